@@ -3,6 +3,8 @@ package types
 import (
 	fmt "fmt"
 
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -28,17 +30,22 @@ func UpdateValidator(pk []byte, power int64, keyType string) ValidatorUpdate {
 	case "", ed25519.KeyType:
 		return Ed25519ValidatorUpdate(pk, power)
 	case secp256k1.KeyType:
-		pke := secp256k1.PubKey(pk)
-		pkp, err := cryptoenc.PubKeyToProto(pke)
-		if err != nil {
-			panic(err)
-		}
-		return ValidatorUpdate{
-			// Address:
-			PubKey: pkp,
-			Power:  power,
-		}
+		return validatorUpdate(secp256k1.PubKey(pk), power)
+	case bls12381.KeyType:
+		return validatorUpdate(bls12381.PubKey(pk), power)
 	default:
 		panic(fmt.Sprintf("key type %s not supported", keyType))
 	}
 }
+
+func validatorUpdate(pke crypto.PubKey, power int64) ValidatorUpdate {
+	pkp, err := cryptoenc.PubKeyToProto(pke)
+	if err != nil {
+		panic(err)
+	}
+	return ValidatorUpdate{
+		// Address:
+		PubKey: pkp,
+		Power:  power,
+	}
+}
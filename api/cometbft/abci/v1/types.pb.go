@@ -2290,6 +2290,26 @@ type CheckTxResponse struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// Priority of the transaction. It is used by the `priority` mempool, which
+	// orders transactions by descending priority when reaping them and evicts
+	// the ones with the lowest priority when it is full. Other mempool types
+	// only use it to decide whether a transaction replaces another one with the
+	// same sender and nonce (see sender).
+	Priority int64 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Name of the mempool lane the transaction belongs to. It is only used when
+	// the node operator has configured mempool lanes, in which case each lane
+	// has its own limits and is allocated a share of the block space according
+	// to its weight. Transactions without a lane, or with a lane that is not
+	// configured, go to the "default" lane.
+	Lane string `protobuf:"bytes,12,opt,name=lane,proto3" json:"lane,omitempty"`
+	// Sender of the transaction, as defined by the application. Together with
+	// nonce, it identifies a slot in the mempool: a transaction with the same
+	// sender and nonce as a transaction already in the mempool, and a strictly
+	// higher priority, replaces it. If empty, the transaction is never
+	// replaced, nor does it replace another one.
+	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Nonce of the transaction for its sender. See sender.
+	Nonce uint64 `protobuf:"varint,13,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *CheckTxResponse) Reset()         { *m = CheckTxResponse{} }
@@ -2381,6 +2401,34 @@ func (m *CheckTxResponse) GetCodespace() string {
 	return ""
 }
 
+func (m *CheckTxResponse) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CheckTxResponse) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *CheckTxResponse) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *CheckTxResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // CommitResponse indicates how much blocks should CometBFT retain.
 type CommitResponse struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
@@ -3747,204 +3795,205 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v1/types.proto", fileDescriptor_95dd8f7b670b96e3) }
 
 var fileDescriptor_95dd8f7b670b96e3 = []byte{
-	// 3140 bytes of a gzipped FileDescriptorProto
+	// 3159 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
-	0xb5, 0xd6, 0xe0, 0x41, 0x02, 0x07, 0x00, 0x39, 0x6c, 0x92, 0x12, 0x44, 0xcb, 0x24, 0x35, 0xb4,
-	0x2c, 0x59, 0xb2, 0xc9, 0x2b, 0xd9, 0xd7, 0x8f, 0xeb, 0x57, 0x81, 0x10, 0x68, 0x92, 0xa2, 0x08,
-	0x78, 0x00, 0xf2, 0x5a, 0xaa, 0x7b, 0x33, 0x1e, 0x00, 0x0d, 0x62, 0x2c, 0x00, 0x33, 0x9e, 0x69,
-	0xd0, 0x60, 0xb2, 0x4a, 0x2a, 0x76, 0xa5, 0xbc, 0xf2, 0x26, 0x9b, 0x54, 0x52, 0x95, 0xaa, 0x54,
-	0x56, 0xa9, 0xca, 0x3a, 0xbf, 0x20, 0xe5, 0x55, 0xe2, 0x65, 0x56, 0x4e, 0xca, 0xde, 0x65, 0x91,
-	0x9d, 0xab, 0xb2, 0x4c, 0xf5, 0x63, 0x5e, 0xc0, 0x0c, 0x49, 0xc9, 0xce, 0x22, 0x95, 0xec, 0xa6,
-	0xbb, 0xbf, 0x73, 0xba, 0xfb, 0x74, 0xf7, 0x79, 0x7c, 0x00, 0x5c, 0x69, 0x99, 0x7d, 0x4c, 0x9a,
-	0x1d, 0xb2, 0xa1, 0x37, 0x5b, 0xc6, 0xc6, 0xf1, 0xed, 0x0d, 0x72, 0x62, 0x61, 0x67, 0xdd, 0xb2,
-	0x4d, 0x62, 0x22, 0xd9, 0x1d, 0x5d, 0xa7, 0xa3, 0xeb, 0xc7, 0xb7, 0x97, 0x9e, 0xf6, 0xf0, 0x2d,
-	0xfb, 0xc4, 0x22, 0x26, 0x95, 0x78, 0x84, 0x4f, 0x84, 0xc0, 0xd2, 0x72, 0xc4, 0xb0, 0x65, 0x9b,
-	0x66, 0x67, 0x62, 0x9c, 0x4d, 0xc3, 0x86, 0x75, 0x5b, 0xef, 0xbb, 0xf2, 0x57, 0x27, 0xc7, 0x8f,
-	0xf5, 0x9e, 0xd1, 0xd6, 0x89, 0x69, 0x0b, 0xc8, 0xc2, 0x91, 0x79, 0x64, 0xb2, 0xcf, 0x0d, 0xfa,
-	0x25, 0x7a, 0x57, 0x8e, 0x4c, 0xf3, 0xa8, 0x87, 0x37, 0x58, 0xab, 0x39, 0xec, 0x6c, 0x10, 0xa3,
-	0x8f, 0x1d, 0xa2, 0xf7, 0x2d, 0x0e, 0x50, 0xfe, 0x98, 0x85, 0x69, 0x15, 0x7f, 0x38, 0xc4, 0x0e,
-	0x41, 0x2f, 0x42, 0x0a, 0xb7, 0xba, 0x66, 0x51, 0x5a, 0x95, 0x6e, 0xe4, 0xee, 0x3c, 0xbd, 0x3e,
-	0xbe, 0xcb, 0xf5, 0x4a, 0xab, 0x6b, 0x0a, 0xf0, 0xf6, 0x05, 0x95, 0x81, 0xd1, 0xcb, 0x90, 0xee,
-	0xf4, 0x86, 0x4e, 0xb7, 0x98, 0x60, 0x52, 0xcb, 0x93, 0x52, 0x5b, 0x74, 0xd8, 0x17, 0xe3, 0x70,
-	0x3a, 0x99, 0x31, 0xe8, 0x98, 0xc5, 0x64, 0xdc, 0x64, 0x3b, 0x83, 0x4e, 0x70, 0x32, 0x0a, 0x46,
-	0x65, 0x00, 0x63, 0x60, 0x10, 0xad, 0xd5, 0xd5, 0x8d, 0x41, 0x31, 0xcd, 0x44, 0x95, 0x28, 0x51,
-	0x83, 0x94, 0x29, 0xc4, 0x97, 0xcf, 0x1a, 0x6e, 0x1f, 0x5d, 0xf1, 0x87, 0x43, 0x6c, 0x9f, 0x14,
-	0xa7, 0xe2, 0x56, 0xfc, 0x2e, 0x1d, 0x0e, 0xac, 0x98, 0xc1, 0xd1, 0x9b, 0x90, 0x69, 0x75, 0x71,
-	0xeb, 0x91, 0x46, 0x46, 0xc5, 0x0c, 0x13, 0x5d, 0x9d, 0x14, 0x2d, 0x53, 0x44, 0x63, 0xe4, 0x0b,
-	0x4f, 0xb7, 0x78, 0x0f, 0x7a, 0x0d, 0xa6, 0x5a, 0x66, 0xbf, 0x6f, 0x90, 0x62, 0x8e, 0x09, 0xaf,
-	0x44, 0x08, 0xb3, 0x71, 0x5f, 0x56, 0x08, 0xa0, 0x2a, 0xcc, 0xf4, 0x0c, 0x87, 0x68, 0xce, 0x40,
-	0xb7, 0x9c, 0xae, 0x49, 0x9c, 0x62, 0x9e, 0xa9, 0x78, 0x76, 0x52, 0xc5, 0x9e, 0xe1, 0x90, 0xba,
-	0x0b, 0xf3, 0x35, 0x15, 0x7a, 0xc1, 0x7e, 0xaa, 0xd0, 0xec, 0x74, 0xb0, 0xed, 0x69, 0x2c, 0x16,
-	0xe2, 0x14, 0x56, 0x29, 0xce, 0x95, 0x0c, 0x28, 0x34, 0x83, 0xfd, 0xe8, 0xff, 0x60, 0xbe, 0x67,
-	0xea, 0x6d, 0x4f, 0x9f, 0xd6, 0xea, 0x0e, 0x07, 0x8f, 0x8a, 0x33, 0x4c, 0xeb, 0xcd, 0x88, 0x65,
-	0x9a, 0x7a, 0xdb, 0x15, 0x2e, 0x53, 0xa8, 0xaf, 0x79, 0xae, 0x37, 0x3e, 0x86, 0x34, 0x58, 0xd0,
-	0x2d, 0xab, 0x77, 0x32, 0xae, 0x7e, 0x96, 0xa9, 0xbf, 0x35, 0xa9, 0xbe, 0x44, 0xd1, 0x31, 0xfa,
-	0x91, 0x3e, 0x31, 0x88, 0x0e, 0x40, 0xb6, 0x6c, 0x6c, 0xe9, 0x36, 0xd6, 0x2c, 0xdb, 0xb4, 0x4c,
-	0x47, 0xef, 0x15, 0x65, 0xa6, 0xfc, 0xc6, 0xa4, 0xf2, 0x1a, 0x47, 0xd6, 0x04, 0xd0, 0xd7, 0x3c,
-	0x6b, 0x85, 0x47, 0xb8, 0x5a, 0xb3, 0x85, 0x1d, 0xc7, 0x57, 0x3b, 0x17, 0xaf, 0x96, 0x21, 0x23,
-	0xd5, 0x86, 0x46, 0xd0, 0x16, 0xe4, 0xf0, 0x88, 0xe0, 0x41, 0x5b, 0x3b, 0x36, 0x09, 0x2e, 0x22,
-	0xa6, 0x71, 0x2d, 0xe2, 0xb9, 0x32, 0xd0, 0xa1, 0x49, 0xb0, 0xaf, 0x0c, 0xb0, 0xd7, 0x89, 0x9a,
-	0xb0, 0x78, 0x8c, 0x6d, 0xa3, 0x73, 0xc2, 0xf4, 0x68, 0x6c, 0xc4, 0x31, 0xcc, 0x41, 0x71, 0x9e,
-	0x69, 0x7c, 0x7e, 0x52, 0xe3, 0x21, 0x83, 0x53, 0xe1, 0x8a, 0x0b, 0xf6, 0x55, 0xcf, 0x1f, 0x4f,
-	0x8e, 0xd2, 0x9b, 0xd6, 0x31, 0x06, 0x7a, 0xcf, 0xf8, 0x3e, 0xd6, 0x9a, 0x3d, 0xb3, 0xf5, 0xa8,
-	0xb8, 0x10, 0x77, 0xd3, 0xb6, 0x04, 0x6e, 0x93, 0xc2, 0x02, 0x37, 0xad, 0x13, 0xec, 0xdf, 0x9c,
-	0x86, 0xf4, 0xb1, 0xde, 0x1b, 0xe2, 0xdd, 0x54, 0x26, 0x25, 0xa7, 0x77, 0x53, 0x99, 0x69, 0x39,
-	0xb3, 0x9b, 0xca, 0x64, 0x65, 0xd8, 0x4d, 0x65, 0x40, 0xce, 0x29, 0xd7, 0x21, 0x17, 0xf0, 0x53,
-	0xa8, 0x08, 0xd3, 0x7d, 0xec, 0x38, 0xfa, 0x11, 0x66, 0x7e, 0x2d, 0xab, 0xba, 0x4d, 0x65, 0x06,
-	0xf2, 0x41, 0xd7, 0xa4, 0x7c, 0x26, 0x41, 0x2e, 0xe0, 0x74, 0xa8, 0xe4, 0x31, 0xb6, 0x99, 0x41,
-	0x84, 0xa4, 0x68, 0xa2, 0x35, 0x28, 0xb0, 0xbd, 0x68, 0xee, 0x38, 0xf5, 0x7d, 0x29, 0x35, 0xcf,
-	0x3a, 0x0f, 0x05, 0x68, 0x05, 0x72, 0xd6, 0x1d, 0xcb, 0x83, 0x24, 0x19, 0x04, 0xac, 0x3b, 0x96,
-	0x0b, 0xb8, 0x0a, 0x79, 0xba, 0x75, 0x0f, 0x91, 0x62, 0x93, 0xe4, 0x68, 0x9f, 0x80, 0x28, 0x7f,
-	0x48, 0x80, 0x3c, 0xee, 0xcc, 0xd0, 0xab, 0x90, 0xa2, 0x5e, 0x5c, 0xb8, 0xe9, 0xa5, 0x75, 0xee,
-	0xe2, 0xd7, 0x5d, 0x17, 0xbf, 0xde, 0x70, 0x5d, 0xfc, 0x66, 0xe6, 0xf3, 0x2f, 0x57, 0x2e, 0x7c,
-	0xf6, 0xe7, 0x15, 0x49, 0x65, 0x12, 0xe8, 0x32, 0xf5, 0x60, 0xba, 0x31, 0xd0, 0x8c, 0x36, 0x5b,
-	0x72, 0x96, 0x7a, 0x27, 0xdd, 0x18, 0xec, 0xb4, 0xd1, 0x7d, 0x90, 0x5b, 0xe6, 0xc0, 0xc1, 0x03,
-	0x67, 0xe8, 0x68, 0x3c, 0xf6, 0x08, 0xd7, 0x1c, 0xf0, 0xaf, 0x3c, 0x06, 0x32, 0x47, 0x25, 0xa0,
-	0x35, 0x86, 0x54, 0x67, 0x5b, 0xe1, 0x0e, 0xf4, 0x0e, 0x80, 0x17, 0xa0, 0x9c, 0x62, 0x6a, 0x35,
-	0x79, 0x23, 0x77, 0xe7, 0x6a, 0xc4, 0x7d, 0x72, 0x31, 0x07, 0x56, 0x5b, 0x27, 0x78, 0x33, 0x45,
-	0x17, 0xac, 0x06, 0x44, 0xd1, 0xb3, 0x30, 0xab, 0x5b, 0x96, 0xe6, 0x10, 0x9d, 0x60, 0xad, 0x79,
-	0x42, 0xb0, 0xc3, 0xdc, 0x7e, 0x5e, 0x2d, 0xe8, 0x96, 0x55, 0xa7, 0xbd, 0x9b, 0xb4, 0x13, 0x5d,
-	0x83, 0x19, 0xea, 0xe1, 0x0d, 0xbd, 0xa7, 0x75, 0xb1, 0x71, 0xd4, 0x25, 0xcc, 0xbb, 0x27, 0xd5,
-	0x82, 0xe8, 0xdd, 0x66, 0x9d, 0x4a, 0x1b, 0xf2, 0x41, 0xe7, 0x8e, 0x10, 0xa4, 0xda, 0x3a, 0xd1,
-	0x99, 0x2d, 0xf3, 0x2a, 0xfb, 0xa6, 0x7d, 0x96, 0x4e, 0xba, 0xc2, 0x42, 0xec, 0x1b, 0x5d, 0x84,
-	0x29, 0xa1, 0x36, 0xc9, 0xd4, 0x8a, 0x16, 0x5a, 0x80, 0xb4, 0x65, 0x9b, 0xc7, 0x98, 0x1d, 0x5e,
-	0x46, 0xe5, 0x0d, 0xe5, 0x01, 0xcc, 0x84, 0xe3, 0x00, 0x9a, 0x81, 0x04, 0x19, 0x89, 0x59, 0x12,
-	0x64, 0x84, 0x6e, 0x43, 0x8a, 0x1a, 0x93, 0x69, 0x9b, 0x89, 0x8a, 0x7e, 0x42, 0xbe, 0x71, 0x62,
-	0x61, 0x95, 0x41, 0x77, 0x53, 0x99, 0x84, 0x9c, 0x54, 0x66, 0xa1, 0x10, 0x8a, 0x12, 0xca, 0x45,
-	0x58, 0x88, 0xf2, 0xf9, 0x8a, 0x01, 0x0b, 0x51, 0xae, 0x1b, 0xbd, 0x0c, 0x19, 0xcf, 0xe9, 0xbb,
-	0x37, 0x68, 0x62, 0x76, 0x4f, 0xc8, 0xc3, 0xd2, 0xbb, 0x43, 0x0f, 0xa2, 0xab, 0x8b, 0x50, 0x9f,
-	0x57, 0xa7, 0x75, 0xcb, 0xda, 0xd6, 0x9d, 0xae, 0xf2, 0x3e, 0x14, 0xe3, 0xfc, 0x79, 0xc0, 0x70,
-	0x12, 0x7b, 0x00, 0xae, 0xe1, 0x2e, 0xc2, 0x54, 0xc7, 0xb4, 0xfb, 0x3a, 0x61, 0xca, 0x0a, 0xaa,
-	0x68, 0x51, 0x83, 0x72, 0xdf, 0x9e, 0x64, 0xdd, 0xbc, 0xa1, 0x68, 0x70, 0x39, 0xd6, 0xa5, 0x53,
-	0x11, 0x63, 0xd0, 0xc6, 0xdc, 0xbc, 0x05, 0x95, 0x37, 0x7c, 0x45, 0x7c, 0xb1, 0xbc, 0x41, 0xa7,
-	0x75, 0xf0, 0xa0, 0x8d, 0x6d, 0xa6, 0x3f, 0xab, 0x8a, 0x96, 0xf2, 0xb3, 0x24, 0x5c, 0x8c, 0xf6,
-	0xeb, 0x68, 0x15, 0xf2, 0x7d, 0x7d, 0xa4, 0x91, 0x91, 0xb8, 0x7e, 0x12, 0xbb, 0x00, 0xd0, 0xd7,
-	0x47, 0x8d, 0x11, 0xbf, 0x7b, 0x32, 0x24, 0xc9, 0xc8, 0x29, 0x26, 0x56, 0x93, 0x37, 0xf2, 0x2a,
-	0xfd, 0x44, 0x87, 0x30, 0xd7, 0x33, 0x5b, 0x7a, 0x4f, 0xeb, 0xe9, 0x0e, 0xd1, 0x44, 0xd8, 0xe7,
-	0xcf, 0xe9, 0x99, 0x38, 0x3f, 0x8d, 0xdb, 0xfc, 0x60, 0xa9, 0x0b, 0x12, 0x0f, 0x61, 0x96, 0x29,
-	0xd9, 0xd3, 0x1d, 0xc2, 0x87, 0x50, 0x05, 0x72, 0x7d, 0xc3, 0x69, 0xe2, 0xae, 0x7e, 0x6c, 0x98,
-	0xb6, 0x78, 0x57, 0x11, 0xb7, 0xe7, 0xbe, 0x0f, 0x12, 0xaa, 0x82, 0x72, 0x81, 0x43, 0x49, 0x87,
-	0x6e, 0xb3, 0xeb, 0x59, 0xa6, 0x1e, 0xdb, 0xb3, 0xfc, 0x17, 0x2c, 0x0c, 0xf0, 0x88, 0x68, 0xfe,
-	0xcb, 0xe5, 0x37, 0x65, 0x9a, 0x19, 0x1f, 0xd1, 0x31, 0xef, 0xad, 0x3b, 0xf4, 0xd2, 0xa0, 0xe7,
-	0x58, 0x6c, 0xb4, 0x4c, 0x07, 0xdb, 0x9a, 0xde, 0x6e, 0xdb, 0xd8, 0x71, 0x58, 0x56, 0x95, 0x67,
-	0xf1, 0x8e, 0xf5, 0x97, 0x78, 0xb7, 0xf2, 0x29, 0x3b, 0x9c, 0xa8, 0xe8, 0xe8, 0x9a, 0x5e, 0xf2,
-	0x4d, 0xdf, 0x80, 0x05, 0x21, 0xdf, 0x0e, 0x59, 0x9f, 0xa7, 0xa7, 0x57, 0xe2, 0x92, 0xae, 0x80,
-	0xd5, 0x91, 0x2b, 0x1f, 0x6f, 0xf8, 0xe4, 0x13, 0x1a, 0x1e, 0x41, 0x8a, 0x99, 0x25, 0xc5, 0xdd,
-	0x0d, 0xfd, 0xfe, 0x57, 0x3b, 0x8c, 0x8f, 0x93, 0x30, 0x37, 0x91, 0x58, 0x78, 0x1b, 0x93, 0x22,
-	0x37, 0x96, 0x88, 0xdc, 0x58, 0xf2, 0xb1, 0x37, 0x26, 0x4e, 0x3b, 0x75, 0xf6, 0x69, 0xa7, 0xbf,
-	0xcb, 0xd3, 0x9e, 0x7a, 0xc2, 0xd3, 0xfe, 0xa7, 0x9e, 0xc3, 0xcf, 0x25, 0x58, 0x8a, 0x4f, 0xc7,
-	0x22, 0x0f, 0xe4, 0x16, 0xcc, 0x79, 0x4b, 0xf1, 0xd4, 0x73, 0xf7, 0x28, 0x7b, 0x03, 0x42, 0x7f,
-	0x6c, 0xc4, 0xbb, 0x06, 0x33, 0x63, 0xd9, 0x22, 0xbf, 0xcc, 0x85, 0xe3, 0xe0, 0x32, 0x94, 0x4f,
-	0x92, 0xb0, 0x10, 0x95, 0xd0, 0x45, 0xbc, 0x58, 0x15, 0xe6, 0xdb, 0xb8, 0x65, 0xb4, 0x9f, 0xf8,
-	0xc1, 0xce, 0x09, 0xf1, 0xff, 0xbc, 0xd7, 0x88, 0x7b, 0xf2, 0x6b, 0x80, 0x8c, 0x8a, 0x1d, 0x8b,
-	0x26, 0x68, 0xa8, 0x0c, 0x59, 0x3c, 0x6a, 0x61, 0x8b, 0xb8, 0x49, 0x6d, 0x4c, 0xdd, 0x20, 0x20,
-	0xae, 0x1c, 0xad, 0x9f, 0x3d, 0x39, 0xf4, 0x92, 0xa0, 0x09, 0x62, 0x0b, 0x7e, 0x9e, 0x7e, 0x7b,
-	0xa2, 0x9c, 0x27, 0x78, 0xc5, 0xe5, 0x09, 0x92, 0x71, 0xd5, 0xaf, 0x48, 0xc6, 0x3d, 0x39, 0x41,
-	0x14, 0xbc, 0x24, 0x88, 0x82, 0x54, 0xdc, 0x74, 0x3c, 0x67, 0xf7, 0xa7, 0x63, 0x4c, 0xc1, 0xdd,
-	0x10, 0x53, 0x30, 0x15, 0xb7, 0xd5, 0x40, 0x72, 0xed, 0x6f, 0xd5, 0xa7, 0x0a, 0x5e, 0x71, 0xa9,
-	0x82, 0xe9, 0xb8, 0x45, 0x8b, 0x6c, 0xd2, 0x5f, 0x34, 0xe7, 0x0a, 0xde, 0x0a, 0x70, 0x05, 0x59,
-	0x26, 0x7b, 0xf5, 0x14, 0xae, 0xc0, 0x93, 0xf6, 0xc8, 0x82, 0xff, 0xf1, 0xc8, 0x82, 0x7c, 0x2c,
-	0xd3, 0x20, 0xd2, 0x40, 0x4f, 0xd8, 0x65, 0x0b, 0x6a, 0x13, 0x6c, 0x01, 0x2f, 0xee, 0xaf, 0x9f,
-	0xc9, 0x16, 0x78, 0xaa, 0xc6, 0xe8, 0x82, 0xda, 0x04, 0x5d, 0x30, 0x13, 0xa7, 0x71, 0x2c, 0xe7,
-	0xf4, 0x35, 0x86, 0xf9, 0x82, 0xff, 0x8f, 0xe6, 0x0b, 0x62, 0x0b, 0xfa, 0x88, 0xfc, 0xd2, 0x53,
-	0x1d, 0x41, 0x18, 0xbc, 0x1f, 0x43, 0x18, 0xc8, 0x71, 0x85, 0x6d, 0x54, 0x76, 0xe9, 0x4d, 0x10,
-	0xc5, 0x18, 0x1c, 0x46, 0x30, 0x06, 0xbc, 0xb4, 0x7f, 0xee, 0x1c, 0x8c, 0x81, 0xa7, 0x7a, 0x82,
-	0x32, 0x38, 0x8c, 0xa0, 0x0c, 0x50, 0xbc, 0xde, 0xb1, 0xa4, 0x28, 0xa8, 0x37, 0xcc, 0x19, 0xbc,
-	0x13, 0xe6, 0x0c, 0xe6, 0x4f, 0xcf, 0x45, 0x79, 0x68, 0xf7, 0xb4, 0x05, 0x49, 0x83, 0x56, 0x1c,
-	0x69, 0xc0, 0xeb, 0xfa, 0x17, 0xce, 0x49, 0x1a, 0x78, 0xba, 0x23, 0x59, 0x83, 0xda, 0x04, 0x6b,
-	0xb0, 0x18, 0x77, 0xe1, 0xc6, 0x82, 0x8c, 0x7f, 0xe1, 0x62, 0x69, 0x83, 0xb4, 0x3c, 0xb5, 0x9b,
-	0xca, 0x64, 0xe4, 0x2c, 0x27, 0x0c, 0x76, 0x53, 0x99, 0x9c, 0x9c, 0x57, 0x9e, 0xa3, 0x69, 0xcd,
-	0x98, 0xdf, 0xa3, 0x45, 0x04, 0xb6, 0x6d, 0xd3, 0x16, 0x04, 0x00, 0x6f, 0x28, 0x37, 0x20, 0x1f,
-	0x74, 0x71, 0xa7, 0x50, 0x0c, 0xb3, 0x50, 0x08, 0x79, 0x35, 0xe5, 0x77, 0x12, 0xe4, 0x83, 0xfe,
-	0x2a, 0x54, 0x80, 0x66, 0x45, 0x01, 0x1a, 0x20, 0x1e, 0x12, 0x61, 0xe2, 0x61, 0x05, 0x72, 0xb4,
-	0x08, 0x1b, 0xe3, 0x14, 0x74, 0xcb, 0xe3, 0x14, 0x6e, 0xc2, 0x1c, 0x8b, 0xa1, 0x9c, 0x9e, 0x10,
-	0x71, 0x2a, 0xc5, 0xe2, 0xd4, 0x2c, 0x1d, 0x60, 0xc6, 0xe0, 0xb5, 0x30, 0x7a, 0x01, 0xe6, 0x03,
-	0x58, 0xaf, 0xb8, 0xe3, 0xe5, 0xb5, 0xec, 0xa1, 0x4b, 0xa2, 0xca, 0xfb, 0xbd, 0x04, 0x73, 0x13,
-	0xee, 0x32, 0x92, 0x37, 0x90, 0xbe, 0x2b, 0xde, 0x20, 0xf1, 0xe4, 0xbc, 0x41, 0xb0, 0x5c, 0x4d,
-	0x86, 0xcb, 0xd5, 0xbf, 0x4b, 0x50, 0x08, 0xb9, 0x6d, 0x7a, 0x08, 0x2d, 0xb3, 0x8d, 0x45, 0x01,
-	0xc9, 0xbe, 0x69, 0x9e, 0xd2, 0x33, 0x8f, 0x44, 0x99, 0x48, 0x3f, 0x29, 0xca, 0x0b, 0x44, 0x59,
-	0x11, 0x66, 0xbc, 0xda, 0x93, 0xe7, 0x02, 0xa2, 0xf6, 0x94, 0x21, 0xf9, 0x08, 0x73, 0x7e, 0x39,
-	0xaf, 0xd2, 0x4f, 0x8a, 0x63, 0xd7, 0x4f, 0xc4, 0x74, 0xde, 0x40, 0xaf, 0x41, 0x96, 0xfd, 0x0a,
-	0xa0, 0x99, 0x96, 0x23, 0x28, 0xe5, 0x40, 0xbe, 0xc3, 0x7f, 0x2a, 0x10, 0xef, 0xdc, 0xec, 0x54,
-	0x2d, 0x47, 0xcd, 0x58, 0xe2, 0x2b, 0x90, 0x85, 0x64, 0x43, 0x59, 0xc8, 0x15, 0xc8, 0xd2, 0xe5,
-	0x3b, 0x96, 0xde, 0xc2, 0x45, 0x60, 0x2b, 0xf5, 0x3b, 0x94, 0xdf, 0x24, 0x60, 0x76, 0x2c, 0xea,
-	0x44, 0x6e, 0xde, 0xbd, 0x95, 0x89, 0x00, 0x2d, 0x72, 0x3e, 0x83, 0x2c, 0x03, 0x1c, 0xe9, 0x8e,
-	0xf6, 0x91, 0x3e, 0x20, 0xb8, 0x2d, 0xac, 0x12, 0xe8, 0x41, 0x4b, 0x90, 0xa1, 0xad, 0xa1, 0x83,
-	0xdb, 0x82, 0xa1, 0xf1, 0xda, 0x68, 0x07, 0xa6, 0xf0, 0x31, 0x1e, 0x10, 0xa7, 0x38, 0xcd, 0x0e,
-	0xfe, 0x52, 0x84, 0x7b, 0xa2, 0xe3, 0x9b, 0x45, 0x7a, 0xdc, 0x7f, 0xfd, 0x72, 0x45, 0xe6, 0xf0,
-	0xe7, 0xcd, 0xbe, 0x41, 0x70, 0xdf, 0x22, 0x27, 0xaa, 0x50, 0x10, 0x36, 0x43, 0x66, 0xcc, 0x0c,
-	0x8c, 0x2e, 0xcc, 0xbb, 0xb5, 0x3f, 0x35, 0xaa, 0x61, 0xda, 0x06, 0x39, 0x51, 0x0b, 0x7d, 0xdc,
-	0xb7, 0x4c, 0xb3, 0xa7, 0xf1, 0x77, 0x5e, 0x82, 0x99, 0x70, 0x90, 0x45, 0x6b, 0x50, 0xb0, 0x31,
-	0xd1, 0x8d, 0x81, 0x16, 0xca, 0x8d, 0xf3, 0xbc, 0x93, 0xbf, 0xab, 0xdd, 0x54, 0x46, 0x92, 0x13,
-	0x82, 0xae, 0x79, 0x17, 0x16, 0x23, 0x63, 0x2c, 0x7a, 0x15, 0xb2, 0x7e, 0x7c, 0x96, 0xd8, 0x76,
-	0x4f, 0xe3, 0x61, 0x7c, 0xb0, 0x72, 0x08, 0x8b, 0x91, 0x41, 0x16, 0xbd, 0x09, 0x53, 0x36, 0x76,
-	0x86, 0x3d, 0x4e, 0xb5, 0xcc, 0xdc, 0xb9, 0x76, 0x76, 0x74, 0x1e, 0xf6, 0x88, 0x2a, 0x84, 0x94,
-	0xdb, 0x70, 0x39, 0x36, 0xca, 0xfa, 0x6c, 0x8a, 0x14, 0x60, 0x53, 0x94, 0xdf, 0x4a, 0xb0, 0x14,
-	0x1f, 0x39, 0xd1, 0xe6, 0xd8, 0x82, 0x6e, 0x9e, 0x33, 0xee, 0x06, 0x56, 0x45, 0xcb, 0x0d, 0x1b,
-	0x77, 0x30, 0x69, 0x75, 0x79, 0x08, 0xe7, 0x4e, 0xa1, 0xa0, 0x16, 0x44, 0x2f, 0x93, 0x71, 0x38,
-	0xec, 0x03, 0xdc, 0x22, 0x1a, 0x3f, 0x54, 0x87, 0xa5, 0xfc, 0x59, 0x0a, 0xa3, 0xbd, 0x75, 0xde,
-	0xa9, 0xdc, 0x82, 0x4b, 0x31, 0xb1, 0x78, 0xb2, 0x2e, 0x51, 0x1e, 0x52, 0x70, 0x64, 0x80, 0x45,
-	0x6f, 0xc3, 0x94, 0x43, 0x74, 0x32, 0x74, 0xc4, 0xce, 0xae, 0x9f, 0x19, 0x9b, 0xeb, 0x0c, 0xae,
-	0x0a, 0x31, 0xe5, 0x75, 0x40, 0x93, 0x91, 0x36, 0xa2, 0xb6, 0x92, 0xa2, 0x6a, 0xab, 0x26, 0x3c,
-	0x75, 0x4a, 0x4c, 0x45, 0xe5, 0xb1, 0xc5, 0xdd, 0x3a, 0x57, 0x48, 0x1e, 0x5b, 0xe0, 0xdf, 0x12,
-	0xb0, 0x18, 0x19, 0x5a, 0x03, 0xaf, 0x54, 0xfa, 0xb6, 0xaf, 0xf4, 0x4d, 0x00, 0x32, 0xd2, 0xf8,
-	0x49, 0xbb, 0xde, 0x3e, 0xaa, 0x9e, 0x18, 0xe1, 0x16, 0x73, 0x58, 0xf4, 0x62, 0x64, 0x89, 0xf8,
-	0xa2, 0xc5, 0x7f, 0xa0, 0x9e, 0x1d, 0xb2, 0x48, 0xe0, 0x88, 0x52, 0xef, 0xdc, 0x31, 0xc3, 0x2f,
-	0x7c, 0x79, 0xb7, 0x83, 0x1e, 0xc2, 0xa5, 0xb1, 0x88, 0xe6, 0xe9, 0x4e, 0x9d, 0x3b, 0xb0, 0x2d,
-	0x86, 0x03, 0x9b, 0xab, 0x3b, 0x18, 0x95, 0xd2, 0xe1, 0xa8, 0xf4, 0x10, 0xc0, 0x2f, 0x6c, 0xe9,
-	0x7b, 0xb3, 0xcd, 0xe1, 0xa0, 0xcd, 0x8e, 0x30, 0xad, 0xf2, 0x06, 0x7a, 0x19, 0xd2, 0xf4, 0x26,
-	0xb8, 0xa6, 0x8a, 0x70, 0x18, 0xf4, 0x48, 0x03, 0x95, 0x31, 0x87, 0x2b, 0x1f, 0xb8, 0xb7, 0x2d,
-	0xc8, 0x31, 0xc6, 0xcc, 0xf1, 0x56, 0x78, 0x0e, 0x25, 0x9e, 0xae, 0x8c, 0x9e, 0xeb, 0x07, 0x90,
-	0x66, 0xc7, 0x4f, 0xa3, 0x03, 0xa3, 0xb8, 0x45, 0x66, 0x43, 0xbf, 0xd1, 0xf7, 0x00, 0x74, 0x42,
-	0x6c, 0xa3, 0x39, 0xf4, 0x67, 0x58, 0x8d, 0xb9, 0x3f, 0x25, 0x17, 0xb8, 0x79, 0x45, 0x5c, 0xa4,
-	0x05, 0x5f, 0x36, 0x70, 0x99, 0x02, 0x1a, 0x95, 0x7d, 0x98, 0x09, 0xcb, 0xba, 0xa1, 0x98, 0x2f,
-	0x22, 0x1c, 0x8a, 0x79, 0x6e, 0x25, 0x42, 0xb1, 0x17, 0xc8, 0x93, 0x9c, 0xc8, 0x67, 0x0d, 0xe5,
-	0x87, 0x09, 0xc8, 0x07, 0x6f, 0xdf, 0xbf, 0x61, 0xb0, 0x54, 0x3e, 0x91, 0x20, 0xe3, 0xed, 0x3f,
-	0x4c, 0xe7, 0x87, 0x7e, 0x07, 0xe1, 0xe6, 0x4b, 0x04, 0x39, 0x78, 0xfe, 0xab, 0x47, 0xd2, 0xfb,
-	0xd5, 0xe3, 0x0d, 0x2f, 0x20, 0xc4, 0x16, 0xf3, 0x41, 0x6b, 0x8b, 0x8b, 0xe5, 0x06, 0xa8, 0xd7,
-	0x21, 0xeb, 0xbd, 0x61, 0x9a, 0x23, 0xbb, 0xc4, 0x87, 0x24, 0x1e, 0x92, 0x20, 0xae, 0x16, 0x20,
-	0x6d, 0x99, 0x1f, 0x09, 0x86, 0x3f, 0xa9, 0xf2, 0x86, 0x82, 0x61, 0x76, 0xcc, 0x01, 0xa0, 0x37,
-	0x60, 0xda, 0x1a, 0x36, 0x35, 0xf7, 0x7a, 0x84, 0xf8, 0xa1, 0x40, 0xee, 0x35, 0x6c, 0xf6, 0x8c,
-	0xd6, 0x3d, 0x7c, 0xe2, 0xae, 0xc6, 0x1a, 0x36, 0xef, 0xf1, 0x6b, 0xc4, 0xa7, 0x49, 0x04, 0xa7,
-	0xf9, 0xa9, 0x04, 0x19, 0xf7, 0x5d, 0xa0, 0xb7, 0x21, 0xeb, 0x79, 0x17, 0x31, 0xc5, 0x53, 0xa7,
-	0xf8, 0x25, 0x31, 0x81, 0x2f, 0x83, 0x36, 0xdd, 0xdf, 0x19, 0x8d, 0xb6, 0xd6, 0xe9, 0xe9, 0x47,
-	0xe2, 0xe7, 0xa2, 0xe5, 0x08, 0x07, 0xc4, 0x7c, 0xf4, 0xce, 0xdd, 0xad, 0x9e, 0x7e, 0xa4, 0xe6,
-	0x98, 0xd0, 0x4e, 0x9b, 0x36, 0x44, 0x1e, 0xf2, 0x8d, 0x04, 0xf2, 0xf8, 0xbb, 0xfd, 0xf6, 0xeb,
-	0x9b, 0x8c, 0x57, 0xc9, 0x88, 0x78, 0x85, 0x36, 0x60, 0xde, 0x43, 0x68, 0x8e, 0x71, 0x34, 0xd0,
-	0xc9, 0xd0, 0xc6, 0x82, 0x54, 0x43, 0xde, 0x50, 0xdd, 0x1d, 0x99, 0xdc, 0x77, 0xfa, 0x49, 0xf7,
-	0xfd, 0x71, 0x02, 0x72, 0x01, 0x8e, 0x0f, 0xfd, 0x77, 0xc0, 0x29, 0xcd, 0x44, 0x45, 0x89, 0x00,
-	0xd8, 0xff, 0xed, 0x2d, 0x6c, 0xa9, 0xc4, 0x13, 0x58, 0x2a, 0x8e, 0x4d, 0x75, 0x49, 0xc3, 0xd4,
-	0x63, 0x93, 0x86, 0xcf, 0x03, 0x22, 0x26, 0xd1, 0x7b, 0xb4, 0x0c, 0x37, 0x06, 0x47, 0x1a, 0xbf,
-	0x8c, 0xdc, 0x87, 0xc8, 0x6c, 0xe4, 0x90, 0x0d, 0xd4, 0xd8, 0xbd, 0xfc, 0x91, 0x04, 0x19, 0x8f,
-	0x7c, 0x79, 0xdc, 0xdf, 0xe4, 0x2e, 0xc2, 0x94, 0xc8, 0xbd, 0xf8, 0x8f, 0x72, 0xa2, 0x15, 0xc9,
-	0x8e, 0x2e, 0x41, 0xa6, 0x8f, 0x89, 0xce, 0x1c, 0x22, 0x8f, 0x70, 0x5e, 0xfb, 0x66, 0x13, 0x72,
-	0x81, 0x9f, 0x35, 0xd1, 0x65, 0x58, 0x2c, 0x6f, 0x57, 0xca, 0xf7, 0xb4, 0xc6, 0x7b, 0x5a, 0xe3,
-	0x41, 0xad, 0xa2, 0x1d, 0xec, 0xdf, 0xdb, 0xaf, 0xfe, 0xef, 0xbe, 0x7c, 0x61, 0x72, 0x48, 0xad,
-	0xb0, 0xb6, 0x2c, 0xa1, 0x4b, 0x30, 0x1f, 0x1e, 0xe2, 0x03, 0x89, 0xa5, 0xd4, 0x4f, 0x7e, 0xb5,
-	0x7c, 0xe1, 0xe6, 0x37, 0x12, 0xcc, 0x47, 0x64, 0xb9, 0xe8, 0x2a, 0x3c, 0x5d, 0xdd, 0xda, 0xaa,
-	0xa8, 0x5a, 0x7d, 0xbf, 0x54, 0xab, 0x6f, 0x57, 0x1b, 0x9a, 0x5a, 0xa9, 0x1f, 0xec, 0x35, 0x02,
-	0x93, 0xae, 0xc2, 0x95, 0x68, 0x48, 0xa9, 0x5c, 0xae, 0xd4, 0x1a, 0xb2, 0x84, 0x56, 0xe0, 0xa9,
-	0x18, 0xc4, 0x66, 0x55, 0x6d, 0xc8, 0x89, 0x78, 0x15, 0x6a, 0x65, 0xb7, 0x52, 0x6e, 0xc8, 0x49,
-	0x74, 0x1d, 0xd6, 0x4e, 0x43, 0x68, 0x5b, 0x55, 0xf5, 0x7e, 0xa9, 0x21, 0xa7, 0xce, 0x04, 0xd6,
-	0x2b, 0xfb, 0x77, 0x2b, 0xaa, 0x9c, 0x16, 0xfb, 0xfe, 0x65, 0x02, 0x8a, 0x71, 0xc9, 0x34, 0xd5,
-	0x55, 0xaa, 0xd5, 0xf6, 0x1e, 0xf8, 0xba, 0xca, 0xdb, 0x07, 0xfb, 0xf7, 0x26, 0x4d, 0xf0, 0x2c,
-	0x28, 0xa7, 0x01, 0x3d, 0x43, 0x5c, 0x83, 0xab, 0xa7, 0xe2, 0x84, 0x39, 0xce, 0x80, 0xa9, 0x95,
-	0x86, 0xfa, 0x40, 0x4e, 0xa2, 0x75, 0xb8, 0x79, 0x26, 0xcc, 0x1b, 0x93, 0x53, 0x68, 0x03, 0x6e,
-	0x9d, 0x8e, 0xe7, 0x06, 0x72, 0x05, 0x5c, 0x13, 0x7d, 0x2a, 0xc1, 0x62, 0x64, 0x56, 0x8e, 0xd6,
-	0x60, 0xa5, 0xa6, 0x56, 0xcb, 0x95, 0x7a, 0x5d, 0xab, 0xa9, 0xd5, 0x5a, 0xb5, 0x5e, 0xda, 0xd3,
-	0xea, 0x8d, 0x52, 0xe3, 0xa0, 0x1e, 0xb0, 0x8d, 0x02, 0xcb, 0x71, 0x20, 0xcf, 0x2e, 0xa7, 0x60,
-	0xc4, 0x0d, 0x70, 0xef, 0xe9, 0x2f, 0x24, 0xb8, 0x1c, 0x9b, 0x85, 0xa3, 0x1b, 0xf0, 0xcc, 0x61,
-	0x45, 0xdd, 0xd9, 0x7a, 0xa0, 0x1d, 0x56, 0x1b, 0x15, 0xad, 0xf2, 0x5e, 0xa3, 0xb2, 0x5f, 0xdf,
-	0xa9, 0xee, 0x4f, 0xae, 0xea, 0x3a, 0xac, 0x9d, 0x8a, 0xf4, 0x96, 0x76, 0x16, 0x70, 0x6c, 0x7d,
-	0x3f, 0x96, 0x60, 0x76, 0xcc, 0x17, 0xa2, 0x2b, 0x50, 0xbc, 0xbf, 0x53, 0xdf, 0xac, 0x6c, 0x97,
-	0x0e, 0x77, 0xaa, 0xea, 0xf8, 0x9b, 0x5d, 0x83, 0x95, 0x89, 0xd1, 0xbb, 0x07, 0xb5, 0xbd, 0x9d,
-	0x72, 0xa9, 0x51, 0x61, 0x93, 0xca, 0x12, 0xdd, 0xd8, 0x04, 0x68, 0x6f, 0xe7, 0x9d, 0xed, 0x86,
-	0x56, 0xde, 0xdb, 0xa9, 0xec, 0x37, 0xb4, 0x52, 0xa3, 0x51, 0xf2, 0x9f, 0xf3, 0xe6, 0xbd, 0xcf,
-	0xbf, 0x5a, 0x96, 0xbe, 0xf8, 0x6a, 0x59, 0xfa, 0xcb, 0x57, 0xcb, 0xd2, 0x67, 0x5f, 0x2f, 0x5f,
-	0xf8, 0xe2, 0xeb, 0xe5, 0x0b, 0x7f, 0xfa, 0x7a, 0xf9, 0xc2, 0xc3, 0xdb, 0x47, 0x06, 0xe9, 0x0e,
-	0x9b, 0xd4, 0x0b, 0x6f, 0xf8, 0xff, 0xae, 0xf4, 0xfe, 0xb5, 0x69, 0x19, 0x1b, 0xe3, 0x7f, 0xe1,
-	0x6c, 0x4e, 0x31, 0xb7, 0xfa, 0xe2, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x41, 0x56, 0x8d,
-	0xdd, 0x29, 0x00, 0x00,
+	0xb5, 0xd6, 0x00, 0x43, 0x12, 0x38, 0x00, 0xc8, 0x61, 0x93, 0x94, 0x20, 0x5a, 0x26, 0xa9, 0x91,
+	0x65, 0xc9, 0x92, 0x4d, 0x5e, 0xc9, 0xf7, 0xfa, 0x71, 0xfd, 0x2a, 0x10, 0x02, 0x4d, 0x52, 0x14,
+	0x01, 0x0f, 0x40, 0xc6, 0x52, 0x25, 0x19, 0x0f, 0x80, 0x06, 0x31, 0x16, 0x80, 0x19, 0xcf, 0x34,
+	0x68, 0x30, 0x59, 0x25, 0x15, 0xbb, 0x52, 0x5e, 0x79, 0x93, 0x4d, 0x2a, 0xa9, 0x4a, 0x55, 0x2a,
+	0xdb, 0xac, 0xf3, 0x0b, 0x52, 0x5e, 0x25, 0x5e, 0x66, 0xe5, 0xa4, 0xec, 0x5d, 0x16, 0xd9, 0xb9,
+	0x92, 0x65, 0xaa, 0x1f, 0xf3, 0x02, 0x66, 0x48, 0x49, 0x76, 0x16, 0xa9, 0x64, 0x87, 0xee, 0xfe,
+	0xce, 0xe9, 0xee, 0xd3, 0xdd, 0xe7, 0xf1, 0x0d, 0xe0, 0x52, 0xcb, 0xea, 0x63, 0xd2, 0xec, 0x90,
+	0x0d, 0xa3, 0xd9, 0x32, 0x37, 0x8e, 0x6f, 0x6d, 0x90, 0x13, 0x1b, 0xbb, 0xeb, 0xb6, 0x63, 0x11,
+	0x0b, 0x29, 0xde, 0xe8, 0x3a, 0x1d, 0x5d, 0x3f, 0xbe, 0xb5, 0xfc, 0xb4, 0x8f, 0x6f, 0x39, 0x27,
+	0x36, 0xb1, 0xa8, 0xc4, 0x43, 0x7c, 0x22, 0x04, 0x96, 0x57, 0x62, 0x86, 0x6d, 0xc7, 0xb2, 0x3a,
+	0x13, 0xe3, 0x6c, 0x1a, 0x36, 0x6c, 0x38, 0x46, 0xdf, 0x93, 0xbf, 0x3c, 0x39, 0x7e, 0x6c, 0xf4,
+	0xcc, 0xb6, 0x41, 0x2c, 0x47, 0x40, 0x16, 0x8f, 0xac, 0x23, 0x8b, 0xfd, 0xdc, 0xa0, 0xbf, 0x44,
+	0xef, 0xea, 0x91, 0x65, 0x1d, 0xf5, 0xf0, 0x06, 0x6b, 0x35, 0x87, 0x9d, 0x0d, 0x62, 0xf6, 0xb1,
+	0x4b, 0x8c, 0xbe, 0xcd, 0x01, 0xea, 0x1f, 0xb3, 0x30, 0xa3, 0xe1, 0x0f, 0x86, 0xd8, 0x25, 0xe8,
+	0x45, 0x90, 0x71, 0xab, 0x6b, 0x15, 0xa5, 0x35, 0xe9, 0x7a, 0xee, 0xf6, 0xd3, 0xeb, 0xe3, 0xbb,
+	0x5c, 0xaf, 0xb4, 0xba, 0x96, 0x00, 0x6f, 0x9f, 0xd3, 0x18, 0x18, 0xbd, 0x04, 0x53, 0x9d, 0xde,
+	0xd0, 0xed, 0x16, 0x53, 0x4c, 0x6a, 0x65, 0x52, 0x6a, 0x8b, 0x0e, 0x07, 0x62, 0x1c, 0x4e, 0x27,
+	0x33, 0x07, 0x1d, 0xab, 0x98, 0x4e, 0x9a, 0x6c, 0x67, 0xd0, 0x09, 0x4f, 0x46, 0xc1, 0xa8, 0x0c,
+	0x60, 0x0e, 0x4c, 0xa2, 0xb7, 0xba, 0x86, 0x39, 0x28, 0x4e, 0x31, 0x51, 0x35, 0x4e, 0xd4, 0x24,
+	0x65, 0x0a, 0x09, 0xe4, 0xb3, 0xa6, 0xd7, 0x47, 0x57, 0xfc, 0xc1, 0x10, 0x3b, 0x27, 0xc5, 0xe9,
+	0xa4, 0x15, 0xbf, 0x43, 0x87, 0x43, 0x2b, 0x66, 0x70, 0xf4, 0x06, 0x64, 0x5a, 0x5d, 0xdc, 0x7a,
+	0xa8, 0x93, 0x51, 0x31, 0xc3, 0x44, 0xd7, 0x26, 0x45, 0xcb, 0x14, 0xd1, 0x18, 0x05, 0xc2, 0x33,
+	0x2d, 0xde, 0x83, 0x5e, 0x85, 0xe9, 0x96, 0xd5, 0xef, 0x9b, 0xa4, 0x98, 0x63, 0xc2, 0xab, 0x31,
+	0xc2, 0x6c, 0x3c, 0x90, 0x15, 0x02, 0xa8, 0x0a, 0xb3, 0x3d, 0xd3, 0x25, 0xba, 0x3b, 0x30, 0x6c,
+	0xb7, 0x6b, 0x11, 0xb7, 0x98, 0x67, 0x2a, 0x9e, 0x9d, 0x54, 0xb1, 0x67, 0xba, 0xa4, 0xee, 0xc1,
+	0x02, 0x4d, 0x85, 0x5e, 0xb8, 0x9f, 0x2a, 0xb4, 0x3a, 0x1d, 0xec, 0xf8, 0x1a, 0x8b, 0x85, 0x24,
+	0x85, 0x55, 0x8a, 0xf3, 0x24, 0x43, 0x0a, 0xad, 0x70, 0x3f, 0xfa, 0x2e, 0x2c, 0xf4, 0x2c, 0xa3,
+	0xed, 0xeb, 0xd3, 0x5b, 0xdd, 0xe1, 0xe0, 0x61, 0x71, 0x96, 0x69, 0xbd, 0x11, 0xb3, 0x4c, 0xcb,
+	0x68, 0x7b, 0xc2, 0x65, 0x0a, 0x0d, 0x34, 0xcf, 0xf7, 0xc6, 0xc7, 0x90, 0x0e, 0x8b, 0x86, 0x6d,
+	0xf7, 0x4e, 0xc6, 0xd5, 0xcf, 0x31, 0xf5, 0x37, 0x27, 0xd5, 0x97, 0x28, 0x3a, 0x41, 0x3f, 0x32,
+	0x26, 0x06, 0xd1, 0x01, 0x28, 0xb6, 0x83, 0x6d, 0xc3, 0xc1, 0xba, 0xed, 0x58, 0xb6, 0xe5, 0x1a,
+	0xbd, 0xa2, 0xc2, 0x94, 0x5f, 0x9f, 0x54, 0x5e, 0xe3, 0xc8, 0x9a, 0x00, 0x06, 0x9a, 0xe7, 0xec,
+	0xe8, 0x08, 0x57, 0x6b, 0xb5, 0xb0, 0xeb, 0x06, 0x6a, 0xe7, 0x93, 0xd5, 0x32, 0x64, 0xac, 0xda,
+	0xc8, 0x08, 0xda, 0x82, 0x1c, 0x1e, 0x11, 0x3c, 0x68, 0xeb, 0xc7, 0x16, 0xc1, 0x45, 0xc4, 0x34,
+	0x5e, 0x89, 0x79, 0xae, 0x0c, 0x74, 0x68, 0x11, 0x1c, 0x28, 0x03, 0xec, 0x77, 0xa2, 0x26, 0x2c,
+	0x1d, 0x63, 0xc7, 0xec, 0x9c, 0x30, 0x3d, 0x3a, 0x1b, 0x71, 0x4d, 0x6b, 0x50, 0x5c, 0x60, 0x1a,
+	0x9f, 0x9f, 0xd4, 0x78, 0xc8, 0xe0, 0x54, 0xb8, 0xe2, 0x81, 0x03, 0xd5, 0x0b, 0xc7, 0x93, 0xa3,
+	0xf4, 0xa6, 0x75, 0xcc, 0x81, 0xd1, 0x33, 0x7f, 0x80, 0xf5, 0x66, 0xcf, 0x6a, 0x3d, 0x2c, 0x2e,
+	0x26, 0xdd, 0xb4, 0x2d, 0x81, 0xdb, 0xa4, 0xb0, 0xd0, 0x4d, 0xeb, 0x84, 0xfb, 0x37, 0x67, 0x60,
+	0xea, 0xd8, 0xe8, 0x0d, 0xf1, 0xae, 0x9c, 0x91, 0x95, 0xa9, 0x5d, 0x39, 0x33, 0xa3, 0x64, 0x76,
+	0xe5, 0x4c, 0x56, 0x81, 0x5d, 0x39, 0x03, 0x4a, 0x4e, 0xbd, 0x06, 0xb9, 0x90, 0x9f, 0x42, 0x45,
+	0x98, 0xe9, 0x63, 0xd7, 0x35, 0x8e, 0x30, 0xf3, 0x6b, 0x59, 0xcd, 0x6b, 0xaa, 0xb3, 0x90, 0x0f,
+	0xbb, 0x26, 0xf5, 0x53, 0x09, 0x72, 0x21, 0xa7, 0x43, 0x25, 0x8f, 0xb1, 0xc3, 0x0c, 0x22, 0x24,
+	0x45, 0x13, 0x5d, 0x81, 0x02, 0xdb, 0x8b, 0xee, 0x8d, 0x53, 0xdf, 0x27, 0x6b, 0x79, 0xd6, 0x79,
+	0x28, 0x40, 0xab, 0x90, 0xb3, 0x6f, 0xdb, 0x3e, 0x24, 0xcd, 0x20, 0x60, 0xdf, 0xb6, 0x3d, 0xc0,
+	0x65, 0xc8, 0xd3, 0xad, 0xfb, 0x08, 0x99, 0x4d, 0x92, 0xa3, 0x7d, 0x02, 0xa2, 0xfe, 0x21, 0x05,
+	0xca, 0xb8, 0x33, 0x43, 0xaf, 0x80, 0x4c, 0xbd, 0xb8, 0x70, 0xd3, 0xcb, 0xeb, 0xdc, 0xc5, 0xaf,
+	0x7b, 0x2e, 0x7e, 0xbd, 0xe1, 0xb9, 0xf8, 0xcd, 0xcc, 0x67, 0x5f, 0xac, 0x9e, 0xfb, 0xf4, 0xcf,
+	0xab, 0x92, 0xc6, 0x24, 0xd0, 0x45, 0xea, 0xc1, 0x0c, 0x73, 0xa0, 0x9b, 0x6d, 0xb6, 0xe4, 0x2c,
+	0xf5, 0x4e, 0x86, 0x39, 0xd8, 0x69, 0xa3, 0x7b, 0xa0, 0xb4, 0xac, 0x81, 0x8b, 0x07, 0xee, 0xd0,
+	0xd5, 0x79, 0xec, 0x29, 0xa6, 0xc7, 0xfd, 0x2b, 0x8f, 0x81, 0xcc, 0x51, 0x09, 0x68, 0x8d, 0x21,
+	0xb5, 0xb9, 0x56, 0xb4, 0x03, 0xbd, 0x0d, 0xe0, 0x07, 0x28, 0xb7, 0x28, 0xaf, 0xa5, 0xaf, 0xe7,
+	0x6e, 0x5f, 0x8e, 0xb9, 0x4f, 0x1e, 0xe6, 0xc0, 0x6e, 0x1b, 0x04, 0x6f, 0xca, 0x74, 0xc1, 0x5a,
+	0x48, 0x14, 0x3d, 0x0b, 0x73, 0x86, 0x6d, 0xeb, 0x2e, 0x31, 0x08, 0xd6, 0x9b, 0x27, 0x04, 0xbb,
+	0xcc, 0xed, 0xe7, 0xb5, 0x82, 0x61, 0xdb, 0x75, 0xda, 0xbb, 0x49, 0x3b, 0xd1, 0x55, 0x98, 0xa5,
+	0x1e, 0xde, 0x34, 0x7a, 0x7a, 0x17, 0x9b, 0x47, 0x5d, 0xc2, 0xbc, 0x7b, 0x5a, 0x2b, 0x88, 0xde,
+	0x6d, 0xd6, 0xa9, 0xb6, 0x21, 0x1f, 0x76, 0xee, 0x08, 0x81, 0xdc, 0x36, 0x88, 0xc1, 0x6c, 0x99,
+	0xd7, 0xd8, 0x6f, 0xda, 0x67, 0x1b, 0xa4, 0x2b, 0x2c, 0xc4, 0x7e, 0xa3, 0xf3, 0x30, 0x2d, 0xd4,
+	0xa6, 0x99, 0x5a, 0xd1, 0x42, 0x8b, 0x30, 0x65, 0x3b, 0xd6, 0x31, 0x66, 0x87, 0x97, 0xd1, 0x78,
+	0x43, 0xbd, 0x0f, 0xb3, 0xd1, 0x38, 0x80, 0x66, 0x21, 0x45, 0x46, 0x62, 0x96, 0x14, 0x19, 0xa1,
+	0x5b, 0x20, 0x53, 0x63, 0x32, 0x6d, 0xb3, 0x71, 0xd1, 0x4f, 0xc8, 0x37, 0x4e, 0x6c, 0xac, 0x31,
+	0xe8, 0xae, 0x9c, 0x49, 0x29, 0x69, 0x75, 0x0e, 0x0a, 0x91, 0x28, 0xa1, 0x9e, 0x87, 0xc5, 0x38,
+	0x9f, 0xaf, 0x9a, 0xb0, 0x18, 0xe7, 0xba, 0xd1, 0x4b, 0x90, 0xf1, 0x9d, 0xbe, 0x77, 0x83, 0x26,
+	0x66, 0xf7, 0x85, 0x7c, 0x2c, 0xbd, 0x3b, 0xf4, 0x20, 0xba, 0x86, 0x08, 0xf5, 0x79, 0x6d, 0xc6,
+	0xb0, 0xed, 0x6d, 0xc3, 0xed, 0xaa, 0xef, 0x41, 0x31, 0xc9, 0x9f, 0x87, 0x0c, 0x27, 0xb1, 0x07,
+	0xe0, 0x19, 0xee, 0x3c, 0x4c, 0x77, 0x2c, 0xa7, 0x6f, 0x10, 0xa6, 0xac, 0xa0, 0x89, 0x16, 0x35,
+	0x28, 0xf7, 0xed, 0x69, 0xd6, 0xcd, 0x1b, 0xaa, 0x0e, 0x17, 0x13, 0x5d, 0x3a, 0x15, 0x31, 0x07,
+	0x6d, 0xcc, 0xcd, 0x5b, 0xd0, 0x78, 0x23, 0x50, 0xc4, 0x17, 0xcb, 0x1b, 0x74, 0x5a, 0x17, 0x0f,
+	0xda, 0xd8, 0x61, 0xfa, 0xb3, 0x9a, 0x68, 0xa9, 0x3f, 0x4f, 0xc3, 0xf9, 0x78, 0xbf, 0x8e, 0xd6,
+	0x20, 0xdf, 0x37, 0x46, 0x3a, 0x19, 0x89, 0xeb, 0x27, 0xb1, 0x0b, 0x00, 0x7d, 0x63, 0xd4, 0x18,
+	0xf1, 0xbb, 0xa7, 0x40, 0x9a, 0x8c, 0xdc, 0x62, 0x6a, 0x2d, 0x7d, 0x3d, 0xaf, 0xd1, 0x9f, 0xe8,
+	0x10, 0xe6, 0x7b, 0x56, 0xcb, 0xe8, 0xe9, 0x3d, 0xc3, 0x25, 0xba, 0x08, 0xfb, 0xfc, 0x39, 0x3d,
+	0x93, 0xe4, 0xa7, 0x71, 0x9b, 0x1f, 0x2c, 0x75, 0x41, 0xe2, 0x21, 0xcc, 0x31, 0x25, 0x7b, 0x86,
+	0x4b, 0xf8, 0x10, 0xaa, 0x40, 0xae, 0x6f, 0xba, 0x4d, 0xdc, 0x35, 0x8e, 0x4d, 0xcb, 0x11, 0xef,
+	0x2a, 0xe6, 0xf6, 0xdc, 0x0b, 0x40, 0x42, 0x55, 0x58, 0x2e, 0x74, 0x28, 0x53, 0x91, 0xdb, 0xec,
+	0x79, 0x96, 0xe9, 0xc7, 0xf6, 0x2c, 0xff, 0x03, 0x8b, 0x03, 0x3c, 0x22, 0x7a, 0xf0, 0x72, 0xf9,
+	0x4d, 0x99, 0x61, 0xc6, 0x47, 0x74, 0xcc, 0x7f, 0xeb, 0x2e, 0xbd, 0x34, 0xe8, 0x39, 0x16, 0x1b,
+	0x6d, 0xcb, 0xc5, 0x8e, 0x6e, 0xb4, 0xdb, 0x0e, 0x76, 0x5d, 0x96, 0x55, 0xe5, 0xb5, 0x39, 0xaf,
+	0xbf, 0xc4, 0xbb, 0xd5, 0x4f, 0xd8, 0xe1, 0xc4, 0x45, 0x47, 0xcf, 0xf4, 0x52, 0x60, 0xfa, 0x06,
+	0x2c, 0x0a, 0xf9, 0x76, 0xc4, 0xfa, 0x3c, 0x3d, 0xbd, 0x94, 0x94, 0x74, 0x85, 0xac, 0x8e, 0x3c,
+	0xf9, 0x64, 0xc3, 0xa7, 0x9f, 0xd0, 0xf0, 0x08, 0x64, 0x66, 0x16, 0x99, 0xbb, 0x1b, 0xfa, 0xfb,
+	0xdf, 0xed, 0x30, 0x3e, 0x4a, 0xc3, 0xfc, 0x44, 0x62, 0xe1, 0x6f, 0x4c, 0x8a, 0xdd, 0x58, 0x2a,
+	0x76, 0x63, 0xe9, 0xc7, 0xde, 0x98, 0x38, 0x6d, 0xf9, 0xec, 0xd3, 0x9e, 0xfa, 0x36, 0x4f, 0x7b,
+	0xfa, 0x09, 0x4f, 0xfb, 0x5f, 0x7a, 0x0e, 0xbf, 0x90, 0x60, 0x39, 0x39, 0x1d, 0x8b, 0x3d, 0x90,
+	0x9b, 0x30, 0xef, 0x2f, 0xc5, 0x57, 0xcf, 0xdd, 0xa3, 0xe2, 0x0f, 0x08, 0xfd, 0x89, 0x11, 0xef,
+	0x2a, 0xcc, 0x8e, 0x65, 0x8b, 0xfc, 0x32, 0x17, 0x8e, 0xc3, 0xcb, 0x50, 0x3f, 0x4e, 0xc3, 0x62,
+	0x5c, 0x42, 0x17, 0xf3, 0x62, 0x35, 0x58, 0x68, 0xe3, 0x96, 0xd9, 0x7e, 0xe2, 0x07, 0x3b, 0x2f,
+	0xc4, 0xff, 0xfb, 0x5e, 0x63, 0xee, 0xc9, 0x6f, 0x00, 0x32, 0x1a, 0x76, 0x6d, 0x6b, 0xe0, 0x62,
+	0x54, 0x86, 0x2c, 0x1e, 0xb5, 0xb0, 0x4d, 0xbc, 0xa4, 0x36, 0xa1, 0x6e, 0x10, 0x10, 0x4f, 0x8e,
+	0xd6, 0xcf, 0xbe, 0x1c, 0xfa, 0x5f, 0x41, 0x13, 0x24, 0x16, 0xfc, 0x3c, 0xfd, 0xf6, 0x45, 0x19,
+	0x1a, 0xbd, 0xec, 0xf1, 0x04, 0xe9, 0xa4, 0xea, 0x57, 0x24, 0xe3, 0xbe, 0x1c, 0xc7, 0xd3, 0xe9,
+	0x18, 0x51, 0x20, 0x27, 0x4d, 0xc7, 0x73, 0xf6, 0x60, 0x3a, 0x8a, 0x46, 0x77, 0x22, 0x4c, 0xc1,
+	0x74, 0xd2, 0x56, 0x43, 0xc9, 0x75, 0xb0, 0xd5, 0x80, 0x2a, 0x78, 0xd9, 0xa3, 0x0a, 0x66, 0x92,
+	0x16, 0x2d, 0xb2, 0xc9, 0x60, 0xd1, 0x0c, 0x8f, 0xde, 0x0c, 0x71, 0x05, 0xd9, 0x35, 0x29, 0x3e,
+	0xfb, 0xf5, 0x73, 0x44, 0x5f, 0xda, 0x27, 0x0b, 0xfe, 0xdf, 0x27, 0x0b, 0xf2, 0x89, 0x4c, 0x83,
+	0x48, 0x03, 0x7d, 0x61, 0x21, 0x81, 0x6a, 0x13, 0x6c, 0x01, 0x2f, 0xee, 0xaf, 0x9d, 0xc9, 0x16,
+	0xf8, 0xaa, 0xc6, 0xe8, 0x82, 0xda, 0x04, 0x5d, 0x30, 0x9b, 0xa4, 0x71, 0x2c, 0xe7, 0x0c, 0x34,
+	0x46, 0xf9, 0x82, 0xef, 0xc5, 0xf3, 0x05, 0x89, 0x05, 0x7d, 0x4c, 0x7e, 0xe9, 0xab, 0x8e, 0x21,
+	0x0c, 0xde, 0x4b, 0x20, 0x0c, 0x94, 0xa4, 0xc2, 0x36, 0x2e, 0xbb, 0xf4, 0x27, 0x88, 0x63, 0x0c,
+	0x0e, 0x63, 0x18, 0x03, 0x5e, 0xda, 0x3f, 0xf7, 0x08, 0x8c, 0x81, 0xaf, 0x7a, 0x82, 0x32, 0x38,
+	0x8c, 0xa1, 0x0c, 0x50, 0xb2, 0xde, 0xb1, 0xa4, 0x28, 0xac, 0x37, 0x32, 0x84, 0xde, 0x8e, 0x72,
+	0x06, 0x0b, 0xa7, 0xe7, 0xa2, 0x3c, 0xb4, 0xfb, 0xda, 0xc2, 0xa4, 0x41, 0x2b, 0x89, 0x34, 0xe0,
+	0x75, 0xfd, 0x0b, 0x8f, 0x48, 0x1a, 0xf8, 0xba, 0x63, 0x59, 0x83, 0xda, 0x04, 0x6b, 0xb0, 0x94,
+	0x74, 0xe1, 0xc6, 0x82, 0x4c, 0x70, 0xe1, 0x12, 0x69, 0x83, 0x29, 0x65, 0x7a, 0x57, 0xce, 0x64,
+	0x94, 0x2c, 0x27, 0x0c, 0x76, 0xe5, 0x4c, 0x4e, 0xc9, 0xab, 0xcf, 0xd1, 0xb4, 0x66, 0xcc, 0xef,
+	0xd1, 0x22, 0x02, 0x3b, 0x8e, 0xe5, 0x08, 0x02, 0x80, 0x37, 0xd4, 0xeb, 0x90, 0x0f, 0xbb, 0xb8,
+	0x53, 0x28, 0x86, 0x39, 0x28, 0x44, 0xbc, 0x9a, 0xfa, 0x3b, 0x09, 0xf2, 0x61, 0x7f, 0x15, 0x29,
+	0x40, 0xb3, 0xa2, 0x00, 0x0d, 0x11, 0x0f, 0xa9, 0x28, 0xf1, 0xb0, 0x0a, 0x39, 0x5a, 0x84, 0x8d,
+	0x71, 0x0a, 0x86, 0xed, 0x73, 0x0a, 0x37, 0x60, 0x9e, 0xc5, 0x50, 0x4e, 0x4f, 0x88, 0x38, 0x25,
+	0xb3, 0x38, 0x35, 0x47, 0x07, 0x98, 0x31, 0x78, 0x2d, 0x8c, 0x5e, 0x80, 0x85, 0x10, 0xd6, 0x2f,
+	0xee, 0x78, 0x79, 0xad, 0xf8, 0xe8, 0x92, 0xa8, 0xf2, 0x7e, 0x2f, 0xc1, 0xfc, 0x84, 0xbb, 0x8c,
+	0xe5, 0x0d, 0xa4, 0x6f, 0x8b, 0x37, 0x48, 0x3d, 0x39, 0x6f, 0x10, 0x2e, 0x57, 0xd3, 0xd1, 0x72,
+	0xf5, 0x1f, 0x12, 0x14, 0x22, 0x6e, 0x9b, 0x1e, 0x42, 0xcb, 0x6a, 0x63, 0x51, 0x40, 0xb2, 0xdf,
+	0x34, 0x4f, 0xe9, 0x59, 0x47, 0xa2, 0x4c, 0xa4, 0x3f, 0x29, 0xca, 0x0f, 0x44, 0x59, 0x11, 0x66,
+	0xfc, 0xda, 0x93, 0xe7, 0x02, 0xbc, 0x41, 0x65, 0x1f, 0x62, 0xce, 0x2f, 0xe7, 0x35, 0xfa, 0x13,
+	0x2d, 0x8a, 0xeb, 0x27, 0x62, 0x3a, 0x6f, 0xa0, 0x57, 0x21, 0xcb, 0xbe, 0x02, 0xe8, 0x96, 0xed,
+	0x16, 0x33, 0xe3, 0xf9, 0x0e, 0xff, 0x54, 0x20, 0xde, 0xb9, 0xd5, 0xa9, 0xda, 0xae, 0x96, 0xb1,
+	0xc5, 0xaf, 0x50, 0x16, 0x92, 0x8d, 0x64, 0x21, 0x97, 0x20, 0x4b, 0x97, 0xef, 0xda, 0x46, 0x0b,
+	0x17, 0x81, 0xad, 0x34, 0xe8, 0x50, 0xff, 0x9e, 0x82, 0xb9, 0xb1, 0xa8, 0x13, 0xbb, 0x79, 0xef,
+	0x56, 0xa6, 0x42, 0xb4, 0xc8, 0xa3, 0x19, 0x64, 0x05, 0xe0, 0xc8, 0x70, 0xf5, 0x0f, 0x8d, 0x01,
+	0xc1, 0x6d, 0x61, 0x95, 0x50, 0x0f, 0x5a, 0x86, 0x0c, 0x6d, 0x0d, 0x5d, 0xdc, 0x16, 0x0c, 0x8d,
+	0xdf, 0x46, 0x3b, 0x30, 0x8d, 0x8f, 0xf1, 0x80, 0xb8, 0xc5, 0x19, 0x76, 0xf0, 0x17, 0x62, 0xdc,
+	0x13, 0x1d, 0xdf, 0x2c, 0xd2, 0xe3, 0xfe, 0xeb, 0x17, 0xab, 0x0a, 0x87, 0x3f, 0x6f, 0xf5, 0x4d,
+	0x82, 0xfb, 0x36, 0x39, 0xd1, 0x84, 0x82, 0xa8, 0x19, 0x32, 0x63, 0x66, 0xa0, 0x8b, 0xb0, 0x1d,
+	0xd3, 0x72, 0x4c, 0x72, 0xc2, 0x6c, 0x94, 0xd6, 0xfc, 0x36, 0xdd, 0x54, 0xcf, 0x18, 0x60, 0x16,
+	0x77, 0xb3, 0x1a, 0xfb, 0x1d, 0x62, 0x0d, 0xb2, 0x61, 0xd6, 0x80, 0x9e, 0xea, 0xc0, 0x1a, 0xb4,
+	0x30, 0x0b, 0xb0, 0xb2, 0xc6, 0x1b, 0xdc, 0x9f, 0x68, 0x85, 0x3e, 0xee, 0xdb, 0x96, 0xd5, 0xd3,
+	0xb9, 0xcf, 0x28, 0xc1, 0x6c, 0x34, 0x60, 0x53, 0x12, 0xd1, 0xc1, 0x84, 0xb2, 0x71, 0x91, 0x3c,
+	0x3b, 0xcf, 0x3b, 0xf9, 0x1b, 0xdd, 0x95, 0x33, 0x92, 0x92, 0x12, 0xd4, 0xcf, 0x3b, 0xb0, 0x14,
+	0x1b, 0xaf, 0xd1, 0x2b, 0x90, 0x0d, 0x62, 0xbd, 0xb4, 0x96, 0x3e, 0x83, 0xd3, 0x09, 0xc0, 0xea,
+	0x21, 0x2c, 0xc5, 0x06, 0x6c, 0xf4, 0x06, 0x4c, 0x3b, 0xd8, 0x1d, 0xf6, 0x38, 0x6d, 0x33, 0x7b,
+	0xfb, 0xea, 0xd9, 0x91, 0x7e, 0xd8, 0x23, 0x9a, 0x10, 0x52, 0x6f, 0xc1, 0xc5, 0xc4, 0x88, 0x1d,
+	0x30, 0x33, 0x52, 0x88, 0x99, 0x51, 0x7f, 0x2b, 0xc1, 0x72, 0x72, 0x14, 0x46, 0x9b, 0x63, 0x0b,
+	0xba, 0xf1, 0x88, 0x31, 0x3c, 0xb4, 0x2a, 0x5a, 0xba, 0x38, 0xb8, 0x83, 0x49, 0xab, 0xcb, 0xd3,
+	0x01, 0xee, 0x60, 0x0a, 0x5a, 0x41, 0xf4, 0x32, 0x19, 0x97, 0xc3, 0xde, 0xc7, 0x2d, 0xa2, 0xf3,
+	0x63, 0x76, 0x59, 0xf9, 0x90, 0xd5, 0x0a, 0xbc, 0xb7, 0xce, 0x3b, 0xd5, 0x9b, 0x70, 0x21, 0x21,
+	0xae, 0x4f, 0xd6, 0x38, 0xea, 0x03, 0x0a, 0x8e, 0x0d, 0xd6, 0xe8, 0x2d, 0x98, 0x76, 0x89, 0x41,
+	0x86, 0xae, 0xd8, 0xd9, 0xb5, 0x33, 0xe3, 0x7c, 0x9d, 0xc1, 0x35, 0x21, 0xa6, 0xbe, 0x06, 0x68,
+	0x32, 0x6a, 0xc7, 0xd4, 0x69, 0x52, 0x5c, 0x9d, 0xd6, 0x84, 0xa7, 0x4e, 0x89, 0xcf, 0xa8, 0x3c,
+	0xb6, 0xb8, 0x9b, 0x8f, 0x14, 0xde, 0xc7, 0x16, 0xf8, 0xb7, 0x14, 0x2c, 0xc5, 0x86, 0xe9, 0xd0,
+	0x8b, 0x97, 0xbe, 0xe9, 0x8b, 0x7f, 0x03, 0x80, 0x8c, 0x74, 0x7e, 0xd2, 0x5e, 0xe4, 0x88, 0xab,
+	0x4d, 0x46, 0xb8, 0xd5, 0x18, 0x89, 0x8b, 0x91, 0x25, 0xe2, 0x17, 0x25, 0x12, 0x42, 0xb5, 0xf1,
+	0x90, 0x45, 0x15, 0xb7, 0x98, 0x7e, 0xbc, 0xf8, 0xa3, 0x1c, 0x47, 0xbb, 0x5d, 0xf4, 0x00, 0x2e,
+	0x8c, 0x45, 0x47, 0x5f, 0xb7, 0xfc, 0xc8, 0x41, 0x72, 0x29, 0x1a, 0x24, 0x3d, 0xdd, 0xe1, 0x08,
+	0x37, 0x15, 0x8d, 0x70, 0x0f, 0x00, 0x82, 0x22, 0x99, 0xbe, 0x37, 0xc7, 0x1a, 0x0e, 0xda, 0xec,
+	0x08, 0xa7, 0x34, 0xde, 0xa0, 0x5f, 0x41, 0xe9, 0x4d, 0xf0, 0x4c, 0x15, 0xe3, 0x30, 0xe8, 0x91,
+	0x86, 0xaa, 0x6c, 0x0e, 0x57, 0xdf, 0x07, 0x34, 0xc9, 0x57, 0x26, 0xcc, 0xf1, 0x66, 0x74, 0x0e,
+	0x35, 0x99, 0xfa, 0x8c, 0x9f, 0xeb, 0x87, 0x30, 0xc5, 0x8e, 0x9f, 0x3a, 0x65, 0x46, 0x97, 0x8b,
+	0x2c, 0x89, 0xfe, 0x46, 0xdf, 0x07, 0x30, 0x08, 0x71, 0xcc, 0xe6, 0x30, 0x98, 0x61, 0x2d, 0xe1,
+	0xfe, 0x94, 0x3c, 0xe0, 0xe6, 0x25, 0x71, 0x91, 0x16, 0x03, 0xd9, 0xd0, 0x65, 0x0a, 0x69, 0x54,
+	0xf7, 0x61, 0x36, 0x2a, 0xeb, 0x85, 0x75, 0xbe, 0x88, 0x68, 0x58, 0xe7, 0x79, 0x1a, 0x6f, 0x04,
+	0x49, 0x41, 0x9a, 0x7f, 0x14, 0x60, 0x0d, 0xf5, 0x47, 0x29, 0xc8, 0x87, 0x6f, 0xdf, 0x7f, 0x60,
+	0xe0, 0x55, 0x3f, 0x96, 0x20, 0xe3, 0xef, 0x3f, 0xfa, 0x69, 0x20, 0xf2, 0x4d, 0x85, 0x9b, 0x2f,
+	0x15, 0xe6, 0xf3, 0xf9, 0x17, 0x94, 0xb4, 0xff, 0x05, 0xe5, 0x75, 0x3f, 0x20, 0x24, 0x12, 0x03,
+	0x61, 0x6b, 0x8b, 0x8b, 0xe5, 0x05, 0xa8, 0xd7, 0x20, 0xeb, 0xbf, 0x61, 0x9a, 0x6f, 0x7b, 0x24,
+	0x8a, 0x24, 0x1e, 0x12, 0x6f, 0xd2, 0xa5, 0xd8, 0xd6, 0x87, 0xe2, 0x6b, 0x41, 0x5a, 0xe3, 0x0d,
+	0x15, 0xc3, 0xdc, 0x98, 0x03, 0x40, 0xaf, 0xc3, 0x8c, 0x3d, 0x6c, 0xea, 0xde, 0xf5, 0x88, 0x70,
+	0x4d, 0xa1, 0x3c, 0x6e, 0xd8, 0xec, 0x99, 0xad, 0xbb, 0xf8, 0xc4, 0x5b, 0x8d, 0x3d, 0x6c, 0xde,
+	0xe5, 0xd7, 0x88, 0x4f, 0x93, 0x0a, 0x4f, 0xf3, 0x33, 0x09, 0x32, 0xde, 0xbb, 0x40, 0x6f, 0x41,
+	0xd6, 0xf7, 0x2e, 0x62, 0x8a, 0xa7, 0x4e, 0xf1, 0x4b, 0x62, 0x82, 0x40, 0x06, 0x6d, 0x7a, 0xdf,
+	0x2c, 0xcd, 0xb6, 0xde, 0xe9, 0x19, 0x47, 0xe2, 0xd3, 0xd3, 0x4a, 0x8c, 0x03, 0x62, 0x3e, 0x7a,
+	0xe7, 0xce, 0x56, 0xcf, 0x38, 0xd2, 0x72, 0x4c, 0x68, 0xa7, 0x4d, 0x1b, 0x22, 0x0f, 0xf9, 0x5a,
+	0x02, 0x65, 0xfc, 0xdd, 0x7e, 0xf3, 0xf5, 0x4d, 0xc6, 0xab, 0x74, 0x4c, 0xbc, 0x42, 0x1b, 0xb0,
+	0xe0, 0x23, 0x74, 0xd7, 0x3c, 0x1a, 0x18, 0x64, 0xe8, 0x60, 0x41, 0xd0, 0x21, 0x7f, 0xa8, 0xee,
+	0x8d, 0x4c, 0xee, 0x7b, 0xea, 0x49, 0xf7, 0xfd, 0x51, 0x0a, 0x72, 0x21, 0xbe, 0x10, 0xfd, 0x5f,
+	0xc8, 0x29, 0xcd, 0xc6, 0x45, 0x89, 0x10, 0x38, 0xf8, 0x8e, 0x17, 0xb5, 0x54, 0xea, 0x09, 0x2c,
+	0x95, 0xc4, 0xcc, 0x7a, 0x04, 0xa4, 0xfc, 0xd8, 0x04, 0xe4, 0xf3, 0x80, 0x88, 0x45, 0x8c, 0x1e,
+	0x2d, 0xe9, 0xcd, 0xc1, 0x91, 0xce, 0x2f, 0x23, 0xf7, 0x21, 0x0a, 0x1b, 0x39, 0x64, 0x03, 0x35,
+	0x76, 0x2f, 0x7f, 0x2c, 0x41, 0xc6, 0x27, 0x72, 0x1e, 0xf7, 0xfb, 0xde, 0x79, 0x98, 0x16, 0xb9,
+	0x17, 0xff, 0xc0, 0x27, 0x5a, 0xb1, 0x4c, 0xeb, 0x32, 0x64, 0xfa, 0x98, 0x18, 0xcc, 0x21, 0xf2,
+	0x08, 0xe7, 0xb7, 0x6f, 0x34, 0x21, 0x17, 0xfa, 0x44, 0x8a, 0x2e, 0xc2, 0x52, 0x79, 0xbb, 0x52,
+	0xbe, 0xab, 0x37, 0xde, 0xd5, 0x1b, 0xf7, 0x6b, 0x15, 0xfd, 0x60, 0xff, 0xee, 0x7e, 0xf5, 0x3b,
+	0xfb, 0xca, 0xb9, 0xc9, 0x21, 0xad, 0xc2, 0xda, 0x8a, 0x84, 0x2e, 0xc0, 0x42, 0x74, 0x88, 0x0f,
+	0xa4, 0x96, 0xe5, 0x9f, 0xfe, 0x7a, 0xe5, 0xdc, 0x8d, 0xaf, 0x25, 0x58, 0x88, 0xc9, 0x72, 0xd1,
+	0x65, 0x78, 0xba, 0xba, 0xb5, 0x55, 0xd1, 0xf4, 0xfa, 0x7e, 0xa9, 0x56, 0xdf, 0xae, 0x36, 0x74,
+	0xad, 0x52, 0x3f, 0xd8, 0x6b, 0x84, 0x26, 0x5d, 0x83, 0x4b, 0xf1, 0x90, 0x52, 0xb9, 0x5c, 0xa9,
+	0x35, 0x14, 0x09, 0xad, 0xc2, 0x53, 0x09, 0x88, 0xcd, 0xaa, 0xd6, 0x50, 0x52, 0xc9, 0x2a, 0xb4,
+	0xca, 0x6e, 0xa5, 0xdc, 0x50, 0xd2, 0xe8, 0x1a, 0x5c, 0x39, 0x0d, 0xa1, 0x6f, 0x55, 0xb5, 0x7b,
+	0xa5, 0x86, 0x22, 0x9f, 0x09, 0xac, 0x57, 0xf6, 0xef, 0x54, 0x34, 0x65, 0x4a, 0xec, 0xfb, 0x57,
+	0x29, 0x28, 0x26, 0x25, 0xd3, 0x54, 0x57, 0xa9, 0x56, 0xdb, 0xbb, 0x1f, 0xe8, 0x2a, 0x6f, 0x1f,
+	0xec, 0xdf, 0x9d, 0x34, 0xc1, 0xb3, 0xa0, 0x9e, 0x06, 0xf4, 0x0d, 0x71, 0x15, 0x2e, 0x9f, 0x8a,
+	0x13, 0xe6, 0x38, 0x03, 0xa6, 0x55, 0x1a, 0xda, 0x7d, 0x25, 0x8d, 0xd6, 0xe1, 0xc6, 0x99, 0x30,
+	0x7f, 0x4c, 0x91, 0xd1, 0x06, 0xdc, 0x3c, 0x1d, 0xcf, 0x0d, 0xe4, 0x09, 0x78, 0x26, 0xfa, 0x44,
+	0x82, 0xa5, 0xd8, 0xac, 0x1c, 0x5d, 0x81, 0xd5, 0x9a, 0x56, 0x2d, 0x57, 0xea, 0x75, 0xbd, 0xa6,
+	0x55, 0x6b, 0xd5, 0x7a, 0x69, 0x4f, 0xaf, 0x37, 0x4a, 0x8d, 0x83, 0x7a, 0xc8, 0x36, 0x2a, 0xac,
+	0x24, 0x81, 0x7c, 0xbb, 0x9c, 0x82, 0x11, 0x37, 0xc0, 0xbb, 0xa7, 0xbf, 0x94, 0xe0, 0x62, 0x62,
+	0x16, 0x8e, 0xae, 0xc3, 0x33, 0x87, 0x15, 0x6d, 0x67, 0xeb, 0xbe, 0x7e, 0x58, 0x6d, 0x54, 0xf4,
+	0xca, 0xbb, 0x8d, 0xca, 0x7e, 0x7d, 0xa7, 0xba, 0x3f, 0xb9, 0xaa, 0x6b, 0x70, 0xe5, 0x54, 0xa4,
+	0xbf, 0xb4, 0xb3, 0x80, 0x63, 0xeb, 0xfb, 0x89, 0x04, 0x73, 0x63, 0xbe, 0x10, 0x5d, 0x82, 0xe2,
+	0xbd, 0x9d, 0xfa, 0x66, 0x65, 0xbb, 0x74, 0xb8, 0x53, 0xd5, 0xc6, 0xdf, 0xec, 0x15, 0x58, 0x9d,
+	0x18, 0xbd, 0x73, 0x50, 0xdb, 0xdb, 0x29, 0x97, 0x1a, 0x15, 0x36, 0xa9, 0x22, 0xd1, 0x8d, 0x4d,
+	0x80, 0xf6, 0x76, 0xde, 0xde, 0x6e, 0xe8, 0xe5, 0xbd, 0x9d, 0xca, 0x7e, 0x43, 0x2f, 0x35, 0x1a,
+	0xa5, 0xe0, 0x39, 0x6f, 0xde, 0xfd, 0xec, 0xcb, 0x15, 0xe9, 0xf3, 0x2f, 0x57, 0xa4, 0xbf, 0x7c,
+	0xb9, 0x22, 0x7d, 0xfa, 0xd5, 0xca, 0xb9, 0xcf, 0xbf, 0x5a, 0x39, 0xf7, 0xa7, 0xaf, 0x56, 0xce,
+	0x3d, 0xb8, 0x75, 0x64, 0x92, 0xee, 0xb0, 0x49, 0xbd, 0xf0, 0x46, 0xf0, 0x4f, 0x4d, 0xef, 0x87,
+	0x61, 0x9b, 0x1b, 0xe3, 0x7f, 0x07, 0x6d, 0x4e, 0x33, 0xb7, 0xfa, 0xe2, 0x3f, 0x07, 0x00, 0xd6,
+	0xc0, 0x95, 0x23, 0x29, 0x2a, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -5862,6 +5911,30 @@ func (m *CheckTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -7845,6 +7918,20 @@ func (m *CheckTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -13244,6 +13331,108 @@ func (m *CheckTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
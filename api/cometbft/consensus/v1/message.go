@@ -66,6 +66,24 @@ func (m *HasProposalBlockPart) Wrap() proto.Message {
 	return cm
 }
 
+func (m *CompactBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlock{CompactBlock: m}
+	return cm
+}
+
+func (m *CompactBlockTxsRequest) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlockTxsRequest{CompactBlockTxsRequest: m}
+	return cm
+}
+
+func (m *CompactBlockTxs) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlockTxs{CompactBlockTxs: m}
+	return cm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped consensus
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -94,6 +112,15 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_HasProposalBlockPart:
 		return m.GetHasProposalBlockPart(), nil
 
+	case *Message_CompactBlock:
+		return m.GetCompactBlock(), nil
+
+	case *Message_CompactBlockTxsRequest:
+		return m.GetCompactBlockTxsRequest(), nil
+
+	case *Message_CompactBlockTxs:
+		return m.GetCompactBlockTxs(), nil
+
 	case *Message_VoteSetMaj23:
 		return m.GetVoteSetMaj23(), nil
 
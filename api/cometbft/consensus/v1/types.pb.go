@@ -670,6 +670,234 @@ func (m *HasProposalBlockPart) GetIndex() int32 {
 	return 0
 }
 
+// CompactBlock is sent instead of the parts of a proposal block, to peers that
+// support compact blocks. It contains the block without its transactions, and
+// the keys of the transactions, which the receiver looks up in its mempool to
+// rebuild the block.
+type CompactBlock struct {
+	Height        int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round         int32            `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	PartSetHeader v1.PartSetHeader `protobuf:"bytes,3,opt,name=part_set_header,json=partSetHeader,proto3" json:"part_set_header"`
+	Header        v1.Header        `protobuf:"bytes,4,opt,name=header,proto3" json:"header"`
+	LastCommit    *v1.Commit       `protobuf:"bytes,5,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+	Evidence      v1.EvidenceList  `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence"`
+	TxKeys        [][]byte         `protobuf:"bytes,7,rep,name=tx_keys,json=txKeys,proto3" json:"tx_keys,omitempty"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4179ae4c5322abef, []int{10}
+}
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetPartSetHeader() v1.PartSetHeader {
+	if m != nil {
+		return m.PartSetHeader
+	}
+	return v1.PartSetHeader{}
+}
+
+func (m *CompactBlock) GetHeader() v1.Header {
+	if m != nil {
+		return m.Header
+	}
+	return v1.Header{}
+}
+
+func (m *CompactBlock) GetLastCommit() *v1.Commit {
+	if m != nil {
+		return m.LastCommit
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetEvidence() v1.EvidenceList {
+	if m != nil {
+		return m.Evidence
+	}
+	return v1.EvidenceList{}
+}
+
+func (m *CompactBlock) GetTxKeys() [][]byte {
+	if m != nil {
+		return m.TxKeys
+	}
+	return nil
+}
+
+// CompactBlockTxsRequest is sent to request the transactions of a compact
+// block that are missing from the mempool of the sender.
+type CompactBlockTxsRequest struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *CompactBlockTxsRequest) Reset()         { *m = CompactBlockTxsRequest{} }
+func (m *CompactBlockTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxsRequest) ProtoMessage()    {}
+func (*CompactBlockTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4179ae4c5322abef, []int{11}
+}
+func (m *CompactBlockTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxsRequest.Merge(m, src)
+}
+func (m *CompactBlockTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxsRequest proto.InternalMessageInfo
+
+func (m *CompactBlockTxsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxsRequest) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// CompactBlockTxs contains the transactions of a compact block requested with
+// CompactBlockTxsRequest, along with their indexes in the block.
+type CompactBlockTxs struct {
+	Height  int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Indexes []uint32 `protobuf:"varint,3,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	Txs     [][]byte `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *CompactBlockTxs) Reset()         { *m = CompactBlockTxs{} }
+func (m *CompactBlockTxs) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTxs) ProtoMessage()    {}
+func (*CompactBlockTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4179ae4c5322abef, []int{12}
+}
+func (m *CompactBlockTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTxs.Merge(m, src)
+}
+func (m *CompactBlockTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTxs proto.InternalMessageInfo
+
+func (m *CompactBlockTxs) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *CompactBlockTxs) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 // Message is an abstract consensus message.
 type Message struct {
 	// Sum of all possible messages.
@@ -685,6 +913,9 @@ type Message struct {
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
 	//	*Message_HasProposalBlockPart
+	//	*Message_CompactBlock
+	//	*Message_CompactBlockTxsRequest
+	//	*Message_CompactBlockTxs
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -692,7 +923,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4179ae4c5322abef, []int{10}
+	return fileDescriptor_4179ae4c5322abef, []int{13}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,17 +988,29 @@ type Message_VoteSetBits struct {
 type Message_HasProposalBlockPart struct {
 	HasProposalBlockPart *HasProposalBlockPart `protobuf:"bytes,10,opt,name=has_proposal_block_part,json=hasProposalBlockPart,proto3,oneof" json:"has_proposal_block_part,omitempty"`
 }
+type Message_CompactBlock struct {
+	CompactBlock *CompactBlock `protobuf:"bytes,11,opt,name=compact_block,json=compactBlock,proto3,oneof" json:"compact_block,omitempty"`
+}
+type Message_CompactBlockTxsRequest struct {
+	CompactBlockTxsRequest *CompactBlockTxsRequest `protobuf:"bytes,12,opt,name=compact_block_txs_request,json=compactBlockTxsRequest,proto3,oneof" json:"compact_block_txs_request,omitempty"`
+}
+type Message_CompactBlockTxs struct {
+	CompactBlockTxs *CompactBlockTxs `protobuf:"bytes,13,opt,name=compact_block_txs,json=compactBlockTxs,proto3,oneof" json:"compact_block_txs,omitempty"`
+}
 
-func (*Message_NewRoundStep) isMessage_Sum()         {}
-func (*Message_NewValidBlock) isMessage_Sum()        {}
-func (*Message_Proposal) isMessage_Sum()             {}
-func (*Message_ProposalPol) isMessage_Sum()          {}
-func (*Message_BlockPart) isMessage_Sum()            {}
-func (*Message_Vote) isMessage_Sum()                 {}
-func (*Message_HasVote) isMessage_Sum()              {}
-func (*Message_VoteSetMaj23) isMessage_Sum()         {}
-func (*Message_VoteSetBits) isMessage_Sum()          {}
-func (*Message_HasProposalBlockPart) isMessage_Sum() {}
+func (*Message_NewRoundStep) isMessage_Sum()           {}
+func (*Message_NewValidBlock) isMessage_Sum()          {}
+func (*Message_Proposal) isMessage_Sum()               {}
+func (*Message_ProposalPol) isMessage_Sum()            {}
+func (*Message_BlockPart) isMessage_Sum()              {}
+func (*Message_Vote) isMessage_Sum()                   {}
+func (*Message_HasVote) isMessage_Sum()                {}
+func (*Message_VoteSetMaj23) isMessage_Sum()           {}
+func (*Message_VoteSetBits) isMessage_Sum()            {}
+func (*Message_HasProposalBlockPart) isMessage_Sum()   {}
+func (*Message_CompactBlock) isMessage_Sum()           {}
+func (*Message_CompactBlockTxsRequest) isMessage_Sum() {}
+func (*Message_CompactBlockTxs) isMessage_Sum()        {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -846,6 +1089,27 @@ func (m *Message) GetHasProposalBlockPart() *HasProposalBlockPart {
 	return nil
 }
 
+func (m *Message) GetCompactBlock() *CompactBlock {
+	if x, ok := m.GetSum().(*Message_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxsRequest() *CompactBlockTxsRequest {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxsRequest); ok {
+		return x.CompactBlockTxsRequest
+	}
+	return nil
+}
+
+func (m *Message) GetCompactBlockTxs() *CompactBlockTxs {
+	if x, ok := m.GetSum().(*Message_CompactBlockTxs); ok {
+		return x.CompactBlockTxs
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -859,6 +1123,9 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
 		(*Message_HasProposalBlockPart)(nil),
+		(*Message_CompactBlock)(nil),
+		(*Message_CompactBlockTxsRequest)(nil),
+		(*Message_CompactBlockTxs)(nil),
 	}
 }
 
@@ -873,70 +1140,86 @@ func init() {
 	proto.RegisterType((*VoteSetMaj23)(nil), "cometbft.consensus.v1.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "cometbft.consensus.v1.VoteSetBits")
 	proto.RegisterType((*HasProposalBlockPart)(nil), "cometbft.consensus.v1.HasProposalBlockPart")
+	proto.RegisterType((*CompactBlock)(nil), "cometbft.consensus.v1.CompactBlock")
+	proto.RegisterType((*CompactBlockTxsRequest)(nil), "cometbft.consensus.v1.CompactBlockTxsRequest")
+	proto.RegisterType((*CompactBlockTxs)(nil), "cometbft.consensus.v1.CompactBlockTxs")
 	proto.RegisterType((*Message)(nil), "cometbft.consensus.v1.Message")
 }
 
 func init() { proto.RegisterFile("cometbft/consensus/v1/types.proto", fileDescriptor_4179ae4c5322abef) }
 
 var fileDescriptor_4179ae4c5322abef = []byte{
-	// 903 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x9e, 0x21, 0x76, 0xec, 0x94, 0xf3, 0x03, 0xad, 0x84, 0xb5, 0xb2, 0xc2, 0x31, 0x03, 0x87,
-	0x88, 0x45, 0xb6, 0xe2, 0x20, 0x38, 0xac, 0x90, 0xd8, 0x01, 0xc1, 0x44, 0xbb, 0xc9, 0x5a, 0xed,
-	0xd5, 0x4a, 0xec, 0x65, 0x34, 0xf6, 0x34, 0x76, 0xc3, 0x78, 0x7a, 0x34, 0xdd, 0x71, 0xc8, 0x99,
-	0x17, 0xe0, 0x05, 0x78, 0x0c, 0x2e, 0x3c, 0xc1, 0x1e, 0xf7, 0xc8, 0x69, 0x85, 0x12, 0xf1, 0x0a,
-	0x70, 0x45, 0x5d, 0xd3, 0x1e, 0x8f, 0xbd, 0xf6, 0x86, 0x70, 0x40, 0xda, 0x5b, 0x4f, 0x57, 0xd5,
-	0xd7, 0xd5, 0x5f, 0x55, 0x7d, 0x3d, 0xf0, 0xfe, 0x40, 0x8c, 0x99, 0xea, 0x7f, 0xa7, 0xda, 0x03,
-	0x11, 0x4b, 0x16, 0xcb, 0x73, 0xd9, 0x9e, 0x1c, 0xb5, 0xd5, 0x65, 0xc2, 0x64, 0x2b, 0x49, 0x85,
-	0x12, 0x64, 0x6f, 0xea, 0xd2, 0xca, 0x5d, 0x5a, 0x93, 0xa3, 0xfd, 0xdd, 0xa1, 0x18, 0x0a, 0xf4,
-	0x68, 0xeb, 0x55, 0xe6, 0xbc, 0x3f, 0xc3, 0x8b, 0x78, 0x5f, 0xb6, 0xfb, 0x5c, 0x2d, 0xe2, 0xed,
-	0xbf, 0x97, 0xbb, 0xe0, 0xee, 0x82, 0xd9, 0xf9, 0xd5, 0x86, 0xcd, 0x33, 0x76, 0x41, 0xc5, 0x79,
-	0x1c, 0xf6, 0x14, 0x4b, 0xc8, 0xbb, 0xb0, 0x3e, 0x62, 0x7c, 0x38, 0x52, 0x75, 0xbb, 0x69, 0x1f,
-	0xae, 0x51, 0xf3, 0x45, 0x76, 0xa1, 0x9c, 0x6a, 0xa7, 0xfa, 0x5b, 0x4d, 0xfb, 0xb0, 0x4c, 0xb3,
-	0x0f, 0x42, 0xa0, 0x24, 0x15, 0x4b, 0xea, 0x6b, 0x4d, 0xfb, 0x70, 0x8b, 0xe2, 0x9a, 0x7c, 0x06,
-	0x75, 0xc9, 0x06, 0x22, 0x0e, 0xa5, 0x2f, 0x79, 0x3c, 0x60, 0xbe, 0x54, 0x41, 0xaa, 0x7c, 0xc5,
-	0xc7, 0xac, 0x5e, 0x42, 0xcc, 0x3d, 0x63, 0xef, 0x69, 0x73, 0x4f, 0x5b, 0x9f, 0xf0, 0x31, 0x23,
-	0x1f, 0xc1, 0x3b, 0x51, 0x20, 0x95, 0x3f, 0x10, 0xe3, 0x31, 0x57, 0x7e, 0x76, 0x5c, 0x19, 0x8f,
-	0xdb, 0xd1, 0x86, 0x2f, 0x71, 0x1f, 0x53, 0x75, 0xfe, 0xb6, 0x61, 0xeb, 0x8c, 0x5d, 0x3c, 0x0d,
-	0x22, 0x1e, 0xba, 0x91, 0x18, 0xfc, 0x70, 0xcb, 0xc4, 0xbf, 0x85, 0xbd, 0xbe, 0x0e, 0xf3, 0x13,
-	0x9d, 0x9b, 0x64, 0xca, 0x1f, 0xb1, 0x20, 0x64, 0x29, 0xde, 0xa4, 0xd6, 0x69, 0xb6, 0xf2, 0x32,
-	0x64, 0x6c, 0x4d, 0x8e, 0x5a, 0xdd, 0x20, 0x55, 0x3d, 0xa6, 0x3c, 0xf4, 0x73, 0x4b, 0xcf, 0x5f,
-	0x1e, 0x58, 0x94, 0x20, 0xc8, 0x9c, 0x85, 0x7c, 0x01, 0xb5, 0x19, 0xb4, 0xc4, 0x2b, 0xd7, 0x3a,
-	0x07, 0x33, 0x40, 0x5d, 0xaa, 0x96, 0x2e, 0x95, 0x06, 0x75, 0xb9, 0x7a, 0x90, 0xa6, 0xc1, 0x25,
-	0x85, 0x1c, 0x49, 0x92, 0xbb, 0xb0, 0xc1, 0xa5, 0xa1, 0x01, 0x09, 0xa8, 0xd2, 0x2a, 0x97, 0xd9,
-	0xf5, 0x9d, 0x13, 0xa8, 0x76, 0x53, 0x91, 0x08, 0x19, 0x44, 0xe4, 0x73, 0xa8, 0x26, 0x66, 0x8d,
-	0xb7, 0xae, 0x75, 0xee, 0x2e, 0x4b, 0xdc, 0xb8, 0x98, 0x9c, 0xf3, 0x10, 0xe7, 0x17, 0x1b, 0x6a,
-	0x53, 0x63, 0xf7, 0xf1, 0xa3, 0x95, 0x14, 0x7e, 0x0c, 0x64, 0x1a, 0xe3, 0x27, 0x22, 0xf2, 0x8b,
-	0x7c, 0xbe, 0x3d, 0xb5, 0x74, 0x45, 0x84, 0xa5, 0x21, 0x1e, 0x6c, 0x16, 0xbd, 0x0d, 0xa3, 0x37,
-	0x11, 0x60, 0x92, 0xab, 0x15, 0xe0, 0x9c, 0x08, 0x36, 0xdc, 0x29, 0x2b, 0xb7, 0xac, 0xef, 0x11,
-	0x94, 0x34, 0xfd, 0xe6, 0xf0, 0x3b, 0x2b, 0xca, 0x69, 0x0e, 0x45, 0x57, 0xe7, 0x18, 0x4a, 0x4f,
-	0x85, 0x62, 0xe4, 0x1e, 0x94, 0x26, 0x42, 0x31, 0x43, 0xe8, 0xb2, 0x50, 0xed, 0x46, 0xd1, 0xc9,
-	0xf9, 0xc9, 0x86, 0x8a, 0x17, 0x48, 0x0c, 0xbc, 0x5d, 0x86, 0x9f, 0x40, 0x49, 0x03, 0x62, 0x86,
-	0xdb, 0x4b, 0x1b, 0xae, 0xc7, 0x87, 0x31, 0x0b, 0x4f, 0xe5, 0xf0, 0xc9, 0x65, 0xc2, 0x28, 0x7a,
-	0x6b, 0x2c, 0x1e, 0x87, 0xec, 0x47, 0x6c, 0xab, 0x32, 0xcd, 0x3e, 0x9c, 0xdf, 0x6c, 0xd8, 0xd4,
-	0x29, 0xf4, 0x98, 0x3a, 0x0d, 0xbe, 0xef, 0x1c, 0xff, 0x2f, 0xa9, 0x7c, 0x0d, 0xd5, 0xac, 0xcf,
-	0x79, 0x68, 0x9a, 0x7c, 0x7f, 0x49, 0x24, 0x16, 0xf0, 0xe4, 0x2b, 0x77, 0x47, 0x33, 0x7d, 0xf5,
-	0xf2, 0xa0, 0x62, 0x36, 0x68, 0x05, 0x83, 0x4f, 0x42, 0xe7, 0x2f, 0x1b, 0x6a, 0x26, 0x79, 0x97,
-	0x2b, 0xf9, 0x26, 0xe5, 0x4e, 0xee, 0x43, 0x59, 0xb7, 0x81, 0xc4, 0x29, 0xfd, 0xd7, 0x4d, 0x9e,
-	0xc5, 0x38, 0xcf, 0x60, 0xd7, 0x0b, 0x64, 0x3e, 0x9d, 0xff, 0xb1, 0xd3, 0xf3, 0x8e, 0x58, 0x2b,
-	0x76, 0xc4, 0x9f, 0x65, 0xa8, 0x9c, 0x32, 0x29, 0x83, 0x21, 0x23, 0x0f, 0x61, 0x3b, 0x66, 0x17,
-	0xd9, 0xd4, 0xfa, 0x28, 0xd7, 0x59, 0x6b, 0x7f, 0xd0, 0x5a, 0xfa, 0xd6, 0xb4, 0x8a, 0xef, 0x81,
-	0x67, 0xd1, 0xcd, 0xb8, 0xf8, 0x3e, 0x9c, 0xc1, 0x8e, 0x06, 0x9b, 0x68, 0xe1, 0xf5, 0x91, 0x06,
-	0x4c, 0xa7, 0xd6, 0xf9, 0x70, 0x35, 0xda, 0x4c, 0xa5, 0x3d, 0x8b, 0x6e, 0xc5, 0x73, 0xb2, 0x5d,
-	0x94, 0xb0, 0x57, 0x94, 0x62, 0x0e, 0x68, 0x4a, 0x94, 0x57, 0x90, 0x30, 0xf2, 0xcd, 0x82, 0xd8,
-	0x64, 0xc5, 0x74, 0x6e, 0x80, 0xe8, 0x3e, 0x7e, 0xe4, 0xcd, 0x6b, 0x0d, 0x79, 0x00, 0x30, 0x53,
-	0x6d, 0x53, 0xce, 0xe6, 0x0a, 0x98, 0xbc, 0x54, 0x9e, 0x45, 0x37, 0x72, 0xdd, 0xd6, 0x9a, 0x83,
-	0xc2, 0xb1, 0xbe, 0xa8, 0xc4, 0x73, 0xc1, 0xba, 0xd5, 0x3d, 0x2b, 0x93, 0x0f, 0x72, 0x1f, 0xaa,
-	0xa3, 0x40, 0xfa, 0x18, 0x56, 0xc1, 0xb0, 0xc6, 0x8a, 0x30, 0x23, 0x32, 0x9e, 0x45, 0x2b, 0x23,
-	0xa3, 0x37, 0x0f, 0x61, 0x5b, 0x07, 0xe2, 0xeb, 0x35, 0xd6, 0x63, 0x5f, 0xaf, 0xbe, 0xb6, 0xae,
-	0x45, 0x85, 0xd0, 0x75, 0x9d, 0x14, 0x15, 0xc3, 0x83, 0xad, 0x1c, 0x4c, 0xb7, 0x6d, 0x7d, 0xe3,
-	0xb5, 0x4c, 0x16, 0x06, 0x56, 0x33, 0x39, 0x29, 0xcc, 0x6f, 0x08, 0x77, 0xf4, 0x9d, 0xf2, 0xb2,
-	0x14, 0x68, 0x05, 0xc4, 0xbc, 0xb7, 0xfa, 0x8a, 0xaf, 0x0c, 0x83, 0x67, 0xd1, 0xdd, 0xd1, 0x92,
-	0x7d, 0xb7, 0x0c, 0x6b, 0xf2, 0x7c, 0xec, 0x76, 0x9f, 0x5f, 0x35, 0xec, 0x17, 0x57, 0x0d, 0xfb,
-	0x8f, 0xab, 0x86, 0xfd, 0xf3, 0x75, 0xc3, 0x7a, 0x71, 0xdd, 0xb0, 0x7e, 0xbf, 0x6e, 0x58, 0xcf,
-	0x3e, 0x1d, 0x72, 0x35, 0x3a, 0xef, 0xeb, 0xb3, 0xda, 0x85, 0xdf, 0x2e, 0xb3, 0x08, 0x12, 0xde,
-	0x5e, 0xfa, 0x33, 0xd6, 0x5f, 0xc7, 0x1f, 0xa3, 0xe3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x64,
-	0x03, 0x11, 0x71, 0xac, 0x09, 0x00, 0x00,
+	// 1119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x26, 0x23, 0xc9, 0x94, 0x0f, 0xa5, 0x28, 0x19, 0xf8, 0xc2, 0x38, 0xf8, 0x65, 0xfd, 0x6c,
+	0x51, 0x08, 0x4d, 0x2b, 0xc1, 0x72, 0xd1, 0x00, 0x0d, 0x0a, 0xd4, 0x4a, 0x2f, 0x74, 0x63, 0x3b,
+	0xc2, 0xc8, 0x08, 0xd0, 0x6c, 0x58, 0x8a, 0x9c, 0x4a, 0x8c, 0x25, 0x92, 0xd5, 0x8c, 0x64, 0x69,
+	0xdd, 0x17, 0xe8, 0x0b, 0xf4, 0x31, 0xba, 0xe9, 0x13, 0x64, 0x99, 0x65, 0x37, 0x0d, 0x0a, 0xfb,
+	0x11, 0x0a, 0xb4, 0xdb, 0x62, 0x86, 0x14, 0x45, 0xc9, 0x92, 0x6b, 0xa5, 0x45, 0x81, 0xee, 0xe6,
+	0x72, 0xce, 0x77, 0xce, 0x9c, 0xdb, 0x47, 0xc2, 0xff, 0x6d, 0xbf, 0x47, 0x58, 0xeb, 0x1b, 0x56,
+	0xb5, 0x7d, 0x8f, 0x12, 0x8f, 0x0e, 0x68, 0x75, 0xb8, 0x57, 0x65, 0xe3, 0x80, 0xd0, 0x4a, 0xd0,
+	0xf7, 0x99, 0x8f, 0x36, 0x27, 0x22, 0x95, 0x58, 0xa4, 0x32, 0xdc, 0xdb, 0xd9, 0x68, 0xfb, 0x6d,
+	0x5f, 0x48, 0x54, 0xf9, 0x2a, 0x14, 0xde, 0x99, 0xe2, 0x75, 0xdd, 0x16, 0xad, 0xb6, 0x5c, 0x36,
+	0x8f, 0xb7, 0x53, 0x8a, 0x45, 0xc4, 0x29, 0xbf, 0x26, 0x43, 0xd7, 0x21, 0x9e, 0x4d, 0x22, 0x89,
+	0xff, 0x5d, 0x95, 0x48, 0x00, 0xe8, 0x3f, 0xca, 0x90, 0x3b, 0x21, 0xe7, 0xd8, 0x1f, 0x78, 0x4e,
+	0x93, 0x91, 0x00, 0x6d, 0xc1, 0x5a, 0x87, 0xb8, 0xed, 0x0e, 0xd3, 0xe4, 0x92, 0x5c, 0x4e, 0xe1,
+	0x68, 0x87, 0x36, 0x20, 0xd3, 0xe7, 0x42, 0xda, 0xad, 0x92, 0x5c, 0xce, 0xe0, 0x70, 0x83, 0x10,
+	0xa4, 0x29, 0x23, 0x81, 0x96, 0x2a, 0xc9, 0xe5, 0x3c, 0x16, 0x6b, 0xf4, 0x10, 0x34, 0x4a, 0x6c,
+	0xdf, 0x73, 0xa8, 0x49, 0x5d, 0xcf, 0x26, 0x26, 0x65, 0x56, 0x9f, 0x99, 0xcc, 0xed, 0x11, 0x2d,
+	0x2d, 0x30, 0x37, 0xa3, 0xfb, 0x26, 0xbf, 0x6e, 0xf2, 0xdb, 0x53, 0xb7, 0x47, 0xd0, 0xbb, 0x70,
+	0xb7, 0x6b, 0x51, 0x66, 0xda, 0x7e, 0xaf, 0xe7, 0x32, 0x33, 0x34, 0x97, 0x11, 0xe6, 0x0a, 0xfc,
+	0xe2, 0xb1, 0x38, 0x17, 0xae, 0xea, 0x7f, 0xc8, 0x90, 0x3f, 0x21, 0xe7, 0xcf, 0xac, 0xae, 0xeb,
+	0xd4, 0xbb, 0xbe, 0x7d, 0xb6, 0xa2, 0xe3, 0x5f, 0xc1, 0x66, 0x8b, 0xab, 0x99, 0x01, 0xf7, 0x8d,
+	0x12, 0x66, 0x76, 0x88, 0xe5, 0x90, 0xbe, 0x78, 0x89, 0x5a, 0x2b, 0x55, 0xe2, 0x44, 0x85, 0xd1,
+	0x1a, 0xee, 0x55, 0x1a, 0x56, 0x9f, 0x35, 0x09, 0x33, 0x84, 0x5c, 0x3d, 0xfd, 0xf2, 0xf5, 0xae,
+	0x84, 0x91, 0x00, 0x99, 0xb9, 0x41, 0x9f, 0x80, 0x3a, 0x85, 0xa6, 0xe2, 0xc9, 0x6a, 0x6d, 0x77,
+	0x0a, 0xc8, 0x93, 0x59, 0xe1, 0xc9, 0xe4, 0xa0, 0x75, 0x97, 0x1d, 0xf4, 0xfb, 0xd6, 0x18, 0x43,
+	0x8c, 0x44, 0xd1, 0x7d, 0x58, 0x77, 0x69, 0x14, 0x06, 0x11, 0x80, 0x2c, 0xce, 0xba, 0x34, 0x7c,
+	0xbe, 0x7e, 0x08, 0xd9, 0x46, 0xdf, 0x0f, 0x7c, 0x6a, 0x75, 0xd1, 0xc7, 0x90, 0x0d, 0xa2, 0xb5,
+	0x78, 0xb5, 0x5a, 0xbb, 0xbf, 0xc8, 0xf1, 0x48, 0x24, 0xf2, 0x39, 0x56, 0xd1, 0x7f, 0x90, 0x41,
+	0x9d, 0x5c, 0x36, 0x9e, 0x1e, 0x2d, 0x0d, 0xe1, 0x7b, 0x80, 0x26, 0x3a, 0x66, 0xe0, 0x77, 0xcd,
+	0x64, 0x3c, 0xef, 0x4c, 0x6e, 0x1a, 0x7e, 0x57, 0xa4, 0x06, 0x19, 0x90, 0x4b, 0x4a, 0x6b, 0xa9,
+	0x1b, 0x05, 0x20, 0x72, 0x4e, 0x4d, 0xc0, 0xe9, 0x5d, 0x58, 0xaf, 0x4f, 0xa2, 0xb2, 0x62, 0x7e,
+	0xf7, 0x20, 0xcd, 0xc3, 0x1f, 0x19, 0xdf, 0x5e, 0x92, 0xce, 0xc8, 0xa8, 0x10, 0xd5, 0xf7, 0x21,
+	0xfd, 0xcc, 0x67, 0x04, 0x3d, 0x80, 0xf4, 0xd0, 0x67, 0x44, 0x93, 0x97, 0xaa, 0x72, 0x31, 0x2c,
+	0x84, 0xf4, 0xef, 0x64, 0x50, 0x0c, 0x8b, 0x0a, 0xc5, 0xd5, 0x3c, 0xfc, 0x00, 0xd2, 0x1c, 0x50,
+	0x78, 0x78, 0x7b, 0x61, 0xc1, 0x35, 0xdd, 0xb6, 0x47, 0x9c, 0x63, 0xda, 0x3e, 0x1d, 0x07, 0x04,
+	0x0b, 0x69, 0x8e, 0xe5, 0x7a, 0x0e, 0x19, 0x89, 0xb2, 0xca, 0xe0, 0x70, 0xa3, 0xff, 0x24, 0x43,
+	0x8e, 0xbb, 0xd0, 0x24, 0xec, 0xd8, 0x7a, 0x51, 0xdb, 0xff, 0x57, 0x5c, 0xf9, 0x1c, 0xb2, 0x61,
+	0x9d, 0xbb, 0x4e, 0x54, 0xe4, 0x3b, 0x0b, 0x34, 0x45, 0x02, 0x0f, 0x3f, 0xad, 0x17, 0x78, 0xa4,
+	0x2f, 0x5e, 0xef, 0x2a, 0xd1, 0x01, 0x56, 0x84, 0xf2, 0xa1, 0xa3, 0xff, 0x2e, 0x83, 0x1a, 0x39,
+	0x5f, 0x77, 0x19, 0xfd, 0x2f, 0xf9, 0x8e, 0x1e, 0x41, 0x86, 0x97, 0x01, 0xd5, 0x32, 0xab, 0x14,
+	0x79, 0xa8, 0xa3, 0x3f, 0x87, 0x0d, 0xc3, 0xa2, 0x71, 0x77, 0xbe, 0x61, 0xa5, 0xc7, 0x15, 0x91,
+	0x4a, 0x56, 0xc4, 0x2f, 0xb7, 0x20, 0xf7, 0xd8, 0xef, 0x05, 0x96, 0xcd, 0xde, 0x64, 0x3c, 0x9e,
+	0x40, 0xe1, 0xef, 0x0d, 0xc6, 0x7c, 0x90, 0x3c, 0x44, 0x0f, 0xb9, 0x75, 0x01, 0x13, 0x46, 0xfb,
+	0xde, 0x02, 0x98, 0x19, 0xfd, 0x48, 0x1c, 0x7d, 0x04, 0x6a, 0x82, 0x13, 0xb4, 0xcc, 0x52, 0xed,
+	0x88, 0x1c, 0x60, 0x4a, 0x14, 0xe8, 0x00, 0xb2, 0x13, 0x32, 0xd4, 0xd6, 0xe6, 0xf3, 0x13, 0x2b,
+	0x7e, 0x16, 0x89, 0x1c, 0xb9, 0x74, 0x32, 0x0f, 0x62, 0x35, 0xb4, 0x0d, 0x0a, 0x1b, 0x99, 0x67,
+	0x64, 0x4c, 0x35, 0xa5, 0x94, 0x2a, 0xe7, 0xf0, 0x1a, 0x1b, 0x3d, 0x21, 0x63, 0xaa, 0x7f, 0x0d,
+	0x5b, 0xc9, 0xf0, 0x9e, 0x8e, 0x28, 0x26, 0xdf, 0x0e, 0x08, 0x5d, 0x35, 0x7b, 0x1a, 0x28, 0x22,
+	0x61, 0x84, 0x6a, 0xa9, 0x52, 0xaa, 0x9c, 0xc7, 0x93, 0xad, 0x7e, 0x06, 0x85, 0x39, 0x0b, 0xff,
+	0x14, 0x34, 0xba, 0x03, 0x29, 0x36, 0xe2, 0xcc, 0xc4, 0x5f, 0xc4, 0x97, 0xfa, 0x6f, 0x0a, 0x28,
+	0xc7, 0x84, 0x52, 0xab, 0x4d, 0xd0, 0x13, 0xb8, 0xed, 0x91, 0xf3, 0x70, 0xc8, 0x9b, 0x82, 0xdd,
+	0xc3, 0x49, 0xf8, 0x56, 0x65, 0xe1, 0xc7, 0x4b, 0x25, 0xf9, 0xf9, 0x60, 0x48, 0x38, 0xe7, 0x25,
+	0xf6, 0xbc, 0x90, 0x38, 0xd8, 0x90, 0xf3, 0xb4, 0x29, 0xba, 0x46, 0x38, 0xa9, 0xd6, 0xde, 0x5e,
+	0x8e, 0x36, 0x25, 0x75, 0x43, 0xc2, 0x79, 0x2f, 0x79, 0x30, 0xc3, 0x78, 0x57, 0x88, 0x65, 0x06,
+	0x68, 0xd2, 0x57, 0x46, 0x82, 0xf1, 0xd0, 0x17, 0x73, 0xdc, 0x14, 0x56, 0xa3, 0xfe, 0x17, 0x10,
+	0x8d, 0xa7, 0x47, 0xc6, 0x2c, 0x35, 0xa1, 0x03, 0x80, 0x29, 0xc9, 0x6b, 0x99, 0xf9, 0xde, 0x98,
+	0x81, 0x89, 0x3b, 0xdb, 0x90, 0xf0, 0x7a, 0x4c, 0xf3, 0x9c, 0xa2, 0x04, 0xcf, 0xac, 0xcd, 0x13,
+	0xf7, 0x8c, 0x32, 0x9f, 0x8c, 0x86, 0x14, 0xb2, 0x0d, 0x7a, 0x04, 0xd9, 0x8e, 0x45, 0x4d, 0xa1,
+	0xa6, 0x08, 0xb5, 0xe2, 0x12, 0xb5, 0x88, 0x93, 0x0c, 0x09, 0x2b, 0x9d, 0x70, 0xc9, 0xf3, 0xca,
+	0x15, 0x45, 0x4f, 0xf7, 0x38, 0x4b, 0x68, 0xd9, 0x6b, 0xf3, 0x9a, 0x24, 0x14, 0x9e, 0xd7, 0x61,
+	0x62, 0x8f, 0x0c, 0xc8, 0xc7, 0x60, 0x7c, 0xca, 0x69, 0xeb, 0xd7, 0x46, 0x32, 0x31, 0xdf, 0x79,
+	0x24, 0x87, 0xd3, 0x2d, 0x72, 0x60, 0x9b, 0xbf, 0x29, 0x4e, 0x4b, 0x22, 0xac, 0x20, 0x30, 0x1f,
+	0x2c, 0x7f, 0xe2, 0x95, 0xd9, 0x69, 0x48, 0x78, 0xa3, 0xb3, 0xe0, 0x1c, 0x7d, 0x09, 0x79, 0x3b,
+	0xec, 0xa6, 0xa8, 0x0a, 0xd5, 0x6b, 0xdf, 0x9e, 0xec, 0x3c, 0xfe, 0x76, 0x3b, 0xb1, 0x47, 0x2f,
+	0xe0, 0xde, 0x0c, 0x96, 0xc9, 0x46, 0xd4, 0xec, 0x87, 0xed, 0xaf, 0xe5, 0x04, 0xee, 0xfb, 0x37,
+	0xc0, 0x9d, 0xce, 0x0c, 0x43, 0xc2, 0x5b, 0xf6, 0xc2, 0x1b, 0x74, 0x0a, 0x77, 0xaf, 0xd8, 0xd2,
+	0xf2, 0xc2, 0xc6, 0x3b, 0x37, 0xb3, 0x61, 0x48, 0xb8, 0x30, 0x07, 0x5e, 0xcf, 0x40, 0x8a, 0x0e,
+	0x7a, 0xf5, 0xc6, 0xcb, 0x8b, 0xa2, 0xfc, 0xea, 0xa2, 0x28, 0xff, 0x7a, 0x51, 0x94, 0xbf, 0xbf,
+	0x2c, 0x4a, 0xaf, 0x2e, 0x8b, 0xd2, 0xcf, 0x97, 0x45, 0xe9, 0xf9, 0x87, 0x6d, 0x97, 0x75, 0x06,
+	0x2d, 0x6e, 0xa1, 0x9a, 0xf8, 0xab, 0x89, 0x16, 0x56, 0xe0, 0x56, 0x17, 0xfe, 0xeb, 0xb4, 0xd6,
+	0xc4, 0x5f, 0xc5, 0xfe, 0x9f, 0x03, 0x00, 0x4f, 0xf5, 0x2f, 0xf1, 0x0b, 0x0d, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1393,7 +1676,7 @@ func (m *HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *CompactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1403,38 +1686,38 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	if len(m.TxKeys) > 0 {
+		for iNdEx := len(m.TxKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxKeys[iNdEx])
+			copy(dAtA[i:], m.TxKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TxKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewRoundStep != nil {
+	{
+		size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LastCommit != nil {
 		{
-			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastCommit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1442,34 +1725,229 @@ func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *CompactBlockTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.NewValidBlock != nil {
-		{
-			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA15 := make([]byte, len(m.Indexes)*10)
+		var j14 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTypes(dAtA, i, uint64(j14))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *CompactBlockTxs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Indexes) > 0 {
+		dAtA17 := make([]byte, len(m.Indexes)*10)
+		var j16 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTypes(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewRoundStep != nil {
+		{
+			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewValidBlock != nil {
+		{
+			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -1635,6 +2113,69 @@ func (m *Message_HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_CompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlock != nil {
+		{
+			size, err := m.CompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxsRequest != nil {
+		{
+			size, err := m.CompactBlockTxsRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CompactBlockTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlockTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlockTxs != nil {
+		{
+			size, err := m.CompactBlockTxs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1833,6 +2374,87 @@ func (m *HasProposalBlockPart) Size() (n int) {
 	return n
 }
 
+func (m *CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.PartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Header.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LastCommit != nil {
+		l = m.LastCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Evidence.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.TxKeys) > 0 {
+		for _, b := range m.TxKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1965,6 +2587,42 @@ func (m *Message_HasProposalBlockPart) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlock != nil {
+		l = m.CompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxsRequest != nil {
+		l = m.CompactBlockTxsRequest.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_CompactBlockTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlockTxs != nil {
+		l = m.CompactBlockTxs.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -3251,7 +3909,7 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *CompactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,17 +3932,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3294,17 +3952,632 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCommit == nil {
+				m.LastCommit = &v1.Commit{}
+			}
+			if err := m.LastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxKeys = append(m.TxKeys, make([]byte, postIndex-iNdEx))
+			copy(m.TxKeys[len(m.TxKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactBlockTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactBlockTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactBlockTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactBlockTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactBlockTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactBlockTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -3630,6 +4903,111 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_HasProposalBlockPart{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlock{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxsRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxsRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxsRequest{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlockTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlockTxs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlockTxs{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,1305 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/mempool/v1/mempool.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxRemovalReason is the reason why a transaction was removed from the
+// mempool.
+type TxRemovalReason int32
+
+const (
+	// Unknown
+	TX_REMOVAL_REASON_UNKNOWN TxRemovalReason = 0
+	// Removed by the node, e.g. via an RPC call
+	TX_REMOVAL_REASON_MANUALLY TxRemovalReason = 1
+	// Included in a committed block
+	TX_REMOVAL_REASON_COMMITTED TxRemovalReason = 2
+	// Rejected by the application when rechecked
+	TX_REMOVAL_REASON_INVALID TxRemovalReason = 3
+	// Evicted to make room for a transaction with a higher priority
+	TX_REMOVAL_REASON_EVICTED TxRemovalReason = 4
+	// Stayed in the mempool for longer than the configured TTL
+	TX_REMOVAL_REASON_EXPIRED TxRemovalReason = 5
+	// The mempool was flushed
+	TX_REMOVAL_REASON_FLUSHED TxRemovalReason = 6
+	// Replaced by a transaction with the same sender and nonce
+	TX_REMOVAL_REASON_REPLACED TxRemovalReason = 7
+)
+
+var TxRemovalReason_name = map[int32]string{
+	0: "TX_REMOVAL_REASON_UNKNOWN",
+	1: "TX_REMOVAL_REASON_MANUALLY",
+	2: "TX_REMOVAL_REASON_COMMITTED",
+	3: "TX_REMOVAL_REASON_INVALID",
+	4: "TX_REMOVAL_REASON_EVICTED",
+	5: "TX_REMOVAL_REASON_EXPIRED",
+	6: "TX_REMOVAL_REASON_FLUSHED",
+	7: "TX_REMOVAL_REASON_REPLACED",
+}
+
+var TxRemovalReason_value = map[string]int32{
+	"TX_REMOVAL_REASON_UNKNOWN":   0,
+	"TX_REMOVAL_REASON_MANUALLY":  1,
+	"TX_REMOVAL_REASON_COMMITTED": 2,
+	"TX_REMOVAL_REASON_INVALID":   3,
+	"TX_REMOVAL_REASON_EVICTED":   4,
+	"TX_REMOVAL_REASON_EXPIRED":   5,
+	"TX_REMOVAL_REASON_FLUSHED":   6,
+	"TX_REMOVAL_REASON_REPLACED":  7,
+}
+
+func (x TxRemovalReason) String() string {
+	return proto.EnumName(TxRemovalReason_name, int32(x))
+}
+
+func (TxRemovalReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_537fd2c7761764fe, []int{0}
+}
+
+// SubscribeRequest is a request for a stream of the transactions added to and
+// removed from the mempool.
+type SubscribeRequest struct {
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_537fd2c7761764fe, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+// SubscribeResponse reports that a transaction was added to or removed from
+// the mempool.
+type SubscribeResponse struct {
+	// Types that are valid to be assigned to Event:
+	//	*SubscribeResponse_Added
+	//	*SubscribeResponse_Removed
+	Event isSubscribeResponse_Event `protobuf_oneof:"event"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_537fd2c7761764fe, []int{1}
+}
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+type isSubscribeResponse_Event interface {
+	isSubscribeResponse_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SubscribeResponse_Added struct {
+	Added *TxAdded `protobuf:"bytes,1,opt,name=added,proto3,oneof" json:"added,omitempty"`
+}
+type SubscribeResponse_Removed struct {
+	Removed *TxRemoved `protobuf:"bytes,2,opt,name=removed,proto3,oneof" json:"removed,omitempty"`
+}
+
+func (*SubscribeResponse_Added) isSubscribeResponse_Event()   {}
+func (*SubscribeResponse_Removed) isSubscribeResponse_Event() {}
+
+func (m *SubscribeResponse) GetEvent() isSubscribeResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetAdded() *TxAdded {
+	if x, ok := m.GetEvent().(*SubscribeResponse_Added); ok {
+		return x.Added
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetRemoved() *TxRemoved {
+	if x, ok := m.GetEvent().(*SubscribeResponse_Removed); ok {
+		return x.Removed
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubscribeResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubscribeResponse_Added)(nil),
+		(*SubscribeResponse_Removed)(nil),
+	}
+}
+
+// TxAdded is a transaction added to the mempool, with the values assigned to
+// it by the application in CheckTx.
+type TxAdded struct {
+	Tx   []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The height at which the transaction was checked.
+	Height   int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Priority int64  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Lane     string `protobuf:"bytes,5,opt,name=lane,proto3" json:"lane,omitempty"`
+	Sender   string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	Nonce    uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *TxAdded) Reset()         { *m = TxAdded{} }
+func (m *TxAdded) String() string { return proto.CompactTextString(m) }
+func (*TxAdded) ProtoMessage()    {}
+func (*TxAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_537fd2c7761764fe, []int{2}
+}
+func (m *TxAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxAdded.Merge(m, src)
+}
+func (m *TxAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxAdded proto.InternalMessageInfo
+
+func (m *TxAdded) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxAdded) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxAdded) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxAdded) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TxAdded) GetLane() string {
+	if m != nil {
+		return m.Lane
+	}
+	return ""
+}
+
+func (m *TxAdded) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TxAdded) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// TxRemoved is a transaction removed from the mempool.
+type TxRemoved struct {
+	Hash   []byte          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Reason TxRemovalReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cometbft.services.mempool.v1.TxRemovalReason" json:"reason,omitempty"`
+}
+
+func (m *TxRemoved) Reset()         { *m = TxRemoved{} }
+func (m *TxRemoved) String() string { return proto.CompactTextString(m) }
+func (*TxRemoved) ProtoMessage()    {}
+func (*TxRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_537fd2c7761764fe, []int{3}
+}
+func (m *TxRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRemoved.Merge(m, src)
+}
+func (m *TxRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRemoved proto.InternalMessageInfo
+
+func (m *TxRemoved) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxRemoved) GetReason() TxRemovalReason {
+	if m != nil {
+		return m.Reason
+	}
+	return TX_REMOVAL_REASON_UNKNOWN
+}
+
+func init() {
+	proto.RegisterEnum("cometbft.services.mempool.v1.TxRemovalReason", TxRemovalReason_name, TxRemovalReason_value)
+	proto.RegisterType((*SubscribeRequest)(nil), "cometbft.services.mempool.v1.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "cometbft.services.mempool.v1.SubscribeResponse")
+	proto.RegisterType((*TxAdded)(nil), "cometbft.services.mempool.v1.TxAdded")
+	proto.RegisterType((*TxRemoved)(nil), "cometbft.services.mempool.v1.TxRemoved")
+}
+
+func init() {
+	proto.RegisterFile("cometbft/services/mempool/v1/mempool.proto", fileDescriptor_537fd2c7761764fe)
+}
+
+var fileDescriptor_537fd2c7761764fe = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xf5, 0xe4, 0x49, 0x87, 0xaa, 0x98, 0x51, 0x85, 0x4c, 0x00, 0x13, 0x45, 0x42, 0x44, 0x95,
+	0x88, 0xd5, 0xb2, 0xee, 0xc2, 0x8d, 0x8d, 0x62, 0xe1, 0x38, 0xd5, 0xe4, 0xd1, 0xc2, 0x26, 0xb2,
+	0xe3, 0x69, 0x6c, 0x29, 0xf1, 0x18, 0x7b, 0x62, 0x85, 0x3f, 0x60, 0x89, 0xc4, 0x0f, 0x20, 0xf5,
+	0x67, 0x58, 0x76, 0xc9, 0x12, 0x25, 0x3f, 0x82, 0x3c, 0x89, 0xad, 0x8a, 0x86, 0xc2, 0xee, 0x5c,
+	0x9f, 0xc7, 0x3d, 0x1e, 0xe9, 0xc2, 0xa3, 0x09, 0x9d, 0x13, 0xe6, 0x5c, 0x31, 0x25, 0x26, 0x51,
+	0xe2, 0x4f, 0x48, 0xac, 0xcc, 0xc9, 0x3c, 0xa4, 0x74, 0xa6, 0x24, 0xc7, 0x19, 0x6c, 0x85, 0x11,
+	0x65, 0x14, 0x3d, 0xcf, 0xb4, 0xad, 0x4c, 0xdb, 0xca, 0x04, 0xc9, 0x71, 0xed, 0x70, 0x4a, 0xa7,
+	0x94, 0x0b, 0x95, 0x14, 0x6d, 0x3c, 0x0d, 0x04, 0xc5, 0xfe, 0xc2, 0x89, 0x27, 0x91, 0xef, 0x10,
+	0x4c, 0x3e, 0x2d, 0x48, 0xcc, 0x1a, 0xdf, 0x01, 0x7c, 0x7c, 0xeb, 0x63, 0x1c, 0xd2, 0x20, 0x26,
+	0xe8, 0x14, 0x96, 0x6d, 0xd7, 0x25, 0xae, 0x04, 0xea, 0xa0, 0xf9, 0xf0, 0xe4, 0x55, 0xeb, 0xbe,
+	0x6d, 0xad, 0xc1, 0x52, 0x4d, 0xc5, 0x1d, 0x01, 0x6f, 0x5c, 0xa8, 0x0d, 0xab, 0x11, 0x99, 0xd3,
+	0x84, 0xb8, 0x52, 0x81, 0x07, 0xbc, 0xfe, 0x57, 0x00, 0xde, 0xc8, 0x3b, 0x02, 0xce, 0x9c, 0x67,
+	0x55, 0x58, 0x26, 0x09, 0x09, 0x58, 0xe3, 0x1a, 0xc0, 0xea, 0x76, 0x05, 0x3a, 0x80, 0x05, 0xb6,
+	0xe4, 0xad, 0xf6, 0x71, 0x81, 0x2d, 0x11, 0x82, 0x25, 0xcf, 0x8e, 0x3d, 0xbe, 0x66, 0x1f, 0x73,
+	0x8c, 0x9e, 0xc0, 0x8a, 0x47, 0xfc, 0xa9, 0xc7, 0xa4, 0x62, 0x1d, 0x34, 0x8b, 0x78, 0x3b, 0xa1,
+	0x1a, 0x7c, 0x10, 0x46, 0x3e, 0x8d, 0x7c, 0xf6, 0x59, 0x2a, 0x71, 0x26, 0x9f, 0xd3, 0x9c, 0x99,
+	0x1d, 0x10, 0xa9, 0x5c, 0x07, 0xcd, 0x3d, 0xcc, 0x71, 0x9a, 0x13, 0x93, 0xc0, 0x25, 0x91, 0x54,
+	0xe1, 0x5f, 0xb7, 0x13, 0x3a, 0x84, 0xe5, 0x80, 0x06, 0x13, 0x22, 0x55, 0xeb, 0xa0, 0x59, 0xc2,
+	0x9b, 0xa1, 0x71, 0x05, 0xf7, 0xf2, 0xdf, 0xc8, 0x6b, 0x81, 0x5b, 0xb5, 0x74, 0x58, 0x89, 0x88,
+	0x1d, 0xd3, 0x80, 0x97, 0x3d, 0x38, 0x79, 0xf3, 0x5f, 0x6f, 0x62, 0xcf, 0x30, 0x37, 0xe1, 0xad,
+	0xf9, 0xe8, 0x5b, 0x01, 0x3e, 0xfa, 0x83, 0x43, 0x2f, 0xe0, 0xd3, 0xc1, 0xe5, 0x18, 0xeb, 0xdd,
+	0xde, 0x48, 0x35, 0xc7, 0x58, 0x57, 0xfb, 0x3d, 0x6b, 0x3c, 0xb4, 0xde, 0x5b, 0xbd, 0x0b, 0x4b,
+	0x14, 0x90, 0x0c, 0x6b, 0x77, 0xe9, 0xae, 0x6a, 0x0d, 0x55, 0xd3, 0xfc, 0x20, 0x02, 0xf4, 0x12,
+	0x3e, 0xbb, 0xcb, 0xb7, 0x7b, 0xdd, 0xae, 0x31, 0x18, 0xe8, 0x9a, 0x58, 0xd8, 0x9d, 0x6f, 0x58,
+	0x23, 0xd5, 0x34, 0x34, 0xb1, 0xb8, 0x9b, 0xd6, 0x47, 0x46, 0x3b, 0x75, 0x97, 0xfe, 0x42, 0x5f,
+	0x9e, 0x1b, 0x58, 0xd7, 0xc4, 0xf2, 0x6e, 0xfa, 0x9d, 0x39, 0xec, 0x77, 0x74, 0x4d, 0xac, 0xec,
+	0x2e, 0x8f, 0xf5, 0x73, 0x53, 0x6d, 0xeb, 0x9a, 0x58, 0xad, 0x95, 0xbe, 0x5c, 0xcb, 0xc2, 0xd9,
+	0xc5, 0x8f, 0x95, 0x0c, 0x6e, 0x56, 0x32, 0xf8, 0xb5, 0x92, 0xc1, 0xd7, 0xb5, 0x2c, 0xdc, 0xac,
+	0x65, 0xe1, 0xe7, 0x5a, 0x16, 0x3e, 0x9e, 0x4e, 0x7d, 0xe6, 0x2d, 0x9c, 0xf4, 0xb1, 0x95, 0xfc,
+	0xbe, 0x72, 0x60, 0x87, 0xbe, 0x72, 0xdf, 0xd5, 0x39, 0x15, 0x7e, 0x3a, 0x6f, 0x7f, 0x0f, 0x00,
+	0xff, 0x95, 0x15, 0x38, 0x9c, 0x03, 0x00, 0x00,
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeResponse_Added) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse_Added) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Added != nil {
+		{
+			size, err := m.Added.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMempool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubscribeResponse_Removed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse_Removed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Removed != nil {
+		{
+			size, err := m.Removed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMempool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TxAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Lane) > 0 {
+		i -= len(m.Lane)
+		copy(dAtA[i:], m.Lane)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Lane)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintMempool(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMempool(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMempool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMempool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *SubscribeResponse_Added) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Added != nil {
+		l = m.Added.Size()
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	return n
+}
+func (m *SubscribeResponse_Removed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != nil {
+		l = m.Removed.Size()
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	return n
+}
+func (m *TxAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMempool(uint64(m.Height))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovMempool(uint64(m.Priority))
+	}
+	l = len(m.Lane)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMempool(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *TxRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMempool(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMempool(uint64(m.Reason))
+	}
+	return n
+}
+
+func sovMempool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMempool(x uint64) (n int) {
+	return sovMempool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxAdded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &SubscribeResponse_Added{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxRemoved{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &SubscribeResponse_Removed{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMempool
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= TxRemovalReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMempool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMempool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMempool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMempool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMempool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMempool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMempool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMempool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMempool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMempool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMempool = fmt.Errorf("proto: unexpected end of group")
+)
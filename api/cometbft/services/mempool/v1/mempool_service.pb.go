@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/mempool/v1/mempool_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/mempool/v1/mempool_service.proto", fileDescriptor_f8560b1ab7181466)
+}
+
+var fileDescriptor_f8560b1ab7181466 = []byte{
+	// 187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0xcf, 0x4d,
+	0xcd, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x84, 0x31, 0xe3, 0xa1, 0x72, 0x7a, 0x05, 0x45,
+	0xf9, 0x25, 0xf9, 0x42, 0x32, 0x30, 0x3d, 0x7a, 0x30, 0x3d, 0x7a, 0x50, 0x85, 0x7a, 0x65, 0x86,
+	0x52, 0x5a, 0xc4, 0x98, 0x08, 0x31, 0xc9, 0xa8, 0x81, 0x91, 0x8b, 0xcf, 0x17, 0x22, 0x12, 0x0c,
+	0x51, 0x2c, 0x94, 0xc7, 0xc5, 0x19, 0x5c, 0x9a, 0x54, 0x9c, 0x5c, 0x94, 0x99, 0x94, 0x2a, 0xa4,
+	0xa7, 0x87, 0xcf, 0x2a, 0x3d, 0xb8, 0xc2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x29, 0x7d,
+	0xa2, 0xd5, 0x17, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x30, 0x3a, 0x85, 0x9f, 0x78, 0x24, 0xc7,
+	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
+	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6d, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x12, 0xc8, 0x48,
+	0x7d, 0xb8, 0x9f, 0xe0, 0x8c, 0xc4, 0x82, 0x4c, 0x7d, 0x7c, 0x3e, 0x4d, 0x62, 0x03, 0x7b, 0xd1,
+	0x18, 0x30, 0x00, 0xa2, 0x78, 0x39, 0x07, 0x62, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolServiceClient is the client API for MempoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolServiceClient interface {
+	// Subscribe returns a stream of the transactions added to and removed from
+	// the mempool, with the reason why they were removed. This is a long-lived
+	// stream that is only terminated by the server if an error occurs, or if
+	// the client does not keep up with the events. The caller is expected to
+	// handle such disconnections and automatically reconnect.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (MempoolService_SubscribeClient, error)
+}
+
+type mempoolServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolServiceClient(cc grpc1.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{cc}
+}
+
+func (c *mempoolServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (MempoolService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[0], "/cometbft.services.mempool.v1.MempoolService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MempoolService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MempoolServiceServer is the server API for MempoolService service.
+type MempoolServiceServer interface {
+	// Subscribe returns a stream of the transactions added to and removed from
+	// the mempool, with the reason why they were removed. This is a long-lived
+	// stream that is only terminated by the server if an error occurs, or if
+	// the client does not keep up with the events. The caller is expected to
+	// handle such disconnections and automatically reconnect.
+	Subscribe(*SubscribeRequest, MempoolService_SubscribeServer) error
+}
+
+// UnimplementedMempoolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServiceServer struct {
+}
+
+func (*UnimplementedMempoolServiceServer) Subscribe(req *SubscribeRequest, srv MempoolService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterMempoolServiceServer(s grpc1.Server, srv MempoolServiceServer) {
+	s.RegisterService(&_MempoolService_serviceDesc, srv)
+}
+
+func _MempoolService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServiceServer).Subscribe(m, &mempoolServiceSubscribeServer{stream})
+}
+
+type MempoolService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type mempoolServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _MempoolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.mempool.v1.MempoolService",
+	HandlerType: (*MempoolServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _MempoolService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cometbft/services/mempool/v1/mempool_service.proto",
+}
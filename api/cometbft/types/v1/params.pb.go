@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/gogoproto/types"
 	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
 	math "math"
@@ -34,7 +35,10 @@ type ConsensusParams struct {
 	Evidence  *EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
 	Validator *ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"`
+	Abci      *ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci,omitempty"` // Deprecated: Do not use.
+	Synchrony *SynchronyParams `protobuf:"bytes,6,opt,name=synchrony,proto3" json:"synchrony,omitempty"`
+	Timeout   *TimeoutParams   `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Feature   *FeatureParams   `protobuf:"bytes,8,opt,name=feature,proto3" json:"feature,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -98,6 +102,7 @@ func (m *ConsensusParams) GetVersion() *VersionParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *ConsensusParams) GetAbci() *ABCIParams {
 	if m != nil {
 		return m.Abci
@@ -105,6 +110,27 @@ func (m *ConsensusParams) GetAbci() *ABCIParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetSynchrony() *SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetTimeout() *TimeoutParams {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ConsensusParams) GetFeature() *FeatureParams {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -383,6 +409,8 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 }
 
 // ABCIParams configure functionality specific to the Application Blockchain Interface.
+//
+// Deprecated: use FeatureParams.vote_extensions_enable_height instead.
 type ABCIParams struct {
 	// vote_extensions_enable_height configures the first height during which
 	// vote extensions will be enabled. During this specified height, and for all
@@ -393,7 +421,7 @@ type ABCIParams struct {
 	// Once enabled, vote extensions will be created by the application in ExtendVote,
 	// passed to the application for validation in VerifyVoteExtension and given
 	// to the application to use when proposing a block during PrepareProposal.
-	VoteExtensionsEnableHeight int64 `protobuf:"varint,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+	VoteExtensionsEnableHeight int64 `protobuf:"varint,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"` // Deprecated: Do not use.
 }
 
 func (m *ABCIParams) Reset()         { *m = ABCIParams{} }
@@ -429,6 +457,7 @@ func (m *ABCIParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ABCIParams proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 	if m != nil {
 		return m.VoteExtensionsEnableHeight
@@ -436,6 +465,260 @@ func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 	return 0
 }
 
+// SynchronyParams determine the validity of block timestamps when
+// proposer-based timestamps (PBTS) are enabled.
+//
+// See the specification in spec/consensus/proposer-based-timestamp for details.
+type SynchronyParams struct {
+	// Bound for how skewed a proposer's clock may be from any validator on the
+	// network while still producing valid proposals.
+	Precision time.Duration `protobuf:"bytes,1,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Bound for how long a proposal message may take to reach all validators on
+	// the network and still be considered valid. It is increased by 10% for
+	// each round of a height.
+	MessageDelay time.Duration `protobuf:"bytes,2,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+}
+
+func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
+func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
+func (*SynchronyParams) ProtoMessage()    {}
+func (*SynchronyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c2f6d19461b2fe7, []int{7}
+}
+func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynchronyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynchronyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynchronyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronyParams.Merge(m, src)
+}
+func (m *SynchronyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynchronyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynchronyParams proto.InternalMessageInfo
+
+func (m *SynchronyParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *SynchronyParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
+// TimeoutParams configure the timeouts of the steps of the consensus
+// algorithm. A timeout that is not set (zero) is taken from the local
+// configuration of each node.
+type TimeoutParams struct {
+	// Timeout of the propose step in the first round of a height: how long a
+	// validator waits for a proposal before prevoting nil.
+	Propose time.Duration `protobuf:"bytes,1,opt,name=propose,proto3,stdduration" json:"propose"`
+	// Increase of the propose timeout for each round of a height.
+	ProposeDelta time.Duration `protobuf:"bytes,2,opt,name=propose_delta,json=proposeDelta,proto3,stdduration" json:"propose_delta"`
+	// Timeout of the prevote and precommit steps in the first round of a
+	// height: how long a validator waits, after receiving +2/3 votes for
+	// anything, for more votes.
+	Vote time.Duration `protobuf:"bytes,3,opt,name=vote,proto3,stdduration" json:"vote"`
+	// Increase of the vote timeout for each round of a height.
+	VoteDelta time.Duration `protobuf:"bytes,4,opt,name=vote_delta,json=voteDelta,proto3,stdduration" json:"vote_delta"`
+	// How long a validator waits after committing a block before starting the
+	// next height, to gather more precommits.
+	Commit time.Duration `protobuf:"bytes,5,opt,name=commit,proto3,stdduration" json:"commit"`
+	// Make progress as soon as all the precommits are received, instead of
+	// waiting for the commit timeout.
+	BypassCommitTimeout bool `protobuf:"varint,6,opt,name=bypass_commit_timeout,json=bypassCommitTimeout,proto3" json:"bypass_commit_timeout,omitempty"`
+}
+
+func (m *TimeoutParams) Reset()         { *m = TimeoutParams{} }
+func (m *TimeoutParams) String() string { return proto.CompactTextString(m) }
+func (*TimeoutParams) ProtoMessage()    {}
+func (*TimeoutParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c2f6d19461b2fe7, []int{8}
+}
+func (m *TimeoutParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeoutParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutParams.Merge(m, src)
+}
+func (m *TimeoutParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutParams proto.InternalMessageInfo
+
+func (m *TimeoutParams) GetPropose() time.Duration {
+	if m != nil {
+		return m.Propose
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetProposeDelta() time.Duration {
+	if m != nil {
+		return m.ProposeDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetVote() time.Duration {
+	if m != nil {
+		return m.Vote
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetVoteDelta() time.Duration {
+	if m != nil {
+		return m.VoteDelta
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetCommit() time.Duration {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *TimeoutParams) GetBypassCommitTimeout() bool {
+	if m != nil {
+		return m.BypassCommitTimeout
+	}
+	return false
+}
+
+// FeatureParams configure the heights from which consensus features are
+// enabled. A feature whose enable height is 0 is disabled. Once the height of
+// the chain reaches the enable height of a feature, the feature cannot be
+// disabled, nor its enable height modified.
+type FeatureParams struct {
+	// vote_extensions_enable_height configures the first height during which
+	// vote extensions will be enabled. During this specified height, and for all
+	// subsequent heights, precommit messages that do not contain valid extension data
+	// will be considered invalid. Prior to this height, vote extensions will not
+	// be used or accepted by validators on the network.
+	//
+	// Once enabled, vote extensions will be created by the application in ExtendVote,
+	// passed to the application for validation in VerifyVoteExtension and given
+	// to the application to use when proposing a block during PrepareProposal.
+	//
+	// If not set in an update, the enable height is not changed.
+	VoteExtensionsEnableHeight *types.Int64Value `protobuf:"bytes,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+	// pbts_enable_height configures the first height during which
+	// proposer-based timestamps are used. From this height on, the time of a
+	// block is the time at which its proposer created it, and validators prevote
+	// nil for proposals that are not timely. Prior to this height, the time of
+	// a block is the weighted median of the timestamps of the precommits of the
+	// previous block (BFT time).
+	//
+	// If not set in an update, the enable height is not changed.
+	PbtsEnableHeight *types.Int64Value `protobuf:"bytes,2,opt,name=pbts_enable_height,json=pbtsEnableHeight,proto3" json:"pbts_enable_height,omitempty"`
+	// erasure_coding_enable_height configures the first height during which
+	// proposal blocks are gossiped as erasure-coded part sets. From this height
+	// on, the part set of a block larger than one part holds as many
+	// Reed-Solomon parity parts as data parts, so that the block can be
+	// rebuilt from any half of its parts. Prior to this height, the part set of
+	// a block only holds the serialized block.
+	//
+	// As the part set header is part of the block ID, all validators must agree
+	// on whether part sets are erasure coded. Erasure coding requires
+	// block.max_bytes to be set, and no greater than 8MB.
+	//
+	// If not set in an update, the enable height is not changed.
+	ErasureCodingEnableHeight *types.Int64Value `protobuf:"bytes,3,opt,name=erasure_coding_enable_height,json=erasureCodingEnableHeight,proto3" json:"erasure_coding_enable_height,omitempty"`
+}
+
+func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
+func (m *FeatureParams) String() string { return proto.CompactTextString(m) }
+func (*FeatureParams) ProtoMessage()    {}
+func (*FeatureParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c2f6d19461b2fe7, []int{9}
+}
+func (m *FeatureParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureParams.Merge(m, src)
+}
+func (m *FeatureParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureParams proto.InternalMessageInfo
+
+func (m *FeatureParams) GetVoteExtensionsEnableHeight() *types.Int64Value {
+	if m != nil {
+		return m.VoteExtensionsEnableHeight
+	}
+	return nil
+}
+
+func (m *FeatureParams) GetPbtsEnableHeight() *types.Int64Value {
+	if m != nil {
+		return m.PbtsEnableHeight
+	}
+	return nil
+}
+
+func (m *FeatureParams) GetErasureCodingEnableHeight() *types.Int64Value {
+	if m != nil {
+		return m.ErasureCodingEnableHeight
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "cometbft.types.v1.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "cometbft.types.v1.BlockParams")
@@ -444,48 +727,70 @@ func init() {
 	proto.RegisterType((*VersionParams)(nil), "cometbft.types.v1.VersionParams")
 	proto.RegisterType((*HashedParams)(nil), "cometbft.types.v1.HashedParams")
 	proto.RegisterType((*ABCIParams)(nil), "cometbft.types.v1.ABCIParams")
+	proto.RegisterType((*SynchronyParams)(nil), "cometbft.types.v1.SynchronyParams")
+	proto.RegisterType((*TimeoutParams)(nil), "cometbft.types.v1.TimeoutParams")
+	proto.RegisterType((*FeatureParams)(nil), "cometbft.types.v1.FeatureParams")
 }
 
 func init() { proto.RegisterFile("cometbft/types/v1/params.proto", fileDescriptor_8c2f6d19461b2fe7) }
 
 var fileDescriptor_8c2f6d19461b2fe7 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x87, 0x73, 0x75, 0xda, 0xa6, 0x6f, 0x48, 0x13, 0x4e, 0x48, 0x98, 0xa0, 0x3a, 0xc1, 0x03,
-	0xaa, 0x54, 0xc9, 0x56, 0x0a, 0x53, 0x25, 0x04, 0x49, 0x89, 0xda, 0x82, 0x0a, 0x95, 0x85, 0x18,
-	0xba, 0x58, 0xe7, 0xe4, 0xea, 0x58, 0x8d, 0x7d, 0x56, 0xee, 0x6c, 0x25, 0xdf, 0x82, 0x91, 0xb1,
-	0x23, 0x7c, 0x02, 0xf8, 0x08, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x16, 0x3e, 0x06, 0xf2, 0xd9, 0x4e,
-	0x9a, 0x36, 0xdb, 0xf9, 0xde, 0xe7, 0xb9, 0x3f, 0xbf, 0xd7, 0x07, 0x5a, 0x8f, 0xf9, 0x54, 0x38,
-	0x17, 0xc2, 0x14, 0x93, 0x90, 0x72, 0x33, 0x6e, 0x99, 0x21, 0x19, 0x11, 0x9f, 0x1b, 0xe1, 0x88,
-	0x09, 0x86, 0x1f, 0xe6, 0x75, 0x43, 0xd6, 0x8d, 0xb8, 0x55, 0x7f, 0xe4, 0x32, 0x97, 0xc9, 0xaa,
-	0x99, 0x8c, 0x52, 0xb0, 0xae, 0xb9, 0x8c, 0xb9, 0x43, 0x6a, 0xca, 0x2f, 0x27, 0xba, 0x30, 0xfb,
-	0xd1, 0x88, 0x08, 0x8f, 0x05, 0x69, 0x5d, 0xff, 0xb1, 0x06, 0xd5, 0x43, 0x16, 0x70, 0x1a, 0xf0,
-	0x88, 0x9f, 0xc9, 0x2d, 0xf0, 0x4b, 0x58, 0x77, 0x86, 0xac, 0x77, 0xa9, 0xa2, 0x26, 0xda, 0x2d,
-	0xef, 0x6b, 0xc6, 0xbd, 0xcd, 0x8c, 0x4e, 0x52, 0x4f, 0x71, 0x2b, 0x85, 0xf1, 0x2b, 0x28, 0xd1,
-	0xd8, 0xeb, 0xd3, 0xa0, 0x47, 0xd5, 0x35, 0x29, 0x3e, 0x5b, 0x21, 0x76, 0x33, 0x24, 0x73, 0xe7,
-	0x0a, 0x7e, 0x03, 0x5b, 0x31, 0x19, 0x7a, 0x7d, 0x22, 0xd8, 0x48, 0x55, 0xa4, 0xaf, 0xaf, 0xf0,
-	0x3f, 0xe7, 0x4c, 0xb6, 0xc0, 0x42, 0xc2, 0x07, 0xb0, 0x19, 0xd3, 0x11, 0xf7, 0x58, 0xa0, 0x16,
-	0xa5, 0xdf, 0x5c, 0xe5, 0xa7, 0x44, 0x66, 0xe7, 0x02, 0x6e, 0x41, 0x91, 0x38, 0x3d, 0x4f, 0x5d,
-	0x97, 0xe2, 0xce, 0x0a, 0xb1, 0xdd, 0x39, 0x3c, 0xc9, 0x2c, 0x89, 0xea, 0x27, 0x50, 0xbe, 0x95,
-	0x02, 0x7e, 0x0a, 0x5b, 0x3e, 0x19, 0xdb, 0xce, 0x44, 0x50, 0x2e, 0x83, 0x53, 0xac, 0x92, 0x4f,
-	0xc6, 0x9d, 0xe4, 0x1b, 0x3f, 0x86, 0xcd, 0xa4, 0xe8, 0x12, 0x2e, 0xa3, 0x51, 0xac, 0x0d, 0x9f,
-	0x8c, 0x8f, 0x08, 0x7f, 0x57, 0x2c, 0x29, 0xb5, 0xa2, 0xfe, 0x1d, 0xc1, 0xf6, 0x72, 0x30, 0x78,
-	0x0f, 0x70, 0x62, 0x10, 0x97, 0xda, 0x41, 0xe4, 0xdb, 0x32, 0xe2, 0x7c, 0xdd, 0xaa, 0x4f, 0xc6,
-	0x6d, 0x97, 0x7e, 0x88, 0x7c, 0x79, 0x00, 0x8e, 0x4f, 0xa1, 0x96, 0xc3, 0x79, 0x7b, 0xb3, 0x16,
-	0x3c, 0x31, 0xd2, 0xfe, 0x1b, 0x79, 0xff, 0x8d, 0xb7, 0x19, 0xd0, 0x29, 0x5d, 0xff, 0x6e, 0x14,
-	0xbe, 0xfe, 0x69, 0x20, 0x6b, 0x3b, 0x5d, 0x2f, 0xaf, 0x2c, 0x5f, 0x45, 0x59, 0xbe, 0x8a, 0xfe,
-	0x1a, 0xaa, 0x77, 0x7a, 0x80, 0x75, 0xa8, 0x84, 0x91, 0x63, 0x5f, 0xd2, 0x89, 0x2d, 0xe3, 0x52,
-	0x51, 0x53, 0xd9, 0xdd, 0xb2, 0xca, 0x61, 0xe4, 0xbc, 0xa7, 0x93, 0x4f, 0xc9, 0xd4, 0x41, 0xe9,
-	0xe7, 0x55, 0x03, 0xfd, 0xbb, 0x6a, 0x20, 0x7d, 0x0f, 0x2a, 0x4b, 0x4d, 0xc0, 0x35, 0x50, 0x48,
-	0x18, 0xca, 0xbb, 0x15, 0xad, 0x64, 0x78, 0x0b, 0x3e, 0x87, 0x07, 0xc7, 0x84, 0x0f, 0x68, 0x3f,
-	0x63, 0x9f, 0x43, 0x55, 0x46, 0x61, 0xdf, 0xcd, 0xba, 0x22, 0xa7, 0x4f, 0xf3, 0xc0, 0x75, 0xa8,
-	0x2c, 0xb8, 0x45, 0xec, 0xe5, 0x9c, 0x3a, 0x22, 0x5c, 0xff, 0x08, 0xb0, 0x68, 0x2a, 0x6e, 0xc3,
-	0x4e, 0xcc, 0x04, 0xb5, 0xe9, 0x58, 0xd0, 0x20, 0x39, 0x1d, 0xb7, 0x69, 0x40, 0x9c, 0x21, 0xb5,
-	0x07, 0xd4, 0x73, 0x07, 0x22, 0xdb, 0xa7, 0x9e, 0x40, 0xdd, 0x39, 0xd3, 0x95, 0xc8, 0xb1, 0x24,
-	0x3a, 0x67, 0xdf, 0xa6, 0x1a, 0xba, 0x9e, 0x6a, 0xe8, 0x66, 0xaa, 0xa1, 0xbf, 0x53, 0x0d, 0x7d,
-	0x99, 0x69, 0x85, 0x9b, 0x99, 0x56, 0xf8, 0x35, 0xd3, 0x0a, 0xe7, 0xfb, 0xae, 0x27, 0x06, 0x91,
-	0x93, 0xfc, 0x5a, 0xe6, 0xfc, 0x75, 0xcf, 0x07, 0x24, 0xf4, 0xcc, 0x7b, 0x6f, 0xde, 0xd9, 0x90,
-	0x6d, 0x7b, 0xf1, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xea, 0x75, 0xab, 0x0f, 0x04, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x78, 0x93, 0x6c, 0xde, 0x76, 0xbb, 0x61, 0x00, 0xe1, 0xa6, 0xd4, 0x1b, 0x7c,
+	0x40, 0x95, 0x2a, 0xad, 0xd5, 0x50, 0x40, 0x2a, 0xaa, 0x20, 0x4e, 0x42, 0x13, 0x50, 0xa1, 0x72,
+	0xab, 0x1e, 0x7a, 0xb1, 0xc6, 0xde, 0x89, 0xd7, 0xea, 0xda, 0x63, 0x79, 0xc6, 0xcb, 0xfa, 0x0b,
+	0x70, 0xe6, 0xc8, 0x09, 0xf5, 0x08, 0x7c, 0x02, 0x3e, 0x42, 0x8e, 0x3d, 0x72, 0x2a, 0x28, 0xb9,
+	0xf0, 0x05, 0xb8, 0xa3, 0x19, 0xcf, 0xec, 0x66, 0x37, 0x09, 0xda, 0xbd, 0x8d, 0xfd, 0xfe, 0xbf,
+	0x37, 0xff, 0x79, 0xef, 0x79, 0x64, 0xb0, 0x23, 0x9a, 0x12, 0x1e, 0x9e, 0x70, 0x97, 0x57, 0x39,
+	0x61, 0xee, 0xe8, 0xbe, 0x9b, 0xe3, 0x02, 0xa7, 0xac, 0x97, 0x17, 0x94, 0x53, 0xf4, 0x8e, 0x8e,
+	0xf7, 0x64, 0xbc, 0x37, 0xba, 0xbf, 0xfd, 0x5e, 0x4c, 0x63, 0x2a, 0xa3, 0xae, 0x58, 0xd5, 0xc2,
+	0x6d, 0x3b, 0xa6, 0x34, 0x1e, 0x12, 0x57, 0x3e, 0x85, 0xe5, 0x89, 0xdb, 0x2f, 0x0b, 0xcc, 0x13,
+	0x9a, 0x5d, 0x17, 0xff, 0xa1, 0xc0, 0x79, 0x4e, 0x0a, 0xb5, 0x91, 0xf3, 0xaf, 0x09, 0x9d, 0x7d,
+	0x9a, 0x31, 0x92, 0xb1, 0x92, 0x3d, 0x95, 0x16, 0xd0, 0x03, 0x58, 0x0b, 0x87, 0x34, 0x7a, 0x65,
+	0x19, 0x3b, 0xc6, 0xdd, 0xd6, 0xae, 0xdd, 0xbb, 0x64, 0xa6, 0xe7, 0x89, 0x78, 0x2d, 0xf7, 0x6b,
+	0x31, 0x7a, 0x04, 0x4d, 0x32, 0x4a, 0xfa, 0x24, 0x8b, 0x88, 0xb5, 0x2a, 0xc1, 0x8f, 0xae, 0x00,
+	0x0f, 0x95, 0x44, 0xb1, 0x13, 0x04, 0x7d, 0x05, 0x9b, 0x23, 0x3c, 0x4c, 0xfa, 0x98, 0xd3, 0xc2,
+	0x32, 0x25, 0xef, 0x5c, 0xc1, 0xbf, 0xd0, 0x1a, 0x95, 0x60, 0x0a, 0xa1, 0x87, 0xb0, 0x31, 0x22,
+	0x05, 0x4b, 0x68, 0x66, 0x35, 0x24, 0xbf, 0x73, 0x15, 0x5f, 0x2b, 0x14, 0xad, 0x01, 0xf4, 0x29,
+	0x34, 0x70, 0x18, 0x25, 0xd6, 0x9a, 0x04, 0xef, 0x5c, 0x01, 0xee, 0x79, 0xfb, 0xc7, 0x35, 0xe5,
+	0xad, 0x5a, 0x86, 0x2f, 0xe5, 0xc2, 0x34, 0xab, 0xb2, 0x68, 0x50, 0xd0, 0xac, 0xb2, 0xd6, 0xaf,
+	0x35, 0xfd, 0x4c, 0x6b, 0xb4, 0xe9, 0x09, 0x24, 0x4c, 0xf3, 0x24, 0x25, 0xb4, 0xe4, 0xd6, 0xc6,
+	0xb5, 0xa6, 0x9f, 0xd7, 0x0a, 0x6d, 0x5a, 0x01, 0x82, 0x3d, 0x21, 0x98, 0x97, 0x05, 0xb1, 0x9a,
+	0xd7, 0xb2, 0x5f, 0xd7, 0x0a, 0xcd, 0x2a, 0xc0, 0x39, 0x86, 0xd6, 0x85, 0x1e, 0xa2, 0xdb, 0xb0,
+	0x99, 0xe2, 0x71, 0x10, 0x56, 0x9c, 0x30, 0xd9, 0x76, 0xd3, 0x6f, 0xa6, 0x78, 0xec, 0x89, 0x67,
+	0xf4, 0x01, 0x6c, 0x88, 0x60, 0x8c, 0x99, 0x6c, 0xac, 0xe9, 0xaf, 0xa7, 0x78, 0xfc, 0x18, 0xb3,
+	0x6f, 0x1a, 0x4d, 0x73, 0xab, 0xe1, 0xfc, 0x66, 0xc0, 0xcd, 0xd9, 0xb6, 0xa2, 0x7b, 0x80, 0x04,
+	0x81, 0x63, 0x12, 0x64, 0x65, 0x1a, 0xc8, 0x01, 0xd1, 0x79, 0x3b, 0x29, 0x1e, 0xef, 0xc5, 0xe4,
+	0xbb, 0x32, 0x95, 0x06, 0x18, 0x7a, 0x02, 0x5b, 0x5a, 0xac, 0x87, 0x57, 0x0d, 0xd0, 0xad, 0x5e,
+	0x3d, 0xbd, 0x3d, 0x3d, 0xbd, 0xbd, 0x03, 0x25, 0xf0, 0x9a, 0xa7, 0x6f, 0xbb, 0x2b, 0x3f, 0xff,
+	0xd5, 0x35, 0xfc, 0x9b, 0x75, 0x3e, 0x1d, 0x99, 0x3d, 0x8a, 0x39, 0x7b, 0x14, 0xe7, 0x4b, 0xe8,
+	0xcc, 0x4d, 0x10, 0x72, 0xa0, 0x9d, 0x97, 0x61, 0xf0, 0x8a, 0x54, 0x81, 0x2c, 0x9a, 0x65, 0xec,
+	0x98, 0x77, 0x37, 0xfd, 0x56, 0x5e, 0x86, 0xdf, 0x92, 0xea, 0xb9, 0x78, 0xf5, 0xb0, 0xf9, 0xc7,
+	0xeb, 0xae, 0xf1, 0xcf, 0xeb, 0xae, 0xe1, 0xdc, 0x83, 0xf6, 0xcc, 0x08, 0xa1, 0x2d, 0x30, 0x71,
+	0x9e, 0xcb, 0xb3, 0x35, 0x7c, 0xb1, 0xbc, 0x20, 0x7e, 0x09, 0x37, 0x8e, 0x30, 0x1b, 0x90, 0xbe,
+	0xd2, 0x7e, 0x0c, 0x1d, 0x59, 0x8a, 0x60, 0xbe, 0xd6, 0x6d, 0xf9, 0xfa, 0x89, 0x2e, 0xb8, 0x03,
+	0xed, 0xa9, 0x6e, 0x5a, 0xf6, 0x96, 0x56, 0x3d, 0xc6, 0xcc, 0x79, 0x06, 0x30, 0x1d, 0x49, 0x74,
+	0x08, 0x77, 0x46, 0x94, 0x93, 0x80, 0x8c, 0x39, 0xc9, 0x84, 0x3b, 0x16, 0x90, 0x0c, 0x87, 0x43,
+	0x12, 0x0c, 0x48, 0x12, 0x0f, 0x78, 0xbd, 0x8f, 0x9c, 0xdc, 0x6d, 0x21, 0x3c, 0x9c, 0xe8, 0x0e,
+	0xa5, 0xec, 0x48, 0xaa, 0x9c, 0x5f, 0x0c, 0xe8, 0xcc, 0x0d, 0x2b, 0xda, 0x83, 0xcd, 0xbc, 0x20,
+	0x51, 0x22, 0x3f, 0x2c, 0x63, 0xf1, 0xbe, 0x4c, 0x29, 0x74, 0x04, 0xed, 0x94, 0x30, 0x26, 0x3b,
+	0x4c, 0x86, 0xb8, 0x5a, 0xa6, 0xbd, 0x37, 0x14, 0x79, 0x20, 0x40, 0xe7, 0x47, 0x13, 0xda, 0x33,
+	0x5f, 0x03, 0x7a, 0x04, 0x1b, 0x79, 0x41, 0x73, 0xca, 0xc8, 0x32, 0xe6, 0x34, 0x23, 0xac, 0xa9,
+	0xa5, 0xb0, 0xc6, 0xf1, 0x52, 0xd6, 0x14, 0x79, 0x20, 0x40, 0xf4, 0x39, 0x34, 0x44, 0x65, 0x2d,
+	0x73, 0xf1, 0x04, 0x12, 0x40, 0x1e, 0x80, 0xec, 0x5d, 0xbd, 0x7f, 0x63, 0x89, 0x0a, 0x0b, 0xac,
+	0xde, 0xfc, 0x0b, 0x58, 0x8f, 0x68, 0x9a, 0x26, 0xdc, 0x5a, 0x5b, 0x9c, 0x57, 0x08, 0xda, 0x85,
+	0xf7, 0xc3, 0x2a, 0xc7, 0x8c, 0x05, 0xf5, 0x8b, 0x40, 0xdf, 0x48, 0xe2, 0x46, 0x6b, 0xfa, 0xef,
+	0xd6, 0xc1, 0x7d, 0x19, 0x53, 0xc5, 0x77, 0x7e, 0x5f, 0x85, 0xf6, 0xcc, 0xd5, 0x82, 0xfa, 0x8b,
+	0x8c, 0x60, 0x6b, 0xf7, 0xf6, 0x25, 0x67, 0xc7, 0x19, 0xff, 0xec, 0xc1, 0x0b, 0x3c, 0x2c, 0x89,
+	0xd7, 0x38, 0x7d, 0xdb, 0xfd, 0xdf, 0x09, 0x45, 0xdf, 0x03, 0xca, 0x43, 0x3e, 0x9f, 0x7a, 0x75,
+	0xd1, 0xd4, 0x5b, 0x02, 0x9e, 0x49, 0x18, 0xc2, 0x87, 0xa4, 0xc0, 0xac, 0x2c, 0x48, 0x10, 0xd1,
+	0x7e, 0x92, 0xc5, 0x73, 0xa9, 0xcd, 0x45, 0x53, 0xdf, 0x52, 0x69, 0xf6, 0x65, 0x96, 0x8b, 0x7b,
+	0x78, 0x4f, 0x7f, 0x3d, 0xb3, 0x8d, 0xd3, 0x33, 0xdb, 0x78, 0x73, 0x66, 0x1b, 0x7f, 0x9f, 0xd9,
+	0xc6, 0x4f, 0xe7, 0xf6, 0xca, 0x9b, 0x73, 0x7b, 0xe5, 0xcf, 0x73, 0x7b, 0xe5, 0xe5, 0x6e, 0x9c,
+	0xf0, 0x41, 0x19, 0x8a, 0xbb, 0xdb, 0x9d, 0xfc, 0x16, 0x4c, 0x16, 0x38, 0x4f, 0xdc, 0x4b, 0x3f,
+	0x0b, 0xe1, 0xba, 0xf4, 0xf1, 0xc9, 0x7f, 0x03, 0x00, 0xd1, 0xe3, 0xe3, 0xd5, 0x48, 0x08, 0x00,
+	0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -522,6 +827,15 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Abci.Equal(that1.Abci) {
 		return false
 	}
+	if !this.Synchrony.Equal(that1.Synchrony) {
+		return false
+	}
+	if !this.Timeout.Equal(that1.Timeout) {
+		return false
+	}
+	if !this.Feature.Equal(that1.Feature) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -685,6 +999,102 @@ func (this *ABCIParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SynchronyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SynchronyParams)
+	if !ok {
+		that2, ok := that.(SynchronyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	return true
+}
+func (this *TimeoutParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TimeoutParams)
+	if !ok {
+		that2, ok := that.(TimeoutParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Propose != that1.Propose {
+		return false
+	}
+	if this.ProposeDelta != that1.ProposeDelta {
+		return false
+	}
+	if this.Vote != that1.Vote {
+		return false
+	}
+	if this.VoteDelta != that1.VoteDelta {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	if this.BypassCommitTimeout != that1.BypassCommitTimeout {
+		return false
+	}
+	return true
+}
+func (this *FeatureParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureParams)
+	if !ok {
+		that2, ok := that.(FeatureParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VoteExtensionsEnableHeight.Equal(that1.VoteExtensionsEnableHeight) {
+		return false
+	}
+	if !this.PbtsEnableHeight.Equal(that1.PbtsEnableHeight) {
+		return false
+	}
+	if !this.ErasureCodingEnableHeight.Equal(that1.ErasureCodingEnableHeight) {
+		return false
+	}
+	return true
+}
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -705,6 +1115,42 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Feature != nil {
+		{
+			size, err := m.Feature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Synchrony != nil {
+		{
+			size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Abci != nil {
 		{
 			size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
@@ -826,12 +1272,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -963,11 +1409,182 @@ func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *SynchronyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MessageDelay):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precision):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeoutParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeoutParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BypassCommitTimeout {
+		i--
+		if m.BypassCommitTimeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Commit, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintParams(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VoteDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VoteDelta):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintParams(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Vote, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Vote):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintParams(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ProposeDelta, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintParams(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Propose, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintParams(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErasureCodingEnableHeight != nil {
+		{
+			size, err := m.ErasureCodingEnableHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PbtsEnableHeight != nil {
+		{
+			size, err := m.PbtsEnableHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VoteExtensionsEnableHeight != nil {
+		{
+			size, err := m.VoteExtensionsEnableHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -1092,6 +1709,18 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Abci.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.Synchrony != nil {
+		l = m.Synchrony.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Feature != nil {
+		l = m.Feature.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1181,6 +1810,62 @@ func (m *ABCIParams) Size() (n int) {
 	return n
 }
 
+func (m *SynchronyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TimeoutParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Propose)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ProposeDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Vote)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VoteDelta)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Commit)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BypassCommitTimeout {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeatureParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != nil {
+		l = m.VoteExtensionsEnableHeight.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PbtsEnableHeight != nil {
+		l = m.PbtsEnableHeight.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ErasureCodingEnableHeight != nil {
+		l = m.ErasureCodingEnableHeight.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1396,6 +2081,114 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchrony == nil {
+				m.Synchrony = &SynchronyParams{}
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &TimeoutParams{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feature == nil {
+				m.Feature = &FeatureParams{}
+			}
+			if err := m.Feature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1934,6 +2727,515 @@ func (m *ABCIParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SynchronyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeoutParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Propose, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ProposeDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Vote, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VoteDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Commit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassCommitTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassCommitTimeout = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionsEnableHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteExtensionsEnableHeight == nil {
+				m.VoteExtensionsEnableHeight = &types.Int64Value{}
+			}
+			if err := m.VoteExtensionsEnableHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PbtsEnableHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PbtsEnableHeight == nil {
+				m.PbtsEnableHeight = &types.Int64Value{}
+			}
+			if err := m.PbtsEnableHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErasureCodingEnableHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ErasureCodingEnableHeight == nil {
+				m.ErasureCodingEnableHeight = &types.Int64Value{}
+			}
+			if err := m.ErasureCodingEnableHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,23 +7,37 @@ import (
 
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
 )
 
+// keyType is the type of the validator key generated by gen-validator and
+// init.
+var keyType string
+
+func init() {
+	GenValidatorCmd.Flags().StringVar(&keyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"type of the validator key: ed25519, secp256k1 or bls12_381")
+}
+
 // GenValidatorCmd allows the generation of a keypair for a
 // validator.
 var GenValidatorCmd = &cobra.Command{
 	Use:     "gen-validator",
 	Aliases: []string{"gen_validator"},
 	Short:   "Generate new validator keypair",
-	Run:     genValidator,
+	RunE:    genValidator,
 }
 
-func genValidator(*cobra.Command, []string) {
-	pv := privval.GenFilePV("", "")
+func genValidator(*cobra.Command, []string) error {
+	pv, err := privval.GenFilePVWithKeyType("", "", keyType)
+	if err != nil {
+		return err
+	}
 	jsbz, err := cmtjson.Marshal(pv)
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf(`%v
 `, string(jsbz))
+	return nil
 }
@@ -21,6 +21,11 @@ var InitFilesCmd = &cobra.Command{
 	RunE:  initFiles,
 }
 
+func init() {
+	InitFilesCmd.Flags().StringVar(&keyType, "key-type", types.ABCIPubKeyTypeEd25519,
+		"type of the validator key, if it is generated: ed25519, secp256k1 or bls12_381")
+}
+
 func initFiles(*cobra.Command, []string) error {
 	return initFilesWithConfig(config)
 }
@@ -35,7 +40,11 @@ func initFilesWithConfig(config *cfg.Config) error {
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
-		pv = privval.GenFilePV(privValKeyFile, privValStateFile)
+		var err error
+		pv, err = privval.GenFilePVWithKeyType(privValKeyFile, privValStateFile, keyType)
+		if err != nil {
+			return err
+		}
 		pv.Save()
 		logger.Info("Generated private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
@@ -70,6 +79,7 @@ func initFilesWithConfig(config *cfg.Config) error {
 			PubKey:  pubKey,
 			Power:   10,
 		}}
+		genDoc.ConsensusParams.Validator.PubKeyTypes = []string{pubKey.Type()}
 
 		if err := genDoc.SaveAs(genFile); err != nil {
 			return err
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	mempl "github.com/cometbft/cometbft/mempool"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+var mempoolRPCAddr string
+
+// MempoolCmd defines the root command containing subcommands to dump the
+// content of the mempool of a running node, and import it into another node.
+var MempoolCmd = &cobra.Command{
+	Use:   "mempool",
+	Short: "Dump and import the content of the mempool",
+}
+
+var mempoolDumpCmd = &cobra.Command{
+	Use:   "dump [output-file]",
+	Short: "Dump the transactions in the mempool of a running node to a file",
+	Long: `
+Dump the transactions in the mempool of a running node, in order, to a JSON
+file, along with their metadata (the height at which they were checked, the
+time they were added to the mempool, their priority, lane, sender and nonce)
+and the peers they were received from.
+
+The node must have the unsafe RPC routes enabled (rpc.unsafe = true).
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := jsonrpcclient.New(mempoolRPCAddr)
+		if err != nil {
+			return fmt.Errorf("failed to create RPC client: %w", err)
+		}
+		result := new(ctypes.ResultUnsafeDumpMempool)
+		if _, err := client.Call(context.Background(), "unsafe_dump_mempool", nil, result); err != nil {
+			return fmt.Errorf("failed to dump mempool: %w", err)
+		}
+
+		bz, err := cmtjson.MarshalIndent(&mempl.Dump{Height: result.Height, Txs: result.Txs}, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := cmtos.WriteFile(args[0], bz, 0o600); err != nil {
+			return err
+		}
+		fmt.Printf("Dumped %d transactions at height %d to %s\n", len(result.Txs), result.Height, args[0])
+		return nil
+	},
+}
+
+var mempoolImportCmd = &cobra.Command{
+	Use:   "import [dump-file]",
+	Short: "Import a mempool dump into the mempool journal of this node",
+	Long: `
+Import the transactions of a file written by "cometbft mempool dump" into the
+mempool journal of this node, replacing its content. The node must be stopped.
+
+When the node starts, the imported transactions are checked again by the
+application and added to the mempool, in the same order. This requires the
+mempool journal to be enabled (mempool.journal = true). The times at which the
+transactions were added to the mempool are shifted so that the last one is the
+time of the import; transactions older than mempool.journal_max_age are
+dropped at startup.
+
+The rest of the metadata in the dump is not imported: the height, gas wanted,
+priority, lane, sender and nonce of each transaction are assigned again by the
+application when it checks the transaction, and the peers it was received from
+are discarded.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !config.Mempool.Journal {
+			return errors.New("the mempool journal is disabled; set mempool.journal = true")
+		}
+
+		bz, err := cmtos.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		dump := new(mempl.Dump)
+		if err := cmtjson.Unmarshal(bz, dump); err != nil {
+			return fmt.Errorf("failed to parse mempool dump: %w", err)
+		}
+
+		db, err := dbm.NewDB("mempool", dbm.BackendType(config.DBBackend), config.DBDir())
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		if err := mempl.ImportDump(db, dump); err != nil {
+			return fmt.Errorf("failed to import mempool dump: %w", err)
+		}
+		fmt.Printf("Imported %d transactions into the mempool journal\n", len(dump.Txs))
+		return nil
+	},
+}
+
+func init() {
+	mempoolDumpCmd.Flags().StringVar(
+		&mempoolRPCAddr,
+		"rpc-laddr",
+		"tcp://localhost:26657/v1",
+		"the CometBFT node's RPC address (<host>:<port>) and version",
+	)
+
+	MempoolCmd.AddCommand(mempoolDumpCmd)
+	MempoolCmd.AddCommand(mempoolImportCmd)
+}
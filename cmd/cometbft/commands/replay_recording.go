@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/cometbft/cometbft/internal/consensus"
+)
+
+var recordingSession int
+
+func init() {
+	ReplayRecordingCmd.Flags().IntVar(&recordingSession, "session", -1,
+		"index of the recording session to replay, starting at 0; the last session if negative")
+}
+
+// ReplayRecordingCmd replays a recording of the inputs of the consensus state
+// machine.
+var ReplayRecordingCmd = &cobra.Command{
+	Use:     "replay-recording [recording-file]",
+	Aliases: []string{"replay_recording"},
+	Short:   "Replay a recording of the inputs of the consensus state machine, offline",
+	Long: `
+Re-run the consensus state machine from a recording of its inputs, written by a
+node with consensus.record_file set. A session of the recording begins each
+time the consensus of the node starts; the last one is replayed by default.
+
+The replay is deterministic, and needs neither the application nor the network:
+the messages, timeouts and responses of the application are processed in the
+recorded order, with the recorded times. The consensus configuration of this
+node is used, so it should match the one of the node that made the recording.
+Use --log_level to see the transitions of the state machine.
+
+The recording file defaults to consensus.record_file.
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := config.Consensus.RecordFile()
+		if len(args) == 1 {
+			path = args[0]
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		result, err := cs.ReplayRecording(config.Consensus, f, recordingSession, logger)
+		if result != nil {
+			fmt.Printf("Replayed %d inputs: last committed height %d (app hash %X), at height %d round %d step %s\n",
+				result.Inputs, result.State.LastBlockHeight, result.State.AppHash,
+				result.RoundState.Height, result.RoundState.Round, result.RoundState.Step)
+		}
+		return err
+	},
+}
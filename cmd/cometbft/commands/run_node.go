@@ -10,7 +10,10 @@ import (
 	nm "github.com/cometbft/cometbft/node"
 )
 
-var genesisHash []byte
+var (
+	genesisHash             []byte
+	overrideDoubleSignGuard bool
+)
 
 // AddNodeFlags exposes some common configuration options on the command-line
 // These are exposed for convenience of commands embedding a CometBFT node.
@@ -33,6 +36,12 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64("consensus.double_sign_check_height", config.Consensus.DoubleSignCheckHeight,
 		"how many blocks to look back to check existence of the node's "+
 			"consensus votes before joining consensus")
+	cmd.Flags().BoolVar(
+		&overrideDoubleSignGuard,
+		"override",
+		false,
+		"start signing even if the signing state of the private validator, the consensus WAL "+
+			"and the block store imply that another node is signing with the same key")
 
 	// abci flags
 	cmd.Flags().String(
@@ -93,6 +102,9 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 			if len(genesisHash) != 0 {
 				config.Storage.GenesisHash = hex.EncodeToString(genesisHash)
 			}
+			if overrideDoubleSignGuard {
+				config.Consensus.DoubleSignGuard = false
+			}
 
 			n, err := nodeProvider(config, logger)
 			if err != nil {
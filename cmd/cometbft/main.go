@@ -28,6 +28,8 @@ func main() {
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
+		cmd.MempoolCmd,
+		cmd.ReplayRecordingCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
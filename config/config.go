@@ -48,8 +48,12 @@ const (
 	v1 = "v1"
 	v2 = "v2"
 
-	MempoolTypeFlood = "flood"
-	MempoolTypeNop   = "nop"
+	MempoolTypeFlood    = "flood"
+	MempoolTypeNop      = "nop"
+	MempoolTypePriority = "priority"
+
+	GossipPeerSelectionRandom  = "random"
+	GossipPeerSelectionLatency = "latency"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -546,6 +550,10 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service streams the transactions added to and removed
+	// from the mempool
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -557,6 +565,7 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -567,6 +576,7 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -626,6 +636,22 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: true,
+	}
+}
+
 //-----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -851,6 +877,9 @@ type MempoolConfig struct {
 	//  Possible types:
 	//  - "flood" : concurrent linked list mempool with flooding gossip protocol
 	//  (default)
+	//  - "priority" : same as "flood", but transactions are reaped by the
+	//  priority set by the ABCI app in CheckTx, and lower priority transactions
+	//  are evicted to make room for higher priority ones when the mempool is full.
 	//  - "nop"   : nop-mempool (short for no operation; the ABCI app is
 	//  responsible for storing, disseminating and proposing txs).
 	//  "create_empty_blocks=false" is not supported.
@@ -871,17 +900,77 @@ type MempoolConfig struct {
 	// block. In other words, if Broadcast is disabled, only the peer you send
 	// the tx to will see it until it is included in a block.
 	Broadcast bool `mapstructure:"broadcast"`
+	// PullGossip (default: false) enables pull-based transaction gossip with
+	// the peers that support it. Instead of sending full transactions, the
+	// node announces the keys of its transactions, and peers request only the
+	// transactions they haven't seen yet. Transactions are still pushed to
+	// peers that don't support (or haven't enabled) pull-based gossip.
+	PullGossip bool `mapstructure:"pull_gossip"`
+	// GossipFanout (default: 0) limits the number of peers each transaction is
+	// relayed to, not counting the peers the transaction was received from.
+	// If 0, transactions are relayed to all peers.
+	GossipFanout int `mapstructure:"gossip_fanout"`
+	// GossipPeerSelection (default: "random") defines how the peers a
+	// transaction is relayed to are selected when GossipFanout is set:
+	//  - "random"  : peers are selected at random, for each transaction
+	//  - "latency" : the peers with the lowest round-trip time are selected
+	GossipPeerSelection string `mapstructure:"gossip_peer_selection"`
+	// AdaptiveGossipFanout (default: false) adapts the fan-out, between 1 and
+	// GossipFanout, to the ratio of transactions received from peers that were
+	// already seen. The fan-out is decreased when this ratio is above
+	// GossipTargetDuplicateRatio, and increased when it is below.
+	AdaptiveGossipFanout bool `mapstructure:"adaptive_gossip_fanout"`
+	// GossipTargetDuplicateRatio (default: 0.5) is the ratio of duplicate
+	// transactions received that AdaptiveGossipFanout aims for. Must be
+	// between 0 and 1.
+	GossipTargetDuplicateRatio float64 `mapstructure:"gossip_target_duplicate_ratio"`
+	// CheckTxBatchSize (default: 0) is the maximum number of transactions
+	// received from peers that are submitted to the application together.
+	// Transactions are queued as they are received, and checked in batches by
+	// a dedicated routine. Up to Size transactions are queued. While the queue
+	// is half full, no transactions are requested to the peers using
+	// pull-based gossip; transactions received while it is full are dropped.
+	// If 0, each transaction is checked as soon as it is received.
+	CheckTxBatchSize int `mapstructure:"check_tx_batch_size"`
+	// MaxInFlightCheckTxs (default: 0) limits the number of transactions
+	// received from peers that are being checked by the application at the
+	// same time. Once the limit is reached, received transactions are queued
+	// as with CheckTxBatchSize until the application responds. If 0, there is
+	// no limit.
+	MaxInFlightCheckTxs int `mapstructure:"max_in_flight_check_txs"`
 	// WalPath (default: "") configures the location of the Write Ahead Log
 	// (WAL) for the mempool. The WAL is disabled by default. To enable, set
 	// WalPath to where you want the WAL to be written (e.g.
 	// "data/mempool.wal").
 	WalPath string `mapstructure:"wal_dir"`
+	// Journal (default: false) keeps an on-disk journal of the transactions
+	// in the mempool, stored in the "mempool" database (see DBBackend). On
+	// startup, after the handshake with the ABCI app, journaled transactions
+	// are checked again with CheckTx, and the valid ones are added back to the
+	// mempool. Ignored by the "nop" mempool.
+	Journal bool `mapstructure:"journal"`
+	// JournalMaxAge (default: 1h) is the maximum time a transaction can spend
+	// in the journal. Older transactions are not replayed on startup. If 0,
+	// there is no limit.
+	JournalMaxAge time.Duration `mapstructure:"journal_max_age"`
+	// JournalMaxBytes (default: 100MB) limits the total size of the
+	// transactions in the journal. Transactions admitted to the mempool while
+	// the journal is full are not journaled. If 0, there is no limit.
+	JournalMaxBytes int64 `mapstructure:"journal_max_bytes"`
 	// Maximum number of transactions in the mempool
 	Size int `mapstructure:"size"`
 	// Limit the total size of all txs in the mempool.
 	// This only accounts for raw transactions (e.g. given 1MB transactions and
 	// max_txs_bytes=5MB, mempool will only accept 5 transactions).
 	MaxTxsBytes int64 `mapstructure:"max_txs_bytes"`
+	// Maximum number of transactions of a single sender in the mempool. The
+	// sender of a transaction is set by the ABCI app in CheckTx; transactions
+	// without a sender are not limited. If 0 (default), there is no limit
+	// other than Size.
+	MaxTxsPerSender int `mapstructure:"max_txs_per_sender"`
+	// Limit the total size of all txs of a single sender in the mempool. If 0
+	// (default), there is no limit other than MaxTxsBytes.
+	MaxTxsBytesPerSender int64 `mapstructure:"max_txs_bytes_per_sender"`
 	// Size of the cache (used to filter transactions we saw earlier) in transactions
 	CacheSize int `mapstructure:"cache_size"`
 	// Do not remove invalid transactions from the cache (default: false)
@@ -891,6 +980,14 @@ type MempoolConfig struct {
 	// Maximum size of a single transaction
 	// NOTE: the max size of a tx transmitted over the network is {max_tx_bytes}.
 	MaxTxBytes int `mapstructure:"max_tx_bytes"`
+	// TTLNumBlocks (default: 0) is the maximum number of blocks a transaction
+	// can stay in the mempool. Older transactions are removed when a block is
+	// committed, without being rechecked. If 0, there is no limit.
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
+	// TTLDuration (default: 0s) is the maximum time a transaction can stay in
+	// the mempool. Older transactions are removed when a block is committed,
+	// without being rechecked. If 0, there is no limit.
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
 	// Experimental parameters to limit gossiping txs to up to the specified number of peers.
 	// We use two independent upper values for persistent and non-persistent peers.
 	// Unconditional peers are not affected by this feature.
@@ -905,15 +1002,64 @@ type MempoolConfig struct {
 	// performance results using the default P2P configuration.
 	ExperimentalMaxGossipConnectionsToPersistentPeers    int `mapstructure:"experimental_max_gossip_connections_to_persistent_peers"`
 	ExperimentalMaxGossipConnectionsToNonPersistentPeers int `mapstructure:"experimental_max_gossip_connections_to_non_persistent_peers"`
+	// Lanes split the mempool into several lanes, each with its own limits and
+	// a weight. The ABCI app assigns transactions to lanes in CheckTx. When
+	// reaping transactions for a block, lanes are visited in a weighted
+	// round-robin fashion: in each round, up to `weight` transactions are taken
+	// from each lane, in the order lanes are defined.
+	// Transactions without a lane, or with an unknown lane, go to the lane
+	// named DefaultMempoolLane. If that lane is not defined, it is added
+	// with weight 1 and no limits other than Size and MaxTxsBytes.
+	// If empty (default), lanes are disabled.
+	Lanes []MempoolLaneConfig `mapstructure:"lanes"`
+}
+
+// DefaultMempoolLane is the name of the mempool lane of the transactions for
+// which the ABCI app did not set a lane in CheckTx.
+const DefaultMempoolLane = "default"
+
+// MempoolLaneConfig defines the configuration of a mempool lane.
+type MempoolLaneConfig struct {
+	// Name of the lane, as set by the ABCI app in CheckTx.
+	Name string `mapstructure:"name"`
+	// Maximum number of transactions in the lane. 0 means no limit other than
+	// the mempool's Size.
+	Size int `mapstructure:"size"`
+	// Limit the total size of all txs in the lane. 0 means no limit other than
+	// the mempool's MaxTxsBytes.
+	MaxTxsBytes int64 `mapstructure:"max_txs_bytes"`
+	// Maximum number of transactions taken from the lane in each round when
+	// reaping transactions. Must be positive.
+	Weight int `mapstructure:"weight"`
+}
+
+// ValidateBasic performs basic validation and returns an error if any check
+// fails.
+func (cfg *MempoolLaneConfig) ValidateBasic() error {
+	if cfg.Name == "" {
+		return errors.New("name can't be empty")
+	}
+	if cfg.Size < 0 {
+		return cmterrors.ErrNegativeField{Field: "size"}
+	}
+	if cfg.MaxTxsBytes < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_bytes"}
+	}
+	if cfg.Weight <= 0 {
+		return errors.New("weight must be positive")
+	}
+	return nil
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool.
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
-		Type:      MempoolTypeFlood,
-		Recheck:   true,
-		Broadcast: true,
-		WalPath:   "",
+		Type:            MempoolTypeFlood,
+		Recheck:         true,
+		Broadcast:       true,
+		WalPath:         "",
+		JournalMaxAge:   time.Hour,
+		JournalMaxBytes: 100 * 1024 * 1024, // 100MB
 		// Each signature verification takes .5ms, Size reduced until we implement
 		// ABCI Recheck
 		Size:        5000,
@@ -922,6 +1068,8 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxBytes:  1024 * 1024, // 1MB
 		ExperimentalMaxGossipConnectionsToNonPersistentPeers: 0,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    0,
+		GossipPeerSelection:        GossipPeerSelectionRandom,
+		GossipTargetDuplicateRatio: 0.5,
 	}
 }
 
@@ -946,7 +1094,7 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
 	switch cfg.Type {
-	case MempoolTypeFlood, MempoolTypeNop:
+	case MempoolTypeFlood, MempoolTypePriority, MempoolTypeNop:
 	case "": // allow empty string to be backwards compatible
 	default:
 		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
@@ -969,6 +1117,55 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.ExperimentalMaxGossipConnectionsToNonPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_non_persistent_peers"}
 	}
+	if cfg.MaxTxsPerSender < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_per_sender"}
+	}
+	if cfg.MaxTxsBytesPerSender < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_bytes_per_sender"}
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_num_blocks"}
+	}
+	if cfg.TTLDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_duration"}
+	}
+	if cfg.JournalMaxAge < 0 {
+		return cmterrors.ErrNegativeField{Field: "journal_max_age"}
+	}
+	if cfg.JournalMaxBytes < 0 {
+		return cmterrors.ErrNegativeField{Field: "journal_max_bytes"}
+	}
+	if cfg.GossipFanout < 0 {
+		return cmterrors.ErrNegativeField{Field: "gossip_fanout"}
+	}
+	if cfg.CheckTxBatchSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "check_tx_batch_size"}
+	}
+	if cfg.MaxInFlightCheckTxs < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_in_flight_check_txs"}
+	}
+	switch cfg.GossipPeerSelection {
+	case GossipPeerSelectionRandom, GossipPeerSelectionLatency:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown gossip peer selection: %q", cfg.GossipPeerSelection)
+	}
+	if cfg.AdaptiveGossipFanout && cfg.GossipFanout == 0 {
+		return errors.New("adaptive_gossip_fanout requires gossip_fanout to be set")
+	}
+	if cfg.GossipTargetDuplicateRatio < 0 || cfg.GossipTargetDuplicateRatio > 1 {
+		return errors.New("gossip_target_duplicate_ratio must be between 0 and 1")
+	}
+	laneNames := make(map[string]struct{}, len(cfg.Lanes))
+	for i, lane := range cfg.Lanes {
+		if err := lane.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid lane #%d: %w", i, err)
+		}
+		if _, ok := laneNames[lane.Name]; ok {
+			return fmt.Errorf("duplicate lane name: %q", lane.Name)
+		}
+		laneNames[lane.Name] = struct{}{}
+	}
 	return nil
 }
 
@@ -1104,6 +1301,9 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal_file"`
 	walFile string // overrides WalPath if set
 
+	// The timeouts below, and SkipTimeoutCommit, are only used when they are
+	// not set in the TimeoutParams consensus params.
+
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout_propose"`
 	// How much timeout_propose increases with each round
@@ -1122,9 +1322,45 @@ type ConsensusConfig struct {
 	// NOTE: when modifying, make sure to update time_iota_ms genesis parameter
 	TimeoutCommit time.Duration `mapstructure:"timeout_commit"`
 
-	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
+	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0).
+	// Only used if none of the TimeoutParams consensus params are set.
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// Adapt the propose and prevote timeouts of the first round of a height to
+	// the message delays observed in the last heights, instead of using
+	// TimeoutPropose and TimeoutPrevote (or their TimeoutParams). The timeouts
+	// still increase with each round by their deltas.
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Percentile of the observed delays used as timeouts, in (0, 1]. Only
+	// checked if AdaptiveTimeouts is set.
+	AdaptiveTimeoutPercentile float64 `mapstructure:"adaptive_timeout_percentile"`
+	// Bounds of the adaptive timeout_propose
+	TimeoutProposeMin time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax time.Duration `mapstructure:"timeout_propose_max"`
+	// Bounds of the adaptive timeout_prevote
+	TimeoutPrevoteMin time.Duration `mapstructure:"timeout_prevote_min"`
+	TimeoutPrevoteMax time.Duration `mapstructure:"timeout_prevote_max"`
+
+	// When the node is the proposer of the next height, create its proposal
+	// block, calling PrepareProposal, during timeout_commit. The block keeps
+	// the last commit it was created with, even if more precommits for the
+	// last block are received meanwhile.
+	// Not used at heights where PBTS is enabled, nor when waiting for txs.
+	PipelineProposals bool `mapstructure:"pipeline_proposals"`
+
+	// Call FinalizeBlock for a proposal as soon as ProcessProposal accepts it,
+	// instead of once it is decided, and use the response if it is decided.
+	// The application must support FinalizeBlock being called for blocks that
+	// are not decided, several times per height, and before ExtendVote and
+	// VerifyVoteExtension, and must only persist its state on Commit.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
+
+	// Number of last heights over which the liveness of the validators, how
+	// they signed the commits, is tracked. Available via the
+	// validator_liveness RPC endpoint and as metrics.
+	// 0 - tracking is disabled.
+	LivenessWindow int64 `mapstructure:"liveness_window"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -1134,7 +1370,30 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration      time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
+	// Send proposal blocks to peers as compact blocks: the block without its
+	// transactions, and the keys of the transactions, from which peers rebuild
+	// the block using their mempool. Only used with peers that also enabled
+	// compact blocks.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+	// How long to wait for a peer to rebuild a compact block before sending it
+	// the block parts it misses.
+	CompactBlockTimeout time.Duration `mapstructure:"compact_block_timeout"`
+
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Refuse to start signing if the last signing state of the private
+	// validator, the WAL and the block store disagree in a way that implies
+	// another node is signing with the same key. Only the block store and
+	// the WAL are checked with a remote signer. Can be skipped for a start
+	// with "cometbft start --override".
+	DoubleSignGuard bool `mapstructure:"double_sign_guard"`
+
+	// RecordPath (default: "") configures the location of the recording of
+	// the inputs of the consensus state machine, which can be replayed offline
+	// with "cometbft replay-recording". Recording is disabled by default. To
+	// enable it, set RecordPath to where you want the recording to be written
+	// (e.g. "data/cs.record").
+	RecordPath string `mapstructure:"record_file"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1149,12 +1408,24 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommitDelta:            500 * time.Millisecond,
 		TimeoutCommit:                    1000 * time.Millisecond,
 		SkipTimeoutCommit:                false,
+		AdaptiveTimeouts:                 false,
+		AdaptiveTimeoutPercentile:        0.95,
+		TimeoutProposeMin:                500 * time.Millisecond,
+		TimeoutProposeMax:                10 * time.Second,
+		TimeoutPrevoteMin:                100 * time.Millisecond,
+		TimeoutPrevoteMax:                5 * time.Second,
+		PipelineProposals:                false,
+		OptimisticExecution:              false,
+		LivenessWindow:                   100,
 		CreateEmptyBlocks:                true,
 		CreateEmptyBlocksInterval:        0 * time.Second,
 		PeerGossipSleepDuration:          100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
+		CompactBlocks:                    false,
+		CompactBlockTimeout:              1000 * time.Millisecond,
 		DoubleSignCheckHeight:            int64(0),
+		DoubleSignGuard:                  true,
 	}
 }
 
@@ -1221,6 +1492,17 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
 }
 
+// RecordFile returns the full path to the recording of the consensus inputs.
+func (cfg *ConsensusConfig) RecordFile() string {
+	return rootify(cfg.RecordPath, cfg.RootDir)
+}
+
+// RecordEnabled returns true if the inputs of the consensus state machine are
+// recorded.
+func (cfg *ConsensusConfig) RecordEnabled() bool {
+	return cfg.RecordPath != ""
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *ConsensusConfig) ValidateBasic() error {
@@ -1245,6 +1527,21 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_commit"}
 	}
+	if cfg.AdaptiveTimeouts && (cfg.AdaptiveTimeoutPercentile <= 0 || cfg.AdaptiveTimeoutPercentile > 1) {
+		return errors.New("adaptive_timeout_percentile must be in (0, 1]")
+	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return errors.New("timeout_propose_max can't be less than timeout_propose_min")
+	}
+	if cfg.TimeoutPrevoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_prevote_min"}
+	}
+	if cfg.TimeoutPrevoteMax < cfg.TimeoutPrevoteMin {
+		return errors.New("timeout_prevote_max can't be less than timeout_prevote_min")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return cmterrors.ErrNegativeField{Field: "create_empty_blocks_interval"}
 	}
@@ -1254,6 +1551,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.PeerQueryMaj23SleepDuration < 0 {
 		return cmterrors.ErrNegativeField{Field: "peer_query_maj23_sleep_duration"}
 	}
+	if cfg.CompactBlockTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_block_timeout"}
+	}
+	if cfg.LivenessWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "liveness_window"}
+	}
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
@@ -1386,6 +1689,13 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// Maximum number of transactions whose lifecycle is traced, from their
+	// arrival to their indexing. Traces are available via the tx_trace RPC
+	// endpoint, and published as TxTrace events. The oldest traces are
+	// dropped first.
+	// 0 - tracing is disabled.
+	TxTraces int `mapstructure:"tx_traces"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
@@ -1411,6 +1721,9 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_open_connections"}
 	}
+	if cfg.TxTraces < 0 {
+		return cmterrors.ErrNegativeField{Field: "tx_traces"}
+	}
 	return nil
 }
 
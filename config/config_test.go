@@ -119,6 +119,14 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MaxTxsPerSender",
+		"MaxTxsBytesPerSender",
+		"TTLNumBlocks",
+		"TTLDuration",
+		"JournalMaxAge",
+		"JournalMaxBytes",
+		"CheckTxBatchSize",
+		"MaxInFlightCheckTxs",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -131,6 +139,32 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	require.Error(t, cfg.ValidateBasic())
 }
 
+func TestMempoolLanesValidateBasic(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.Lanes = []config.MempoolLaneConfig{
+		{Name: "oracle", Size: 10, MaxTxsBytes: 1024, Weight: 2},
+		{Name: config.DefaultMempoolLane, Weight: 1},
+	}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Lanes[1].Name = "oracle"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Lanes[1].Name = ""
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Lanes[1].Name = config.DefaultMempoolLane
+
+	cfg.Lanes[0].Weight = 0
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Lanes[0].Weight = 2
+
+	cfg.Lanes[0].Size = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Lanes[0].Size = 0
+
+	cfg.Lanes[0].MaxTxsBytes = -1
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -172,6 +206,15 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerGossipSleepDuration negative":     {func(c *config.ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
+		"CompactBlockTimeout negative":         {func(c *config.ConsensusConfig) { c.CompactBlockTimeout = -1 }, true},
+		"AdaptiveTimeoutPercentile zero":       {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutPercentile = true, 0 }, true},
+		"AdaptiveTimeoutPercentile above 1":    {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutPercentile = true, 1.1 }, true},
+		"AdaptiveTimeoutPercentile disabled":   {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutPercentile = 0 }, false},
+		"TimeoutProposeMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutPrevoteMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutPrevoteMin = -1 }, true},
+		"TimeoutPrevoteMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutPrevoteMax = c.TimeoutPrevoteMin - 1 }, true},
+		"LivenessWindow negative":              {func(c *config.ConsensusConfig) { c.LivenessWindow = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
 	}
 	for desc, tc := range testcases {
@@ -197,4 +240,8 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	require.Error(t, cfg.ValidateBasic())
+	cfg.MaxOpenConnections = 3
+
+	cfg.TxTraces = -1
+	require.Error(t, cfg.ValidateBasic())
 }
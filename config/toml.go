@@ -291,6 +291,13 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service streams the transactions added to and removed from
+# the mempool, with the reason why they were removed.
+# MempoolTx events, also available to RPC subscribers, are only published if
+# this service is enabled.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -391,6 +398,9 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 #  Possible types:
 #  - "flood" : concurrent linked list mempool with flooding gossip protocol
 #  (default)
+#  - "priority" : same as "flood", but transactions are reaped by the priority
+#  set by the ABCI app in CheckTx, and lower priority transactions are evicted
+#  to make room for higher priority ones when the mempool is full.
 #  - "nop"   : nop-mempool (short for no operation; the ABCI app is responsible
 #  for storing, disseminating and proposing txs). "create_empty_blocks=false" is
 #  not supported.
@@ -410,12 +420,69 @@ recheck = {{ .Mempool.Recheck }}
 # the tx to will see it until it is included in a block.
 broadcast = {{ .Mempool.Broadcast }}
 
+# pull_gossip (default: false) enables pull-based transaction gossip with the
+# peers that support it. Instead of sending full transactions, the node
+# announces the keys of its transactions, and peers request only the
+# transactions they haven't seen yet. Transactions are still pushed to peers
+# that don't support (or haven't enabled) pull-based gossip.
+pull_gossip = {{ .Mempool.PullGossip }}
+
+# gossip_fanout (default: 0) limits the number of peers each transaction is
+# relayed to, not counting the peers the transaction was received from.
+# If 0, transactions are relayed to all peers.
+gossip_fanout = {{ .Mempool.GossipFanout }}
+
+# gossip_peer_selection (default: "random") defines how the peers a transaction
+# is relayed to are selected when gossip_fanout is set:
+#  - "random"  : peers are selected at random, for each transaction
+#  - "latency" : the peers with the lowest round-trip time are selected
+gossip_peer_selection = "{{ .Mempool.GossipPeerSelection }}"
+
+# adaptive_gossip_fanout (default: false) adapts the fan-out, between 1 and
+# gossip_fanout, to the ratio of transactions received from peers that were
+# already seen. The fan-out is decreased when this ratio is above
+# gossip_target_duplicate_ratio, and increased when it is below.
+adaptive_gossip_fanout = {{ .Mempool.AdaptiveGossipFanout }}
+gossip_target_duplicate_ratio = {{ .Mempool.GossipTargetDuplicateRatio }}
+
+# check_tx_batch_size (default: 0) is the maximum number of transactions
+# received from peers that are submitted to the application together.
+# Transactions are queued as they are received, and checked in batches by a
+# dedicated routine. Up to "size" transactions are queued. While the queue is
+# half full, no transactions are requested to the peers using pull-based
+# gossip; transactions received while it is full are dropped. If 0, each
+# transaction is checked as soon as it is received.
+check_tx_batch_size = {{ .Mempool.CheckTxBatchSize }}
+
+# max_in_flight_check_txs (default: 0) limits the number of transactions
+# received from peers that are being checked by the application at the same
+# time. Once the limit is reached, received transactions are queued as with
+# check_tx_batch_size until the application responds. If 0, there is no limit.
+max_in_flight_check_txs = {{ .Mempool.MaxInFlightCheckTxs }}
+
 # wal_dir (default: "") configures the location of the Write Ahead Log
 # (WAL) for the mempool. The WAL is disabled by default. To enable, set
 # wal_dir to where you want the WAL to be written (e.g.
 # "data/mempool.wal").
 wal_dir = "{{ js .Mempool.WalPath }}"
 
+# journal (default: false) keeps an on-disk journal of the transactions in the
+# mempool, stored in the "mempool" database (see db_backend). On startup, after
+# the handshake with the ABCI app, journaled transactions are checked again with
+# CheckTx, and the valid ones are added back to the mempool. Ignored by the
+# "nop" mempool.
+journal = {{ .Mempool.Journal }}
+
+# journal_max_age (default: 1h) is the maximum time a transaction can spend in
+# the journal. Older transactions are not replayed on startup. If 0, there is
+# no limit.
+journal_max_age = "{{ .Mempool.JournalMaxAge }}"
+
+# journal_max_bytes (default: 100MB) limits the total size of the transactions
+# in the journal. Transactions admitted to the mempool while the journal is full
+# are not journaled. If 0, there is no limit.
+journal_max_bytes = {{ .Mempool.JournalMaxBytes }}
+
 # Maximum number of transactions in the mempool
 size = {{ .Mempool.Size }}
 
@@ -424,6 +491,15 @@ size = {{ .Mempool.Size }}
 # max_txs_bytes=5MB, mempool will only accept 5 transactions).
 max_txs_bytes = {{ .Mempool.MaxTxsBytes }}
 
+# Maximum number of transactions of a single sender in the mempool. The sender
+# of a transaction is set by the ABCI app in CheckTx; transactions without a
+# sender are not limited. If 0, there is no limit other than size.
+max_txs_per_sender = {{ .Mempool.MaxTxsPerSender }}
+
+# Limit the total size of all txs of a single sender in the mempool. If 0, there
+# is no limit other than max_txs_bytes.
+max_txs_bytes_per_sender = {{ .Mempool.MaxTxsBytesPerSender }}
+
 # Size of the cache (used to filter transactions we saw earlier) in transactions
 cache_size = {{ .Mempool.CacheSize }}
 
@@ -436,6 +512,16 @@ keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 # NOTE: the max size of a tx transmitted over the network is {max_tx_bytes}.
 max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 
+# ttl_num_blocks (default: 0) is the maximum number of blocks a transaction can
+# stay in the mempool. Older transactions are removed when a block is
+# committed, without being rechecked. If 0, there is no limit.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
+# ttl_duration (default: 0s) is the maximum time a transaction can stay in the
+# mempool. Older transactions are removed when a block is committed, without
+# being rechecked. If 0, there is no limit.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
 # Experimental parameters to limit gossiping txs to up to the specified number of peers.
 # We use two independent upper values for persistent and non-persistent peers.
 # Unconditional peers are not affected by this feature.
@@ -451,6 +537,36 @@ max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 experimental_max_gossip_connections_to_persistent_peers = {{ .Mempool.ExperimentalMaxGossipConnectionsToPersistentPeers }}
 experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers }}
 
+# Lanes split the mempool into several lanes, each with its own limits and a
+# weight. The ABCI app assigns transactions to lanes by setting the lane field
+# of the CheckTx response. When reaping transactions for a block, lanes are
+# visited in a weighted round-robin fashion: in each round, up to "weight"
+# transactions are taken from each lane, in the order lanes are defined here.
+# Transactions without a lane, or with an unknown lane, go to the "default"
+# lane. If that lane is not defined, it is added with weight 1 and no limits
+# other than size and max_txs_bytes above.
+# A size or max_txs_bytes of 0 means that the lane is only limited by the
+# mempool's size and max_txs_bytes.
+# Lanes are disabled if none is defined (default). Example:
+#
+# [[mempool.lanes]]
+# name = "oracle"
+# size = 1000
+# max_txs_bytes = 1048576
+# weight = 4
+#
+# [[mempool.lanes]]
+# name = "default"
+# size = 4000
+# max_txs_bytes = 0
+# weight = 1
+{{ range .Mempool.Lanes }}
+[[mempool.lanes]]
+name = "{{ .Name }}"
+size = {{ .Size }}
+max_txs_bytes = {{ .MaxTxsBytes }}
+weight = {{ .Weight }}
+{{ end }}
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -507,6 +623,9 @@ version = "{{ .BlockSync.Version }}"
 
 wal_file = "{{ js .Consensus.WalPath }}"
 
+# The timeouts below, and skip_timeout_commit, are only used when they are not
+# set in the TimeoutParams consensus parameters of the chain.
+
 # How long we wait for a proposal block before prevoting nil
 timeout_propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout_propose increases with each round
@@ -530,9 +649,64 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
-# Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
+# Refuse to start signing if the last signing state of the private validator,
+# the consensus WAL and the block store disagree in a way that implies another
+# node is signing with the same key: the block store or the WAL has messages
+# of the validator signed after the last signing state of the private
+# validator, or the block store has a precommit of the validator which the WAL
+# does not record. A private validator ahead of the node, e.g. after a state
+# sync with an empty store, is not an error.
+# Only the block store and the WAL are checked with a remote signer.
+# The check can be skipped for a start with "cometbft start --override".
+double_sign_guard = {{ .Consensus.DoubleSignGuard }}
+
+# Make progress as soon as we have all the precommits (as if TimeoutCommit = 0).
+# Only used if none of the TimeoutParams consensus parameters are set.
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Adapt the propose and prevote timeouts of the first round of a height to the
+# message delays observed in the last heights, instead of using timeout_propose
+# and timeout_prevote (or the TimeoutParams consensus parameters):
+# - timeout_propose tracks the delay between a proposal and the prevotes of
+#   +2/3 of the voting power for it;
+# - timeout_prevote tracks the delay between these prevotes and the last ones.
+# The timeouts still increase with each round by their deltas.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+# Percentile of the observed delays used as timeouts, in (0, 1]. Only checked
+# if adaptive_timeouts is true.
+adaptive_timeout_percentile = {{ .Consensus.AdaptiveTimeoutPercentile }}
+# Bounds of the adaptive timeout_propose
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+# Bounds of the adaptive timeout_prevote
+timeout_prevote_min = "{{ .Consensus.TimeoutPrevoteMin }}"
+timeout_prevote_max = "{{ .Consensus.TimeoutPrevoteMax }}"
+
+# When the node is the proposer of the next height, create its proposal block,
+# calling PrepareProposal, during timeout_commit instead of after it. The block
+# keeps the last commit it was created with, even if more precommits for the
+# last block are received meanwhile. Not used at heights where PBTS is enabled,
+# as the time of the block would be too early, nor when waiting for
+# transactions (create_empty_blocks = false or create_empty_blocks_interval > 0).
+pipeline_proposals = {{ .Consensus.PipelineProposals }}
+
+# Call FinalizeBlock for a proposal as soon as ProcessProposal accepts it,
+# while the votes for it are gathered, instead of once it is decided. The
+# response is used if the proposal is decided, and discarded otherwise.
+# The application must support FinalizeBlock being called for a block that is
+# not decided, possibly several times per height, and before ExtendVote and
+# VerifyVoteExtension are called at this height: it must only persist the
+# state of the last executed block, on Commit.
+optimistic_execution = {{ .Consensus.OptimisticExecution }}
+
+# Number of last heights over which the liveness of the validators is tracked:
+# how many commits each validator did not sign or signed for nil, and how late
+# its signatures were. It is computed from the commits in the block store, so
+# it is kept across restarts, and is available via the validator_liveness RPC
+# endpoint and as metrics.
+# 0 - tracking is disabled.
+liveness_window = {{ .Consensus.LivenessWindow }}
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -542,6 +716,23 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Send proposal blocks to peers as compact blocks: the block without its
+# transactions, and the keys of the transactions, from which peers rebuild the
+# block using their mempool. Only used with peers that also enabled compact
+# blocks.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+
+# How long to wait for a peer to rebuild a compact block before sending it the
+# block parts it misses.
+compact_block_timeout = "{{ .Consensus.CompactBlockTimeout }}"
+
+# Location of the recording of the inputs of the consensus state machine: the
+# messages from peers and from this node's validator, the timeouts, and the
+# responses of the application, with the time they were processed. A recording
+# can be replayed offline with "cometbft replay-recording", to reproduce the
+# behavior of the node. The recording grows without bounds. Disabled if empty.
+record_file = "{{ js .Consensus.RecordPath }}"
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -644,4 +835,11 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
+
+# Maximum number of transactions whose lifecycle is traced, from their
+# arrival to their indexing. Traces are available via the tx_trace RPC
+# endpoint, and published as TxTrace events. The oldest traces are
+# dropped first.
+# 0 - tracing is disabled.
+tx_traces = {{ .Instrumentation.TxTraces }}
 `
@@ -0,0 +1,267 @@
+// Package bls12381 implements BLS signatures over the BLS12-381 curve, with
+// public keys in G1 and signatures in G2.
+//
+// Messages are augmented with the public key of the signer before being
+// hashed to G2, as in the BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_
+// ciphersuite of the IETF BLS signature draft. This makes the signed messages
+// of distinct signers distinct, so that signatures can be aggregated without
+// proofs of possession, and verified with VerifyAggregateSignature.
+package bls12381
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+
+	bls "github.com/cloudflare/circl/ecc/bls12381"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+const (
+	PrivKeyName = "cometbft/PrivKeyBls12_381"
+	PubKeyName  = "cometbft/PubKeyBls12_381"
+	// PrivKeySize is the size, in bytes, of private keys: a big-endian
+	// scalar.
+	PrivKeySize = bls.ScalarSize
+	// PubKeySize is the size, in bytes, of public keys: a compressed G1
+	// point.
+	PubKeySize = bls.G1SizeCompressed
+	// SignatureSize is the size, in bytes, of signatures: a compressed G2
+	// point.
+	SignatureSize = bls.G2SizeCompressed
+
+	KeyType = "bls12_381"
+)
+
+var (
+	ErrInvalidSignature = errors.New("bls12381: invalid signature")
+	ErrNoSignatures     = errors.New("bls12381: no signatures to aggregate")
+
+	// dst is the domain separation tag of the hash to G2.
+	dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_")
+)
+
+func init() {
+	cmtjson.RegisterType(PubKey{}, PubKeyName)
+	cmtjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+var _ crypto.PrivKey = PrivKey{}
+
+// PrivKey implements crypto.PrivKey.
+type PrivKey []byte
+
+// GenPrivKey generates a new private key, using OS randomness.
+func GenPrivKey() PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	var sk bls.Scalar
+	for {
+		if err := sk.Random(rand); err != nil {
+			panic(err)
+		}
+		if sk.IsZero() == 0 {
+			break
+		}
+	}
+	bz, err := sk.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return PrivKey(bz)
+}
+
+// Bytes returns the private key as a big-endian scalar.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Sign produces a signature of msg, augmented with the public key.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.scalar()
+	if err != nil {
+		return nil, err
+	}
+	pk := privKey.PubKey().(PubKey)
+
+	sig := hashToG2(pk, msg)
+	sig.ScalarMult(sk, sig)
+	return sig.BytesCompressed(), nil
+}
+
+// PubKey returns the public key, the product of the private key scalar and
+// the G1 generator. It panics if the private key is invalid.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	sk, err := privKey.scalar()
+	if err != nil {
+		panic(err)
+	}
+	var pk bls.G1
+	pk.ScalarMult(sk, bls.G1Generator())
+	return PubKey(pk.BytesCompressed())
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherBls, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBls[:]) == 1
+	}
+	return false
+}
+
+func (PrivKey) Type() string {
+	return KeyType
+}
+
+func (privKey PrivKey) scalar() (*bls.Scalar, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, fmt.Errorf("bls12381: invalid private key length: got %d, want %d", len(privKey), PrivKeySize)
+	}
+	sk := new(bls.Scalar)
+	if err := sk.UnmarshalBinary(privKey); err != nil {
+		return nil, fmt.Errorf("bls12381: invalid private key: %w", err)
+	}
+	if sk.IsZero() == 1 {
+		return nil, errors.New("bls12381: invalid private key: zero scalar")
+	}
+	return sk, nil
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKey{}
+
+// PubKey implements crypto.PubKey. It is a compressed G1 point.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	if len(pubKey) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.AddressHash(pubKey)
+}
+
+// Bytes returns the compressed G1 point.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a signature of msg, augmented with the public key.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	return VerifyAggregateSignature([]PubKey{pubKey}, [][]byte{msg}, sig)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12_381{%X}", []byte(pubKey))
+}
+
+func (PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherBls, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherBls[:])
+	}
+	return false
+}
+
+// point returns the G1 point of the public key. It returns an error if the
+// public key is not a valid, non-identity, G1 point.
+func (pubKey PubKey) point() (*bls.G1, error) {
+	if len(pubKey) != PubKeySize {
+		return nil, fmt.Errorf("bls12381: invalid public key length: got %d, want %d", len(pubKey), PubKeySize)
+	}
+	pk := new(bls.G1)
+	if err := pk.SetBytes(pubKey); err != nil {
+		return nil, fmt.Errorf("bls12381: invalid public key: %w", err)
+	}
+	if pk.IsIdentity() {
+		return nil, errors.New("bls12381: invalid public key: identity point")
+	}
+	return pk, nil
+}
+
+//-------------------------------------
+
+// AggregateSignatures aggregates signatures into a single signature, which can
+// be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	var agg bls.G2
+	agg.SetIdentity()
+	for i, sig := range sigs {
+		p, err := signaturePoint(sig)
+		if err != nil {
+			return nil, fmt.Errorf("signature #%d: %w", i, err)
+		}
+		agg.Add(&agg, p)
+	}
+	return agg.BytesCompressed(), nil
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregation of signatures
+// of msgs[i] by pubKeys[i], for every i. It returns false if the lengths of
+// pubKeys and msgs differ, or if any of the public keys is invalid.
+func VerifyAggregateSignature(pubKeys []PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+	s, err := signaturePoint(sig)
+	if err != nil {
+		return false
+	}
+
+	// Check that e(g1, sig) == e(pk_1, H(pk_1 || msg_1)) * ... * e(pk_n, H(pk_n || msg_n)).
+	g1s := make([]*bls.G1, 0, len(pubKeys)+1)
+	g2s := make([]*bls.G2, 0, len(pubKeys)+1)
+	signs := make([]int, 0, len(pubKeys)+1)
+	for i, pubKey := range pubKeys {
+		pk, err := pubKey.point()
+		if err != nil {
+			return false
+		}
+		g1s = append(g1s, pk)
+		g2s = append(g2s, hashToG2(pubKey, msgs[i]))
+		signs = append(signs, 1)
+	}
+	g1s = append(g1s, bls.G1Generator())
+	g2s = append(g2s, s)
+	signs = append(signs, -1)
+
+	return bls.ProdPairFrac(g1s, g2s, signs).IsIdentity()
+}
+
+// signaturePoint returns the G2 point of a signature.
+func signaturePoint(sig []byte) (*bls.G2, error) {
+	if len(sig) != SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	p := new(bls.G2)
+	if err := p.SetBytes(sig); err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return p, nil
+}
+
+// hashToG2 hashes msg, augmented with the public key, to G2.
+func hashToG2(pubKey PubKey, msg []byte) *bls.G2 {
+	augMsg := make([]byte, 0, len(pubKey)+len(msg))
+	augMsg = append(augMsg, pubKey...)
+	augMsg = append(augMsg, msg...)
+
+	p := new(bls.G2)
+	p.Hash(augMsg, dst)
+	return p
+}
@@ -0,0 +1,76 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+func TestSignAndValidateBls12381(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature(append(msg, 0), sig))
+	assert.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 4
+	var (
+		pubKeys = make([]bls12381.PubKey, n)
+		msgs    = make([][]byte, n)
+		sigs    = make([][]byte, n)
+	)
+	for i := 0; i < n; i++ {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey().(bls12381.PubKey)
+		// The first two signers sign the same message.
+		msgs[i] = []byte{byte(i / 2 * 2)}
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	agg, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, agg, bls12381.SignatureSize)
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, agg))
+
+	// A missing signer, a wrong message, or a wrong signer are detected.
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, [][]byte{{9}, msgs[1], msgs[2], msgs[3]}, agg))
+	other := bls12381.GenPrivKey().PubKey().(bls12381.PubKey)
+	assert.False(t, bls12381.VerifyAggregateSignature(
+		[]bls12381.PubKey{other, pubKeys[1], pubKeys[2], pubKeys[3]}, msgs, agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, msgs[1:], agg))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.ErrorIs(t, err, bls12381.ErrNoSignatures)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0], make([]byte, 64)})
+	require.Error(t, err)
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	assert.True(t, pubKey.Equals(privKey.PubKey()))
+	assert.False(t, pubKey.Equals(bls12381.GenPrivKey().PubKey()))
+	assert.Equal(t, bls12381.KeyType, pubKey.Type())
+	assert.Len(t, pubKey.Address(), crypto.AddressSize)
+}
@@ -238,6 +238,16 @@ func (bA *BitArray) IsFull() bool {
 	return (lastElem+1)&((uint64(1)<<uint(lastElemBits))-1) == 0
 }
 
+// NumTrueBits returns the number of bits set to 1 in the bit array.
+func (bA *BitArray) NumTrueBits() int {
+	if bA == nil {
+		return 0
+	}
+	bA.mtx.Lock()
+	defer bA.mtx.Unlock()
+	return len(bA.getTrueIndices())
+}
+
 // PickRandom returns a random index for a set bit in the bit array.
 // If there is no such value, it returns 0, false.
 // It uses the global randomness in `random.go` to get this index.
@@ -187,6 +187,19 @@ func TestEmptyFull(t *testing.T) {
 	}
 }
 
+func TestNumTrueBits(t *testing.T) {
+	var nilBA *BitArray
+	assert.Zero(t, nilBA.NumTrueBits())
+
+	bA := NewBitArray(123)
+	assert.Zero(t, bA.NumTrueBits())
+	bA.SetIndex(0, true)
+	bA.SetIndex(64, true)
+	bA.SetIndex(122, true)
+	assert.Equal(t, 3, bA.NumTrueBits())
+	assert.Equal(t, 120, bA.Not().NumTrueBits())
+}
+
 func TestUpdateNeverPanics(_ *testing.T) {
 	newRandBitArray := func(n int) *BitArray {
 		ba := randBitArray(n)
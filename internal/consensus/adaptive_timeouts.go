@@ -0,0 +1,64 @@
+package consensus
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// Number of heights over which the message delays are observed.
+	delayWindowSize = 100
+	// Number of observed delays below which the adaptive timeouts are not
+	// used yet.
+	delayWindowMinSamples = 10
+)
+
+// delayWindow holds the last delays observed for a kind of message, from which
+// adaptive timeouts are computed.
+type delayWindow struct {
+	delays []time.Duration
+	next   int // index of the oldest delay once the window is full
+}
+
+func newDelayWindow() *delayWindow {
+	return &delayWindow{delays: make([]time.Duration, 0, delayWindowSize)}
+}
+
+// add records a delay, replacing the oldest one if the window is full.
+// Negative delays, due to clock drift between validators, are recorded as 0.
+func (w *delayWindow) add(d time.Duration) {
+	d = max(d, 0)
+	if len(w.delays) < delayWindowSize {
+		w.delays = append(w.delays, d)
+		return
+	}
+	w.delays[w.next] = d
+	w.next = (w.next + 1) % delayWindowSize
+}
+
+// percentile returns the p-th percentile, with p in (0, 1], of the delays in
+// the window. It returns false if too few delays were observed.
+func (w *delayWindow) percentile(p float64) (time.Duration, bool) {
+	if len(w.delays) < delayWindowMinSamples {
+		return 0, false
+	}
+	sorted := slices.Clone(w.delays)
+	slices.Sort(sorted)
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)], true
+}
+
+// adaptiveTimeout returns the p-th percentile of the delays in w, bounded by
+// minTimeout and maxTimeout, if adaptive timeouts are enabled and enough
+// delays were observed. Otherwise, it returns timeout.
+func (cs *State) adaptiveTimeout(w *delayWindow, timeout, minTimeout, maxTimeout time.Duration) time.Duration {
+	if !cs.config.AdaptiveTimeouts {
+		return timeout
+	}
+	d, ok := w.percentile(cs.config.AdaptiveTimeoutPercentile)
+	if !ok {
+		return timeout
+	}
+	return min(max(d, minTimeout), maxTimeout)
+}
@@ -470,7 +470,7 @@ func randStateWithAppWithHeight(
 	height int64,
 ) (*State, []*validatorStub) {
 	c := test.ConsensusParams()
-	c.ABCI.VoteExtensionsEnableHeight = height
+	c.ABCI.VoteExtensionsEnableHeight = height //nolint:staticcheck
 	return randStateWithAppImpl(nValidators, app, c)
 }
 
@@ -0,0 +1,372 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v1"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Compact blocks
+//
+// When compact blocks are enabled, a node that has a complete proposal block
+// sends it to the peers that have the proposal, and that also enabled compact
+// blocks, as a CompactBlockMessage: the block without its transactions, and
+// the keys of the transactions. A peer receiving a compact block looks up the
+// transactions in its mempool, requests the ones it does not have from the
+// sender with a CompactBlockTxsRequestMessage, rebuilds the block, and adds
+// its parts to the consensus state as if they were received from the sender.
+//
+// The block parts are not sent to a peer that was sent a compact block, unless
+// it still misses parts after compact_block_timeout, for instance because it
+// could not get the missing transactions, or the rebuilt block did not match
+// the part set header of the proposal.
+
+// TxFetcher looks up transactions by their keys, to rebuild compact blocks. It
+// is implemented by mempool.CListMempool.
+type TxFetcher interface {
+	GetTxByKey(key types.TxKey) (types.Tx, bool)
+}
+
+// ReactorTxFetcher sets the TxFetcher used to rebuild compact blocks received
+// from peers. Without it, all the transactions of compact blocks are requested
+// from the peers that sent them.
+func ReactorTxFetcher(txFetcher TxFetcher) ReactorOption {
+	return func(conR *Reactor) { conR.txFetcher = txFetcher }
+}
+
+// pendingCompactBlock is a compact block received from a peer, which is being
+// rebuilt.
+type pendingCompactBlock struct {
+	msg          *CompactBlockMessage
+	peerID       p2p.ID
+	txs          types.Txs // nil for the transactions that are still missing
+	erasureCoded bool      // true if the part set of the block is erasure coded
+	done         bool      // true once the block has been rebuilt, or failed to be
+	rebuilt      bool      // true if the block has been rebuilt
+}
+
+// missingTxs returns the indexes of the transactions that are still missing.
+func (cb *pendingCompactBlock) missingTxs() []uint32 {
+	var indexes []uint32
+	for i, tx := range cb.txs {
+		if tx == nil {
+			indexes = append(indexes, uint32(i))
+		}
+	}
+	return indexes
+}
+
+// rebuild returns the parts of the block, once all its transactions are known.
+// It returns an error if the rebuilt block does not match the part set header
+// of the compact block.
+func (cb *pendingCompactBlock) rebuild() (*types.PartSet, error) {
+	block := &types.Block{
+		Header:     cb.msg.Block.Header,
+		Data:       types.Data{Txs: cb.txs},
+		Evidence:   cb.msg.Block.Evidence,
+		LastCommit: cb.msg.Block.LastCommit,
+	}
+	var (
+		parts *types.PartSet
+		err   error
+	)
+	if cb.erasureCoded {
+		parts, err = block.MakeErasurePartSet(types.BlockPartSizeBytes)
+	} else {
+		parts, err = block.MakePartSet(types.BlockPartSizeBytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !parts.HasHeader(cb.msg.PartSetHeader) {
+		return nil, fmt.Errorf("rebuilt block has part set header %v, expected %v",
+			parts.Header(), cb.msg.PartSetHeader)
+	}
+	return parts, nil
+}
+
+// usesCompactBlocks returns true if proposal blocks are sent to peer as
+// compact blocks, that is, if both this node and peer have enabled compact
+// blocks.
+func (conR *Reactor) usesCompactBlocks(peer p2p.Peer) bool {
+	if !conR.conS.config.CompactBlocks {
+		return false
+	}
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(CompactBlockChannel)
+}
+
+// waitingForCompactBlock returns true if a compact block was sent to the peer
+// less than timeout ago, in which case it is not sent block parts yet.
+func waitingForCompactBlock(prs *cstypes.PeerRoundState, timeout time.Duration) bool {
+	return !prs.CompactBlockSent.IsZero() && cmttime.Now().Sub(prs.CompactBlockSent) < timeout
+}
+
+// compactBlockNotDecoded returns true if the proposal block is to be sent to
+// peer as a compact block, but the consensus state has not decoded it from its
+// complete parts yet, in which case the parts are not sent to the peer.
+func (conR *Reactor) compactBlockNotDecoded(peer p2p.Peer, rs *cstypes.RoundState, prs *cstypes.PeerRoundState) bool {
+	return rs.ProposalBlock == nil && rs.ProposalBlockParts.IsComplete() &&
+		rs.Height == prs.Height && rs.Round == prs.Round && prs.CompactBlockSent.IsZero() &&
+		conR.usesCompactBlocks(peer)
+}
+
+// sendCompactBlock sends the proposal block to peer as a compact block, if the
+// peer uses compact blocks, has the proposal but not all the block parts, and
+// was not sent a compact block for this round yet. It returns true if a
+// compact block was sent.
+func (conR *Reactor) sendCompactBlock(peer p2p.Peer, ps *PeerState, rs *cstypes.RoundState, prs *cstypes.PeerRoundState) bool {
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal || !prs.CompactBlockSent.IsZero() {
+		return false
+	}
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) ||
+		!rs.ProposalBlockParts.IsComplete() {
+		return false
+	}
+	if prs.ProposalBlockParts != nil && prs.ProposalBlockParts.IsFull() {
+		return false
+	}
+	if !conR.usesCompactBlocks(peer) {
+		return false
+	}
+	msg := conR.compactBlockToSend(rs)
+	if msg == nil {
+		return false
+	}
+	if !peer.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: msg}) {
+		return false
+	}
+	ps.SetCompactBlockSent(rs.Height, rs.Round)
+	conR.Logger.Debug("Sent compact block", "peer", peer, "height", rs.Height, "round", rs.Round, "txs", len(msg.TxKeys))
+	return true
+}
+
+// compactBlockToSend returns the compact block of the proposal block of rs. It
+// returns nil if the compact block would not be smaller than the block parts,
+// in which case the parts are sent instead.
+func (conR *Reactor) compactBlockToSend(rs *cstypes.RoundState) *cmtcons.CompactBlock {
+	conR.compactMtx.Lock()
+	defer conR.compactMtx.Unlock()
+
+	if sent := conR.compactSent; sent.height == rs.Height && sent.round == rs.Round &&
+		sent.partSetHeader.Equals(rs.ProposalBlockParts.Header()) {
+		return sent.msg
+	}
+
+	msg := &CompactBlockMessage{
+		Height:        rs.Height,
+		Round:         rs.Round,
+		PartSetHeader: rs.ProposalBlockParts.Header(),
+		Block: &types.Block{
+			Header:     rs.ProposalBlock.Header,
+			Evidence:   rs.ProposalBlock.Evidence,
+			LastCommit: rs.ProposalBlock.LastCommit,
+		},
+		TxKeys: make([]types.TxKey, len(rs.ProposalBlock.Txs)),
+	}
+	for i, tx := range rs.ProposalBlock.Txs {
+		msg.TxKeys[i] = tx.Key()
+	}
+	pb, err := compactBlockToProto(msg)
+	if err != nil {
+		conR.Logger.Error("Error converting compact block to proto", "height", rs.Height, "round", rs.Round, "err", err)
+		pb = nil
+	} else if int64(pb.Size()) >= rs.ProposalBlockParts.ByteSize() {
+		pb = nil
+	}
+
+	conR.compactSent.height = rs.Height
+	conR.compactSent.round = rs.Round
+	conR.compactSent.partSetHeader = rs.ProposalBlockParts.Header()
+	conR.compactSent.msg = pb
+	return pb
+}
+
+// handleCompactBlock starts rebuilding a compact block received from src, if
+// it is for the proposal block of the current round, and no compact block of
+// the same block is being rebuilt. The transactions that are not in the
+// mempool are requested from src.
+//
+// The proposal may not have been processed by the consensus state yet when
+// the compact block is received, in which case its part set header cannot be
+// checked. Compact blocks with different part set headers are thus rebuilt
+// independently, so that a faulty peer does not prevent the proposal block
+// from being rebuilt from the compact blocks of other peers.
+func (conR *Reactor) handleCompactBlock(msg *CompactBlockMessage, src p2p.Peer, ps *PeerState) {
+	rs := conR.getRoundState()
+	if msg.Height != rs.Height || msg.Round != rs.Round ||
+		(rs.ProposalBlockParts != nil && rs.ProposalBlockParts.IsComplete()) {
+		return
+	}
+	if rs.ProposalBlockParts != nil && !rs.ProposalBlockParts.HasHeader(msg.PartSetHeader) {
+		conR.Logger.Debug("Ignoring compact block not matching the proposal", "peer", src,
+			"height", msg.Height, "round", msg.Round, "part_set_header", msg.PartSetHeader)
+		return
+	}
+	erasureCoded := conR.conS.GetState().ConsensusParams.Feature.ErasureCodingEnabled(msg.Height)
+
+	conR.compactMtx.Lock()
+	if len(conR.compactPending) > 0 &&
+		(conR.compactPending[0].msg.Height != msg.Height || conR.compactPending[0].msg.Round != msg.Round) {
+		conR.compactPending = nil
+	}
+	for _, cb := range conR.compactPending {
+		// A compact block that failed to be rebuilt does not prevent another
+		// one of the same block from being rebuilt.
+		if cb.peerID == src.ID() ||
+			(cb.msg.PartSetHeader.Equals(msg.PartSetHeader) && (!cb.done || cb.rebuilt)) {
+			conR.compactMtx.Unlock()
+			return
+		}
+	}
+	cb := &pendingCompactBlock{
+		msg:          msg,
+		peerID:       src.ID(),
+		txs:          make(types.Txs, len(msg.TxKeys)),
+		erasureCoded: erasureCoded,
+	}
+	if conR.txFetcher != nil {
+		for i, key := range msg.TxKeys {
+			if tx, ok := conR.txFetcher.GetTxByKey(key); ok {
+				cb.txs[i] = tx
+			}
+		}
+	}
+	conR.compactPending = append(conR.compactPending, cb)
+	missing := cb.missingTxs()
+	conR.compactMtx.Unlock()
+
+	if len(missing) == 0 {
+		conR.rebuildCompactBlock(cb, src, ps)
+		return
+	}
+	conR.Metrics.CompactBlockMissingTxs.Add(float64(len(missing)))
+	conR.Logger.Debug("Requesting missing transactions of compact block", "peer", src,
+		"height", msg.Height, "round", msg.Round, "missing", len(missing), "txs", len(msg.TxKeys))
+	src.Send(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: missing,
+		},
+	})
+}
+
+// handleCompactBlockTxsRequest sends to src the requested transactions of the
+// proposal block, if it is still the proposal block of the current round. Each
+// transaction is sent once, even if it is requested several times.
+func (conR *Reactor) handleCompactBlockTxsRequest(msg *CompactBlockTxsRequestMessage, src p2p.Peer) {
+	rs := conR.getRoundState()
+	if msg.Height != rs.Height || msg.Round != rs.Round || rs.ProposalBlock == nil {
+		return
+	}
+	txs := rs.ProposalBlock.Txs
+	if len(msg.Indexes) > len(txs) {
+		conR.Switch.StopPeerForError(src, fmt.Errorf("requested %d transactions of a block with %d transactions", len(msg.Indexes), len(txs)))
+		return
+	}
+	resp := &cmtcons.CompactBlockTxs{
+		Height:  msg.Height,
+		Round:   msg.Round,
+		Indexes: make([]uint32, 0, len(msg.Indexes)),
+		Txs:     make([][]byte, 0, len(msg.Indexes)),
+	}
+	requested := make(map[uint32]struct{}, len(msg.Indexes))
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			conR.Switch.StopPeerForError(src, fmt.Errorf("requested transaction %d of a block with %d transactions", index, len(txs)))
+			return
+		}
+		if _, ok := requested[index]; ok {
+			continue
+		}
+		requested[index] = struct{}{}
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, txs[index])
+	}
+	src.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp})
+}
+
+// handleCompactBlockTxs adds the transactions received from src to the compact
+// block being rebuilt, and rebuilds it once all its transactions are known.
+func (conR *Reactor) handleCompactBlockTxs(msg *CompactBlockTxsMessage, src p2p.Peer, ps *PeerState) {
+	conR.compactMtx.Lock()
+	cb := conR.pendingCompactBlockFrom(src.ID())
+	if cb == nil || cb.done || cb.msg.Height != msg.Height || cb.msg.Round != msg.Round {
+		conR.compactMtx.Unlock()
+		return
+	}
+	for i, index := range msg.Indexes {
+		if int(index) >= len(cb.txs) || msg.Txs[i].Key() != cb.msg.TxKeys[index] {
+			cb.done = true
+			conR.compactMtx.Unlock()
+			conR.Switch.StopPeerForError(src, errors.New("received transactions not matching the compact block"))
+			return
+		}
+		cb.txs[index] = msg.Txs[i]
+	}
+	missing := cb.missingTxs()
+	conR.compactMtx.Unlock()
+
+	if len(missing) > 0 {
+		conR.Logger.Debug("Compact block still misses transactions", "peer", src,
+			"height", msg.Height, "round", msg.Round, "missing", len(missing))
+		return
+	}
+	conR.rebuildCompactBlock(cb, src, ps)
+}
+
+// pendingCompactBlockFrom returns the compact block received from the peer
+// with the given ID, if it is being rebuilt. Must be called with compactMtx
+// held.
+func (conR *Reactor) pendingCompactBlockFrom(peerID p2p.ID) *pendingCompactBlock {
+	for _, cb := range conR.compactPending {
+		if cb.peerID == peerID {
+			return cb
+		}
+	}
+	return nil
+}
+
+// rebuildCompactBlock rebuilds the compact block received from src, once all
+// its transactions are known, and adds the parts of the block to the consensus
+// state as if they were received from src. If the block cannot be rebuilt, the
+// parts are sent by the peers after compact_block_timeout.
+func (conR *Reactor) rebuildCompactBlock(cb *pendingCompactBlock, src p2p.Peer, ps *PeerState) {
+	conR.compactMtx.Lock()
+	if cb.done {
+		conR.compactMtx.Unlock()
+		return
+	}
+	cb.done = true
+	conR.compactMtx.Unlock()
+
+	parts, err := cb.rebuild()
+	if err != nil {
+		conR.Metrics.CompactBlocks.With("status", "failed").Add(1)
+		conR.Logger.Info("Failed to rebuild compact block", "peer", src,
+			"height", cb.msg.Height, "round", cb.msg.Round, "err", err)
+		return
+	}
+	conR.compactMtx.Lock()
+	cb.rebuilt = true
+	conR.compactMtx.Unlock()
+	conR.Metrics.CompactBlocks.With("status", "rebuilt").Add(1)
+	conR.Logger.Debug("Rebuilt compact block", "peer", src, "height", cb.msg.Height, "round", cb.msg.Round)
+
+	for i := 0; i < int(parts.Total()); i++ {
+		ps.SetHasProposalBlockPart(cb.msg.Height, cb.msg.Round, i)
+		conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{
+			Height: cb.msg.Height,
+			Round:  cb.msg.Round,
+			Part:   parts.GetPart(i),
+		}, src.ID(), cmttime.Now()}
+	}
+}
@@ -0,0 +1,229 @@
+package consensus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// Number of last heights of the block store searched for a precommit of the
+// validator by the double-sign guard.
+const doubleSignGuardHeights = 100
+
+// Steps of the signed messages, ordered as in the signing state of
+// privval.FilePV.
+const (
+	signStepPropose   int8 = 1
+	signStepPrevote   int8 = 2
+	signStepPrecommit int8 = 3
+)
+
+// lastSignStater is implemented by the private validators that keep the
+// height, round and step of the last message they signed, such as
+// privval.FilePV.
+type lastSignStater interface {
+	LastSignedHRS() (height int64, round int32, step int8)
+}
+
+// signedHRS is the height, round and step of a message signed by the
+// validator.
+type signedHRS struct {
+	height int64
+	round  int32
+	step   int8
+}
+
+func (s signedHRS) after(height int64, round int32, step int8) bool {
+	if s.height != height {
+		return s.height > height
+	}
+	if s.round != round {
+		return s.round > round
+	}
+	return s.step > step
+}
+
+func (s signedHRS) String() string {
+	kind := map[int8]string{
+		signStepPropose:   "proposal",
+		signStepPrevote:   "prevote",
+		signStepPrecommit: "precommit",
+	}[s.step]
+	return fmt.Sprintf("%s at height %d round %d", kind, s.height, s.round)
+}
+
+// CheckDoubleSignGuard is like checkDoubleSignGuard, for the node to run it
+// before block sync, as the consensus state only starts once block sync is
+// done. It opens the WAL for the duration of the check if the consensus state
+// has not opened it yet.
+func (cs *State) CheckDoubleSignGuard() error {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	if !cs.doubleSignGuardEnabled() {
+		return nil
+	}
+	wal := cs.wal
+	if _, ok := wal.(nilWAL); ok {
+		var err error
+		if wal, err = cs.OpenWAL(cs.config.WalFile()); err != nil {
+			return err
+		}
+		defer func() {
+			if err := wal.Stop(); err != nil {
+				cs.Logger.Error("failed to stop WAL", "err", err)
+			}
+			wal.Wait()
+		}()
+	}
+	return cs.checkDoubleSignGuard(wal)
+}
+
+func (cs *State) doubleSignGuardEnabled() bool {
+	return cs.config.DoubleSignGuard && cs.privValidator != nil && cs.privValidatorPubKey != nil
+}
+
+// checkDoubleSignGuard returns an ErrDoubleSignRisk if the last signing state
+// of the private validator, wal and the block store disagree in a way that
+// implies another node is signing with the same key. It must be called
+// before the state machine may sign, including when replaying the WAL.
+func (cs *State) checkDoubleSignGuard(wal WAL) error {
+	if !cs.doubleSignGuardEnabled() {
+		return nil
+	}
+	address := cs.privValidatorPubKey.Address()
+	lastHeight := cs.Height - 1
+
+	walSigned, walHasLastHeight, err := cs.walSignedMessages(wal, lastHeight, address)
+	if err != nil {
+		return err
+	}
+
+	if pv, ok := cs.privValidator.(lastSignStater); ok {
+		// A private validator ahead of the node is safe, as it refuses to sign
+		// below its last signing state: the node may have been restored or
+		// synced with an empty store.
+		height, round, step := pv.LastSignedHRS()
+		if h := cs.lastPrecommitHeightInStore(address); h > height {
+			return ErrDoubleSignRisk{Reason: fmt.Sprintf(
+				"the block store has a precommit of the validator at height %d, but the private validator last signed at height %d",
+				h, height)}
+		}
+		for _, s := range walSigned {
+			if s.after(height, round, step) {
+				return ErrDoubleSignRisk{Reason: fmt.Sprintf(
+					"the WAL has a %v signed by the validator, after the last signing state of the private validator (height %d round %d step %d)",
+					s, height, round, step)}
+			}
+		}
+	}
+
+	// If the node committed the last height in consensus, it signed the
+	// precommits of the validator in its commit, which the WAL records.
+	if !walHasLastHeight {
+		return nil
+	}
+	commit := cs.blockStore.LoadSeenCommit(lastHeight)
+	if commit == nil || !hasPrecommit(commit, address) {
+		return nil
+	}
+	for _, s := range walSigned {
+		if s.height == lastHeight && s.round == commit.Round && s.step == signStepPrecommit {
+			return nil
+		}
+	}
+	return ErrDoubleSignRisk{Reason: fmt.Sprintf(
+		"the commit of height %d has a precommit of the validator at round %d, which the WAL does not record",
+		lastHeight, commit.Round)}
+}
+
+// walSignedMessages returns the messages signed by the validator with the
+// given address that wal records for lastHeight and the next height. It also
+// returns whether wal records the whole of lastHeight. Data corruption in the
+// WAL ends the search, as it is repaired when replaying the WAL.
+func (cs *State) walSignedMessages(wal WAL, lastHeight int64, address types.Address) ([]signedHRS, bool, error) {
+	endHeight := lastHeight - 1
+	if lastHeight <= cs.state.InitialHeight {
+		endHeight = 0
+	}
+	gr, found, err := wal.SearchForEndHeight(endHeight, &WALSearchOptions{IgnoreDataCorruptionErrors: true})
+	if err != nil && err != io.EOF {
+		return nil, false, err
+	}
+	if !found {
+		return nil, false, nil
+	}
+	defer gr.Close()
+
+	var (
+		signed        []signedHRS
+		hasLastHeight bool
+		dec           = WALDecoder{gr}
+	)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF || IsDataCorruptionError(err) {
+			return signed, hasLastHeight, nil
+		} else if err != nil {
+			return nil, false, err
+		}
+
+		switch m := msg.Msg.(type) {
+		case EndHeightMessage:
+			if m.Height == lastHeight {
+				hasLastHeight = true
+			}
+		case msgInfo:
+			// Messages signed by the validator are sent by the node to itself.
+			if m.PeerID != "" {
+				continue
+			}
+			switch msg := m.Msg.(type) {
+			case *ProposalMessage:
+				p := msg.Proposal
+				signBytes := types.ProposalSignBytes(cs.state.ChainID, p.ToProto())
+				if cs.privValidatorPubKey.VerifySignature(signBytes, p.Signature) {
+					signed = append(signed, signedHRS{p.Height, p.Round, signStepPropose})
+				}
+			case *VoteMessage:
+				v := msg.Vote
+				if !bytes.Equal(v.ValidatorAddress, address) {
+					continue
+				}
+				step := signStepPrevote
+				if v.Type == types.PrecommitType {
+					step = signStepPrecommit
+				}
+				signed = append(signed, signedHRS{v.Height, v.Round, step})
+			}
+		}
+	}
+}
+
+// lastPrecommitHeightInStore returns the last height, among the last ones of
+// the block store, whose commit has a precommit of the validator with the
+// given address, or 0.
+func (cs *State) lastPrecommitHeightInStore(address types.Address) int64 {
+	last := cs.blockStore.Height()
+	first := max(cs.blockStore.Base(), last-doubleSignGuardHeights+1, 1)
+	for height := last; height >= first; height-- {
+		commit := cs.blockStore.LoadSeenCommit(height)
+		if commit != nil && hasPrecommit(commit, address) {
+			return height
+		}
+	}
+	return 0
+}
+
+// hasPrecommit returns true if commit has a precommit for the committed block
+// of the validator with the given address.
+func hasPrecommit(commit *types.Commit, address types.Address) bool {
+	for _, s := range commit.Signatures {
+		if s.BlockIDFlag == types.BlockIDFlagCommit && bytes.Equal(s.ValidatorAddress, address) {
+			return true
+		}
+	}
+	return false
+}
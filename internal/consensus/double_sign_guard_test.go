@@ -0,0 +1,103 @@
+package consensus
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// lastSignStatePV is a private validator with the given last signing state.
+type lastSignStatePV struct {
+	types.PrivValidator
+	hrs signedHRS
+}
+
+func (pv lastSignStatePV) LastSignedHRS() (height int64, round int32, step int8) {
+	return pv.hrs.height, pv.hrs.round, pv.hrs.step
+}
+
+func TestDoubleSignGuard(t *testing.T) {
+	app := newHaltingApp(4)
+	cs1, _ := randStateWithApp(1, app)
+	require.NoError(t, cs1.Start())
+	select {
+	case <-app.halted:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the blocks")
+	}
+
+	// newGuardedState returns a state restarted from the stores of cs1, with
+	// pv as private validator and wal as WAL.
+	newGuardedState := func(pv types.PrivValidator, wal WAL) *State {
+		cs := NewState(cs1.config, cs1.state, cs1.blockExec, cs1.blockStore, cs1.txNotifier, cs1.evpool)
+		cs.SetPrivValidator(pv)
+		cs.wal = wal
+		return cs
+	}
+
+	cs2 := newGuardedState(cs1.privValidator, cs1.wal)
+	lastHeight := cs2.Height - 1
+	signed, hasLastHeight, err := cs2.walSignedMessages(cs2.wal, lastHeight, cs2.privValidatorPubKey.Address())
+	require.NoError(t, err)
+	require.True(t, hasLastHeight)
+	require.NotEmpty(t, signed)
+	last := signed[len(signed)-1]
+
+	testCases := []struct {
+		name string
+		hrs  signedHRS
+		err  string
+	}{
+		{"last signed message", last, ""},
+		{"signed above the node", signedHRS{cs2.Height + 1, 0, signStepPropose}, ""},
+		{"precommit in the block store", signedHRS{lastHeight - 1, 0, signStepPrecommit}, "the block store has a precommit"},
+		{"message in the WAL", signedHRS{last.height, last.round, last.step - 1}, "the WAL has a"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := newGuardedState(lastSignStatePV{cs1.privValidator, tc.hrs}, cs1.wal)
+			err := cs.checkDoubleSignGuard(cs.wal)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorAs(t, err, &ErrDoubleSignRisk{})
+			assert.Contains(t, err.Error(), tc.err)
+			require.ErrorAs(t, cs.CheckDoubleSignGuard(), &ErrDoubleSignRisk{})
+
+			// Switching to consensus after syncing does not start it.
+			reactor := NewReactor(cs, true)
+			assert.NotPanics(t, func() {
+				reactor.SwitchToConsensus(cs.state, false)
+			})
+			assert.False(t, cs.IsRunning())
+
+			cfg := *cs1.config
+			cfg.DoubleSignGuard = false
+			cs.config = &cfg
+			require.NoError(t, cs.checkDoubleSignGuard(cs.wal))
+		})
+	}
+
+	// The commit of the last height has a precommit of the validator, which
+	// the WAL does not record, so another node signed it.
+	walFile := filepath.Join(t.TempDir(), "wal")
+	wal, err := NewWAL(walFile)
+	require.NoError(t, err)
+	require.NoError(t, wal.Start())
+	require.NoError(t, wal.WriteSync(EndHeightMessage{lastHeight - 1}))
+	require.NoError(t, wal.WriteSync(EndHeightMessage{lastHeight}))
+	t.Cleanup(func() {
+		require.NoError(t, wal.Stop())
+		wal.Wait()
+	})
+	cs3 := newGuardedState(cs1.privValidator, wal)
+	err = cs3.checkDoubleSignGuard(cs3.wal)
+	require.ErrorAs(t, err, &ErrDoubleSignRisk{})
+	assert.Contains(t, err.Error(), "which the WAL does not record")
+}
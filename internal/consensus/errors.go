@@ -32,6 +32,18 @@ func (e ErrConsensusMessageNotRecognized) Error() string {
 	return fmt.Sprintf("consensus: message not recognized: %T", e.Message)
 }
 
+// ErrDoubleSignRisk is returned on start when the last signing state of the
+// private validator, the WAL and the block store disagree in a way that
+// implies another node is signing with the same key.
+type ErrDoubleSignRisk struct {
+	Reason string
+}
+
+func (e ErrDoubleSignRisk) Error() string {
+	return "refusing to sign, another node may be signing with the same validator key: " + e.Reason +
+		"; if no other node signs with this key, start the node with --override, or disable consensus.double_sign_guard"
+}
+
 type ErrDenyMessageOverflow struct {
 	Err error
 }
@@ -0,0 +1,73 @@
+package consensus
+
+import (
+	"github.com/cometbft/cometbft/types"
+)
+
+// loadLiveness adds to the liveness tracker the commits of the last heights
+// in the block store, so that the liveness of the validators is kept across
+// restarts and includes the blocks received while syncing.
+func (cs *State) loadLiveness() {
+	if cs.livenessTracker == nil {
+		return
+	}
+	// The commit of a height is in the next block; the one of the last height
+	// is added once the next block is committed.
+	lastHeight := cs.blockStore.Height() - 1
+	fromHeight := max(
+		cs.blockStore.Base(),
+		lastHeight-cs.config.LivenessWindow+1,
+		cs.livenessTracker.LastHeight()+1,
+	)
+	for height := fromHeight; height <= lastHeight; height++ {
+		commit := cs.blockStore.LoadBlockCommit(height)
+		if commit == nil {
+			continue
+		}
+		vals, err := cs.blockExec.Store().LoadValidators(height)
+		if err != nil {
+			// The validators of the heights before a state sync are not
+			// stored.
+			cs.Logger.Debug("failed to load validators for liveness tracking", "height", height, "err", err)
+			continue
+		}
+		cs.livenessTracker.AddCommit(commit, vals)
+	}
+	cs.recordLivenessMetrics()
+}
+
+// addLivenessCommit adds to the liveness tracker the commit of the last block,
+// signed by vals.
+func (cs *State) addLivenessCommit(commit *types.Commit, vals *types.ValidatorSet) {
+	if cs.livenessTracker == nil {
+		return
+	}
+	cs.livenessTracker.AddCommit(commit, vals)
+	cs.recordLivenessMetrics()
+}
+
+// recordLivenessMetrics reports the liveness of the validators of the window,
+// and zeroes the metrics of the validators that left it, which would otherwise
+// keep reporting their last values.
+func (cs *State) recordLivenessMetrics() {
+	_, _, liveness := cs.livenessTracker.Liveness()
+	addrs := make(map[string]struct{}, len(liveness))
+	for _, l := range liveness {
+		addr := l.Address.String()
+		addrs[addr] = struct{}{}
+		label := []string{"validator_address", addr}
+		cs.metrics.ValidatorLivenessAbsent.With(label...).Set(float64(l.Absent))
+		cs.metrics.ValidatorLivenessNil.With(label...).Set(float64(l.Nil))
+		cs.metrics.ValidatorLivenessLatenessSeconds.With(label...).Set(l.AvgLateness.Seconds())
+	}
+	for addr := range cs.livenessMetricsAddrs {
+		if _, ok := addrs[addr]; ok {
+			continue
+		}
+		label := []string{"validator_address", addr}
+		cs.metrics.ValidatorLivenessAbsent.With(label...).Set(0)
+		cs.metrics.ValidatorLivenessNil.With(label...).Set(0)
+		cs.metrics.ValidatorLivenessLatenessSeconds.With(label...).Set(0)
+	}
+	cs.livenessMetricsAddrs = addrs
+}
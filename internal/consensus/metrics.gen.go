@@ -136,6 +136,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "duplicate_block_part",
 			Help:      "Number of times we received a duplicate block part",
 		}, labels).With(labelsAndValues...),
+		CompactBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks",
+			Help:      "Number of compact blocks received, by whether they were rebuilt or failed to be.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of transactions of compact blocks that were not in the mempool, and were requested from peers.",
+		}, labels).With(labelsAndValues...),
 		DuplicateVote: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -168,6 +180,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "full_prevote_delay",
 			Help:      "Interval in seconds between the proposal timestamp and the timestamp of the latest prevote in a round where all validators voted.",
 		}, append(labels, "proposer_address")).With(labelsAndValues...),
+		AdaptiveTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "adaptive_timeout_seconds",
+			Help:      "Timeout of the first round of a height in seconds, derived from the observed delays when adaptive timeouts are enabled.",
+		}, append(labels, "step")).With(labelsAndValues...),
 		VoteExtensionReceiveCount: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -198,40 +216,71 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "late_votes",
 			Help:      "LateVotes stores the number of votes that were received by this node that correspond to earlier heights and rounds than this node is currently in.",
 		}, append(labels, "vote_type")).With(labelsAndValues...),
+		RoundSkips: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "round_skips",
+			Help:      "Number of times the node skipped to a higher round in which validators with more than 1/3 of the voting power voted.",
+		}, labels).With(labelsAndValues...),
+		ValidatorLivenessAbsent: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_liveness_absent",
+			Help:      "Number of commits of the liveness window a validator did not sign.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorLivenessNil: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_liveness_nil",
+			Help:      "Number of commits of the liveness window in which a validator precommitted nil.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		ValidatorLivenessLatenessSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_liveness_lateness_seconds",
+			Help:      "Average delay in seconds of the precommits of a validator after +2/3 of the voting power precommitted, over the liveness window.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Height:                    discard.NewGauge(),
-		ValidatorLastSignedHeight: discard.NewGauge(),
-		Rounds:                    discard.NewGauge(),
-		RoundDurationSeconds:      discard.NewHistogram(),
-		Validators:                discard.NewGauge(),
-		ValidatorsPower:           discard.NewGauge(),
-		ValidatorPower:            discard.NewGauge(),
-		ValidatorMissedBlocks:     discard.NewGauge(),
-		MissingValidators:         discard.NewGauge(),
-		MissingValidatorsPower:    discard.NewGauge(),
-		ByzantineValidators:       discard.NewGauge(),
-		ByzantineValidatorsPower:  discard.NewGauge(),
-		BlockIntervalSeconds:      discard.NewHistogram(),
-		NumTxs:                    discard.NewGauge(),
-		BlockSizeBytes:            discard.NewGauge(),
-		ChainSizeBytes:            discard.NewCounter(),
-		TotalTxs:                  discard.NewGauge(),
-		CommittedHeight:           discard.NewGauge(),
-		BlockParts:                discard.NewCounter(),
-		DuplicateBlockPart:        discard.NewCounter(),
-		DuplicateVote:             discard.NewCounter(),
-		StepDurationSeconds:       discard.NewHistogram(),
-		BlockGossipPartsReceived:  discard.NewCounter(),
-		QuorumPrevoteDelay:        discard.NewGauge(),
-		FullPrevoteDelay:          discard.NewGauge(),
-		VoteExtensionReceiveCount: discard.NewCounter(),
-		ProposalReceiveCount:      discard.NewCounter(),
-		ProposalCreateCount:       discard.NewCounter(),
-		RoundVotingPowerPercent:   discard.NewGauge(),
-		LateVotes:                 discard.NewCounter(),
+		Height:                           discard.NewGauge(),
+		ValidatorLastSignedHeight:        discard.NewGauge(),
+		Rounds:                           discard.NewGauge(),
+		RoundDurationSeconds:             discard.NewHistogram(),
+		Validators:                       discard.NewGauge(),
+		ValidatorsPower:                  discard.NewGauge(),
+		ValidatorPower:                   discard.NewGauge(),
+		ValidatorMissedBlocks:            discard.NewGauge(),
+		MissingValidators:                discard.NewGauge(),
+		MissingValidatorsPower:           discard.NewGauge(),
+		ByzantineValidators:              discard.NewGauge(),
+		ByzantineValidatorsPower:         discard.NewGauge(),
+		BlockIntervalSeconds:             discard.NewHistogram(),
+		NumTxs:                           discard.NewGauge(),
+		BlockSizeBytes:                   discard.NewGauge(),
+		ChainSizeBytes:                   discard.NewCounter(),
+		TotalTxs:                         discard.NewGauge(),
+		CommittedHeight:                  discard.NewGauge(),
+		BlockParts:                       discard.NewCounter(),
+		DuplicateBlockPart:               discard.NewCounter(),
+		CompactBlocks:                    discard.NewCounter(),
+		CompactBlockMissingTxs:           discard.NewCounter(),
+		DuplicateVote:                    discard.NewCounter(),
+		StepDurationSeconds:              discard.NewHistogram(),
+		BlockGossipPartsReceived:         discard.NewCounter(),
+		QuorumPrevoteDelay:               discard.NewGauge(),
+		FullPrevoteDelay:                 discard.NewGauge(),
+		AdaptiveTimeoutSeconds:           discard.NewGauge(),
+		VoteExtensionReceiveCount:        discard.NewCounter(),
+		ProposalReceiveCount:             discard.NewCounter(),
+		ProposalCreateCount:              discard.NewCounter(),
+		RoundVotingPowerPercent:          discard.NewGauge(),
+		LateVotes:                        discard.NewCounter(),
+		RoundSkips:                       discard.NewCounter(),
+		ValidatorLivenessAbsent:          discard.NewGauge(),
+		ValidatorLivenessNil:             discard.NewGauge(),
+		ValidatorLivenessLatenessSeconds: discard.NewGauge(),
 	}
 }
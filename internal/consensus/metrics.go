@@ -69,6 +69,13 @@ type Metrics struct {
 	// Number of times we received a duplicate block part
 	DuplicateBlockPart metrics.Counter
 
+	// Number of compact blocks received, by whether they were rebuilt or
+	// failed to be.
+	CompactBlocks metrics.Counter `metrics_labels:"status"`
+	// Number of transactions of compact blocks that were not in the mempool,
+	// and were requested from peers.
+	CompactBlockMissingTxs metrics.Counter
+
 	// Number of times we received a duplicate vote
 	DuplicateVote metrics.Counter
 
@@ -98,6 +105,12 @@ type Metrics struct {
 	// metrics:Interval in seconds between the proposal timestamp and the timestamp of the latest prevote in a round where all validators voted.
 	FullPrevoteDelay metrics.Gauge `metrics_labels:"proposer_address"`
 
+	// AdaptiveTimeoutSeconds is the timeout of the first round of a height, in
+	// seconds, derived from the observed delays when adaptive timeouts are
+	// enabled. The metric is labeled by step, either 'propose' or 'prevote'.
+	// metrics:Timeout of the first round of a height in seconds, derived from the observed delays when adaptive timeouts are enabled.
+	AdaptiveTimeoutSeconds metrics.Gauge `metrics_labels:"step"`
+
 	// VoteExtensionReceiveCount is the number of vote extensions received by this
 	// node. The metric is annotated by the status of the vote extension from the
 	// application, either 'accepted' or 'rejected'.
@@ -124,6 +137,28 @@ type Metrics struct {
 	// correspond to earlier heights and rounds than this node is currently
 	// in.
 	LateVotes metrics.Counter `metrics_labels:"vote_type"`
+
+	// RoundSkips is the number of times this node skipped to a higher round of
+	// the current height because it received votes for that round from
+	// validators with more than 1/3 of the voting power.
+	// metrics:Number of times the node skipped to a higher round in which validators with more than 1/3 of the voting power voted.
+	RoundSkips metrics.Counter
+
+	// ValidatorLivenessAbsent is the number of commits of the liveness window
+	// that a validator did not sign.
+	// metrics:Number of commits of the liveness window a validator did not sign.
+	ValidatorLivenessAbsent metrics.Gauge `metrics_labels:"validator_address"`
+
+	// ValidatorLivenessNil is the number of commits of the liveness window in
+	// which a validator precommitted nil.
+	// metrics:Number of commits of the liveness window in which a validator precommitted nil.
+	ValidatorLivenessNil metrics.Gauge `metrics_labels:"validator_address"`
+
+	// ValidatorLivenessLatenessSeconds is the average delay, over the liveness
+	// window, of the precommits of a validator after +2/3 of the voting power
+	// precommitted the committed block.
+	// metrics:Average delay in seconds of the precommits of a validator after +2/3 of the voting power precommitted, over the liveness window.
+	ValidatorLivenessLatenessSeconds metrics.Gauge `metrics_labels:"validator_address"`
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
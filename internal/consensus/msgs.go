@@ -117,6 +117,28 @@ func MsgToWrappedProto(msg Message) (cmtcons.Message, error) {
 
 		pb.Sum = &cmtcons.Message_VoteSetBits{VoteSetBits: vsb}
 
+	case *CompactBlockMessage:
+		cb, err := compactBlockToProto(msg)
+		if err != nil {
+			return pb, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		pb.Sum = &cmtcons.Message_CompactBlock{CompactBlock: cb}
+
+	case *CompactBlockTxsRequestMessage:
+		pb.Sum = &cmtcons.Message_CompactBlockTxsRequest{CompactBlockTxsRequest: &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}}
+
+	case *CompactBlockTxsMessage:
+		pb.Sum = &cmtcons.Message_CompactBlockTxs{CompactBlockTxs: &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     msg.Txs.ToSliceOfBytes(),
+		}}
+
 	default:
 		return pb, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -238,6 +260,25 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		cb, err := compactBlockFromProto(msg)
+		if err != nil {
+			return nil, cmterrors.ErrMsgFromProto{MessageName: "CompactBlock", Err: err}
+		}
+		pb = cb
+	case *cmtcons.CompactBlockTxsRequest:
+		pb = &CompactBlockTxsRequestMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+	case *cmtcons.CompactBlockTxs:
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     types.ToTxs(msg.Txs),
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -249,6 +290,67 @@ func MsgFromProto(p proto.Message) (Message, error) {
 	return pb, nil
 }
 
+// compactBlockToProto converts a CompactBlockMessage to its proto form.
+func compactBlockToProto(msg *CompactBlockMessage) (*cmtcons.CompactBlock, error) {
+	if msg.Block == nil {
+		return nil, ErrNilMessage
+	}
+	evidence, err := msg.Block.Evidence.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	txKeys := make([][]byte, len(msg.TxKeys))
+	for i := range msg.TxKeys {
+		txKeys[i] = msg.TxKeys[i][:]
+	}
+	return &cmtcons.CompactBlock{
+		Height:        msg.Height,
+		Round:         msg.Round,
+		PartSetHeader: msg.PartSetHeader.ToProto(),
+		Header:        *msg.Block.Header.ToProto(),
+		LastCommit:    msg.Block.LastCommit.ToProto(),
+		Evidence:      *evidence,
+		TxKeys:        txKeys,
+	}, nil
+}
+
+// compactBlockFromProto converts a proto CompactBlock to a CompactBlockMessage.
+// The block is not validated, as its data hash does not match its (missing)
+// transactions.
+func compactBlockFromProto(msg *cmtcons.CompactBlock) (*CompactBlockMessage, error) {
+	psh, err := types.PartSetHeaderFromProto(&msg.PartSetHeader)
+	if err != nil {
+		return nil, err
+	}
+	header, err := types.HeaderFromProto(&msg.Header)
+	if err != nil {
+		return nil, err
+	}
+	block := &types.Block{Header: header}
+	if err := block.Evidence.FromProto(&msg.Evidence); err != nil {
+		return nil, err
+	}
+	if msg.LastCommit != nil {
+		if block.LastCommit, err = types.CommitFromProto(msg.LastCommit); err != nil {
+			return nil, err
+		}
+	}
+	txKeys := make([]types.TxKey, len(msg.TxKeys))
+	for i, key := range msg.TxKeys {
+		if len(key) != len(txKeys[i]) {
+			return nil, fmt.Errorf("invalid tx key length %d", len(key))
+		}
+		copy(txKeys[i][:], key)
+	}
+	return &CompactBlockMessage{
+		Height:        msg.Height,
+		Round:         msg.Round,
+		PartSetHeader: *psh,
+		Block:         block,
+		TxKeys:        txKeys,
+	}, nil
+}
+
 // WALToProto takes a WAL message and return a proto walMessage and error.
 func WALToProto(msg WALMessage) (*cmtcons.WALMessage, error) {
 	var pb cmtcons.WALMessage
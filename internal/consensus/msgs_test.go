@@ -186,6 +186,34 @@ func TestMsgToProto(t *testing.T) {
 
 			false,
 		},
+		{
+			"successful CompactBlockTxsRequestMessage", &CompactBlockTxsRequestMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+			}, &cmtcons.CompactBlockTxsRequest{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+			},
+
+			false,
+		},
+		{
+			"successful CompactBlockTxsMessage", &CompactBlockTxsMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+				Txs:     types.Txs{types.Tx("a"), types.Tx("c")},
+			}, &cmtcons.CompactBlockTxs{
+				Height:  1,
+				Round:   1,
+				Indexes: []uint32{0, 2},
+				Txs:     [][]byte{[]byte("a"), []byte("c")},
+			},
+
+			false,
+		},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
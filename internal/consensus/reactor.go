@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -27,6 +28,9 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is used to send proposal blocks as compact blocks.
+	// It is only advertised by nodes that enabled compact blocks.
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -48,6 +52,20 @@ type Reactor struct {
 	rsMtx cmtsync.Mutex
 	rs    *cstypes.RoundState
 
+	txFetcher  TxFetcher
+	compactMtx cmtsync.Mutex
+	// Last compact block built to be sent to peers, nil if the block parts
+	// are smaller.
+	compactSent struct {
+		height        int64
+		round         int32
+		partSetHeader types.PartSetHeader
+		msg           *cmtcons.CompactBlock
+	}
+	// Compact blocks of the current round received from peers, being
+	// rebuilt. At most one per peer.
+	compactPending []*pendingCompactBlock
+
 	Metrics *Metrics
 }
 
@@ -137,6 +155,12 @@ func (conR *Reactor) SwitchToConsensus(state sm.State, skipWAL bool) {
 
 	// start the consensus protocol
 	err := conR.conS.Start()
+	if errors.As(err, &ErrDoubleSignRisk{}) {
+		// The node checks the guard before syncing, so another node must have
+		// started signing with the same key since. Stay out of consensus.
+		conR.Logger.Error("Not starting consensus, the double-sign guard failed", "err", err)
+		return
+	}
 	if err != nil {
 		panic(fmt.Sprintf(`Failed to start consensus state: %v
 
@@ -151,7 +175,7 @@ conR:
 // GetChannels implements Reactor.
 func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	// TODO optimize
-	return []*p2p.ChannelDescriptor{
+	chs := []*p2p.ChannelDescriptor{
 		{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -185,6 +209,20 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &cmtcons.Message{},
 		}, // <- Add a comma here to separate this element from the next one
 	}
+
+	if conR.conS.config.CompactBlocks {
+		// Compact blocks are only sent if they are smaller than the block
+		// parts, and so is the response to a request for their transactions.
+		chs = append(chs, &p2p.ChannelDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: int(types.MaxBlockSizeBytes),
+			MessageType:         &cmtcons.Message{},
+		})
+	}
+
+	return chs
 }
 
 func (conR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
@@ -314,13 +352,13 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 		switch msg := msg.(type) {
 		case *ProposalMessage:
 			ps.SetHasProposal(msg.Proposal)
-			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID(), cmttime.Now()}
 		case *ProposalPOLMessage:
 			ps.ApplyProposalPOLMessage(msg)
 		case *BlockPartMessage:
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
-			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID(), cmttime.Now()}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -340,7 +378,7 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			ps.EnsureVoteBitArrays(height-1, lastCommitSize)
 			ps.SetHasVote(msg.Vote)
 
-			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID(), cmttime.Now()}
 
 		default:
 			// don't punish (leave room for soft upgrades)
@@ -378,6 +416,22 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *CompactBlockMessage:
+			conR.handleCompactBlock(msg, e.Src, ps)
+		case *CompactBlockTxsRequestMessage:
+			conR.handleCompactBlockTxsRequest(msg, e.Src)
+		case *CompactBlockTxsMessage:
+			conR.handleCompactBlockTxs(msg, e.Src, ps)
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
 	default:
 		conR.Logger.Error(fmt.Sprintf("Unknown chId %X", e.ChannelID))
 	}
@@ -539,8 +593,17 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
-		// Send proposal Block parts?
-		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
+		// Send the proposal block as a compact block?
+		if conR.sendCompactBlock(peer, ps, rs, prs) {
+			continue OUTER_LOOP
+		}
+
+		// Send proposal Block parts? Peers that have enough parts of an
+		// erasure-coded part set to reconstruct it need no more.
+		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) &&
+			!waitingForCompactBlock(prs, conR.conS.config.CompactBlockTimeout) &&
+			!conR.compactBlockNotDecoded(peer, rs, prs) &&
+			!(rs.ProposalBlockParts.IsErasureCoded() && hasDataParts(prs.ProposalBlockParts)) {
 			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				parts, err := part.ToProto()
@@ -627,6 +690,11 @@ OUTER_LOOP:
 
 func (conR *Reactor) BroadcastDataForCatchup(logger log.Logger, rs *cstypes.RoundState, prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
 	// logger.Debug("The broadcast modification is working")
+	if hasDataParts(prs.ProposalBlockParts) && conR.erasureCodingEnabled(prs.Height) {
+		// The peer can reconstruct the block from the parts it has.
+		time.Sleep(conR.conS.config.PeerGossipSleepDuration)
+		return
+	}
 	if index, ok := prs.ProposalBlockParts.Not().PickRandom(); ok {//Randomly selects block part index
 		// Ensure that the peer's PartSetHeader is correct
 		blockMeta := conR.conS.blockStore.LoadBlockMeta(prs.Height)
@@ -676,6 +744,21 @@ func (conR *Reactor) BroadcastDataForCatchup(logger log.Logger, rs *cstypes.Roun
 	time.Sleep(conR.conS.config.PeerGossipSleepDuration)
 }
 
+// hasDataParts returns true if parts, the parts of a part set a peer has, are
+// at least as many as the data parts of an erasure-coded part set of the same
+// size, so that the peer can reconstruct the part set if it is erasure coded.
+func hasDataParts(parts *bits.BitArray) bool {
+	total := parts.Size()
+	return total > 1 && parts.NumTrueBits() >= (total+1)/2
+}
+
+// erasureCodingEnabled returns true if the part sets of the blocks at height
+// are erasure coded. As erasure coding cannot be disabled once enabled, the
+// current consensus params tell it for past heights too.
+func (conR *Reactor) erasureCodingEnabled(height int64) bool {
+	return conR.conS.GetState().ConsensusParams.Feature.ErasureCodingEnabled(height)
+}
+
 func (conR *Reactor) BroadcastVotesRoutine(peer p2p.Peer, ps *PeerState) {
 	logger := conR.Logger.With("peer", peer)
 
@@ -727,7 +810,7 @@ OUTER_LOOP:
 			func() {
 				conR.conS.mtx.RLock()
 				defer conR.conS.mtx.RUnlock()
-				veEnabled = conR.conS.state.ConsensusParams.ABCI.VoteExtensionsEnabled(prs.Height)
+				veEnabled = conR.conS.state.ConsensusParams.Features().VoteExtensionsEnabled(prs.Height)
 			}()
 			if veEnabled {
 				ec = conR.conS.blockStore.LoadBlockExtendedCommit(prs.Height)
@@ -1057,6 +1140,18 @@ func (ps *PeerState) InitProposalBlockParts(partSetHeader types.PartSetHeader) {
 	ps.PRS.ProposalBlockParts = bits.NewBitArray(int(partSetHeader.Total))
 }
 
+// SetCompactBlockSent records that the proposal block of the given round was
+// sent to the peer as a compact block.
+func (ps *PeerState) SetCompactBlockSent(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round {
+		return
+	}
+	ps.PRS.CompactBlockSent = cmttime.Now()
+}
+
 // SetHasProposalBlockPart sets the given block part index as known for the peer.
 func (ps *PeerState) SetHasProposalBlockPart(height int64, round int32, index int) {
 	ps.mtx.Lock()
@@ -1317,6 +1412,7 @@ func (ps *PeerState) ApplyNewRoundStepMessage(msg *NewRoundStepMessage) {
 		ps.PRS.ProposalBlockParts = nil
 		ps.PRS.ProposalPOLRound = -1
 		ps.PRS.ProposalPOL = nil
+		ps.PRS.CompactBlockSent = time.Time{}
 		// We'll update the BitArray capacity later.
 		ps.PRS.Prevotes = nil
 		ps.PRS.Precommits = nil
@@ -1450,6 +1546,9 @@ func init() {
 	cmtjson.RegisterType(&HasProposalBlockPartMessage{}, "tendermint/HasProposalBlockPart")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&CompactBlockTxsRequestMessage{}, "tendermint/CompactBlockTxsRequest")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
 }
 
 //-------------------------------------
@@ -1759,8 +1858,115 @@ func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
 
+//-------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of a proposal block to peers
+// that support compact blocks. Block is the proposal block without its
+// transactions, and TxKeys are the keys of its transactions.
+type CompactBlockMessage struct {
+	Height        int64
+	Round         int32
+	PartSetHeader types.PartSetHeader
+	Block         *types.Block
+	TxKeys        []types.TxKey
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.PartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "PartSetHeader", Err: err}
+	}
+	if m.Block == nil {
+		return cmterrors.ErrRequiredField{Field: "Block"}
+	}
+	if err := m.Block.Header.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "Block", Err: err}
+	}
+	if m.Block.Height != m.Height {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: fmt.Sprintf("height %d does not match message height %d", m.Block.Height, m.Height)}
+	}
+	if len(m.Block.Txs) > 0 {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "contains transactions"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v PSH:%v Txs:%v]", m.Height, m.Round, m.PartSetHeader, len(m.TxKeys))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsRequestMessage is sent to request the transactions of a
+// compact block that are missing from the mempool, by their indexes in the
+// block.
+type CompactBlockTxsRequestMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) == 0 {
+		return cmterrors.ErrRequiredField{Field: "Indexes"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxsRequest H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsMessage contains the transactions of a compact block requested
+// with CompactBlockTxsRequestMessage, along with their indexes in the block.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return cmterrors.ErrNegativeField{Field: "Height"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return cmterrors.ErrInvalidField{Field: "Txs", Reason: fmt.Sprintf("got %d transactions for %d indexes", len(m.Txs), len(m.Indexes))}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
 var (
 	_ types.Wrapper = &cmtcons.BlockPart{}
+	_ types.Wrapper = &cmtcons.CompactBlock{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxs{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxsRequest{}
 	_ types.Wrapper = &cmtcons.HasVote{}
 	_ types.Wrapper = &cmtcons.HasProposalBlockPart{}
 	_ types.Wrapper = &cmtcons.NewRoundStep{}
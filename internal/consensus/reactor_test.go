@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -55,7 +56,11 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		/*logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		if err != nil {	t.Fatal(err)}*/
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		var opts []ReactorOption
+		if txFetcher, ok := css[i].txNotifier.(TxFetcher); ok {
+			opts = append(opts, ReactorTxFetcher(txFetcher))
+		}
+		reactors[i] = NewReactor(css[i], true, opts...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -120,6 +125,78 @@ func TestReactorBasic(t *testing.T) {
 	})
 }
 
+// Ensure proposal blocks are received as compact blocks, and rebuilt by nodes
+// missing some of their transactions.
+func TestReactorCompactBlocks(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(t, N, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) {
+			c.Consensus.CompactBlocks = true
+		})
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	for _, r := range reactors {
+		chIDs := make([]byte, 0)
+		for _, ch := range r.GetChannels() {
+			chIDs = append(chIDs, ch.ID)
+		}
+		require.Contains(t, chIDs, CompactBlockChannel)
+	}
+
+	// wait till everyone makes the first block, whose last commit is empty
+	timeoutWaitGroup(N, func(j int) {
+		<-blocksSubs[j].Out()
+	})
+
+	// The transactions are missing from the mempool of the last node, which
+	// has to fetch them from its peers. They are big enough for the compact
+	// block to be smaller than the block parts.
+	txs := [][]byte{
+		kvstore.NewTx("compact1", strings.Repeat("a", 10000)),
+		kvstore.NewTx("compact2", strings.Repeat("b", 10000)),
+	}
+	for j := 0; j < N-1; j++ {
+		for _, tx := range txs {
+			reqRes, err := assertMempool(css[j].txNotifier).CheckTx(tx)
+			require.NoError(t, err)
+			require.False(t, reqRes.Response.GetCheckTx().IsErr())
+		}
+	}
+
+	activeVals := make(map[string]struct{})
+	for i := 0; i < N; i++ {
+		pubKey, err := css[i].privValidator.GetPubKey()
+		require.NoError(t, err)
+		activeVals[string(pubKey.Address())] = struct{}{}
+	}
+	waitForAndValidateBlockWithTx(t, N, activeVals, blocksSubs, css, txs...)
+
+	// All the nodes but the proposer rebuilt the block with the transactions
+	// from a compact block.
+	var block *types.Block
+	for h := css[0].blockStore.Height(); block == nil || len(block.Txs) == 0; h-- {
+		block, _ = css[0].blockStore.LoadBlock(h)
+	}
+	for i := 0; i < N; i++ {
+		pubKey, err := css[i].privValidator.GetPubKey()
+		require.NoError(t, err)
+		if pubKey.Address().String() == block.ProposerAddress.String() {
+			continue
+		}
+		rebuilt := false
+		reactors[i].compactMtx.Lock()
+		for _, cb := range reactors[i].compactPending {
+			if cb.msg.Height == block.Height && cb.rebuilt {
+				rebuilt = true
+			}
+		}
+		reactors[i].compactMtx.Unlock()
+		assert.True(t, rebuilt, "node %d", i)
+	}
+}
+
 // Ensure we can process blocks with evidence.
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -359,7 +436,7 @@ func TestSwitchToConsensusVoteExtensions(t *testing.T) {
 
 			cs.state.LastBlockHeight = testCase.storedHeight
 			cs.state.LastValidators = cs.state.Validators.Copy()
-			cs.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.initialRequiredHeight
+			cs.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.initialRequiredHeight //nolint:staticcheck
 
 			propBlock, err := cs.createProposalBlock(ctx)
 			require.NoError(t, err)
@@ -975,6 +1052,70 @@ func TestBlockPartMessageValidateBasic(t *testing.T) {
 	require.Error(t, message.ValidateBasic())
 }
 
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	cs1, _ := randState(1)
+	block, err := cs1.createProposalBlock(context.Background())
+	require.NoError(t, err)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName   string
+		malleateFn func(*CompactBlockMessage)
+		expectErr  bool
+	}{
+		{"Valid Message", func(*CompactBlockMessage) {}, false},
+		{"Negative Height", func(m *CompactBlockMessage) { m.Height = -1 }, true},
+		{"Negative Round", func(m *CompactBlockMessage) { m.Round = -1 }, true},
+		{"Invalid PartSetHeader", func(m *CompactBlockMessage) { m.PartSetHeader.Hash = []byte{1} }, true},
+		{"Missing Block", func(m *CompactBlockMessage) { m.Block = nil }, true},
+		{"Wrong Height", func(m *CompactBlockMessage) { m.Height++ }, true},
+		{"Block With Txs", func(m *CompactBlockMessage) { m.Block.Txs = types.Txs{types.Tx("a")} }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			message := &CompactBlockMessage{
+				Height:        block.Height,
+				Round:         0,
+				PartSetHeader: parts.Header(),
+				Block: &types.Block{
+					Header:     block.Header,
+					Evidence:   block.Evidence,
+					LastCommit: block.LastCommit,
+				},
+				TxKeys:        []types.TxKey{},
+			}
+			tc.malleateFn(message)
+
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestCompactBlockTxsMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName  string
+		message   Message
+		expectErr bool
+	}{
+		{"Valid Request", &CompactBlockTxsRequestMessage{Height: 1, Round: 0, Indexes: []uint32{0}}, false},
+		{"Request Without Indexes", &CompactBlockTxsRequestMessage{Height: 1, Round: 0}, true},
+		{"Request With Negative Height", &CompactBlockTxsRequestMessage{Height: -1, Round: 0, Indexes: []uint32{0}}, true},
+		{"Valid Txs", &CompactBlockTxsMessage{Height: 1, Round: 0, Indexes: []uint32{0}, Txs: types.Txs{types.Tx("a")}}, false},
+		{"Txs Not Matching Indexes", &CompactBlockTxsMessage{Height: 1, Round: 0, Indexes: []uint32{0, 1}, Txs: types.Txs{types.Tx("a")}}, true},
+		{"Txs With Negative Round", &CompactBlockTxsMessage{Height: 1, Round: -1}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			assert.Equal(t, tc.expectErr, tc.message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestHasVoteMessageValidateBasic(t *testing.T) {
 	const (
 		validSignedMsgType   types.SignedMsgType = 0x01
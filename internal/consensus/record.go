@@ -0,0 +1,647 @@
+package consensus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	sm "github.com/cometbft/cometbft/internal/state"
+	"github.com/cometbft/cometbft/internal/store"
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+//--------------------------------------------------------
+// types and functions for recording the inputs of the consensus state machine
+
+// TimedRecordMessage is an entry of a consensus recording, wrapping
+// RecordMessage with a time: for messages and timeouts, the time they were
+// queued for the consensus state; for the other entries, the time they were
+// recorded at.
+type TimedRecordMessage struct {
+	Time time.Time     `json:"time"`
+	Msg  RecordMessage `json:"msg"`
+}
+
+// RecordMessage is one of: recordHeader, which starts a recording session;
+// msgInfo, timeoutInfo and txsAvailableInfo, which are inputs of the
+// consensus state; and abciResponseInfo, which is a response of the
+// application to a request made while processing the previous inputs.
+type RecordMessage interface{}
+
+// recordHeader starts a recording session, when the consensus state starts. It
+// holds what is needed to rebuild the consensus state offline.
+type recordHeader struct {
+	// State is the protobuf encoding of the state of the last committed block.
+	State []byte `json:"state"`
+	// LastBlock is the protobuf encoding of the last committed block, if it is
+	// in the block store.
+	LastBlock []byte `json:"last_block"`
+	// LastCommit is the protobuf encoding of the extended commit of the last
+	// committed block if vote extensions were enabled at its height, and of
+	// its commit otherwise.
+	LastCommit []byte `json:"last_commit"`
+	// PubKey is the public key of the validator of the node, if any.
+	PubKey crypto.PubKey `json:"pub_key"`
+}
+
+// txsAvailableInfo records that the mempool notified the consensus state that
+// transactions are available.
+type txsAvailableInfo struct{}
+
+// abciResponseInfo is the response of the application to a request of the
+// consensus connection, indexed by the hash of the request.
+type abciResponseInfo struct {
+	Method      string `json:"method"`
+	RequestHash []byte `json:"request_hash"`
+	Response    []byte `json:"response"`
+}
+
+func init() {
+	cmtjson.RegisterType(recordHeader{}, "cometbft/record/Header")
+	cmtjson.RegisterType(txsAvailableInfo{}, "cometbft/record/TxsAvailable")
+	cmtjson.RegisterType(abciResponseInfo{}, "cometbft/record/ABCIResponse")
+}
+
+// Recorder writes the inputs of the consensus state machine to a file, one
+// JSON-encoded TimedRecordMessage per line: the messages from peers and from
+// the node's own validator, the timeouts, the notifications of available
+// transactions, and the responses of the application. Messages and timeouts
+// are stamped with the time they were queued for the consensus state, not the
+// time they were processed at. Each time the consensus state starts, a new
+// recording session begins. A session can be replayed
+// offline with ReplayRecording.
+//
+// Unlike the WAL, the recording is not pruned, and is not needed for crash
+// recovery.
+type Recorder struct {
+	mtx     cmtsync.Mutex
+	file    *os.File
+	started bool // whether a session has started
+
+	logger log.Logger
+}
+
+// NewRecorder opens the file at path, creating it if needed, and returns a
+// Recorder appending to it.
+func NewRecorder(path string) (*Recorder, error) {
+	if err := cmtos.EnsureDir(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to ensure recording directory is in place: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{file: file, logger: log.NewNopLogger()}, nil
+}
+
+// SetLogger sets the logger used to report recording errors.
+func (rec *Recorder) SetLogger(l log.Logger) {
+	rec.logger = l
+}
+
+// Close closes the file of the recording.
+func (rec *Recorder) Close() error {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+
+	return rec.file.Close()
+}
+
+// AppConnConsensus wraps conn so that the responses of the application are
+// recorded, once a session has started.
+func (rec *Recorder) AppConnConsensus(conn proxy.AppConnConsensus) proxy.AppConnConsensus {
+	return &recordingAppConnConsensus{AppConnConsensus: conn, rec: rec}
+}
+
+// startSession starts a new recording session with the given header.
+func (rec *Recorder) startSession(t time.Time, header recordHeader) {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+
+	rec.started = true
+	rec.write(t, header)
+}
+
+// record writes msg to the recording, if a session has started.
+func (rec *Recorder) record(t time.Time, msg RecordMessage) {
+	rec.mtx.Lock()
+	defer rec.mtx.Unlock()
+
+	if rec.started {
+		rec.write(t, msg)
+	}
+}
+
+// write writes a line to the file. It is not buffered, so that a recording
+// is complete even if the node crashes. Errors are logged, as they must not
+// stop consensus. CONTRACT: rec.mtx is locked.
+func (rec *Recorder) write(t time.Time, msg RecordMessage) {
+	bz, err := cmtjson.Marshal(TimedRecordMessage{Time: t, Msg: msg})
+	if err != nil {
+		rec.logger.Error("failed to encode recorded message", "msg", msg, "err", err)
+		return
+	}
+	if _, err := rec.file.Write(append(bz, '\n')); err != nil {
+		rec.logger.Error("failed writing to the consensus recording", "err", err)
+	}
+}
+
+// recordABCIResponse records the response of the application to a request.
+func (rec *Recorder) recordABCIResponse(method string, req, res proto.Message) {
+	bz, err := proto.Marshal(res)
+	if err != nil {
+		rec.logger.Error("failed to encode recorded ABCI response", "method", method, "err", err)
+		return
+	}
+	rec.record(cmttime.Now(), abciResponseInfo{
+		Method:      method,
+		RequestHash: requestHash(req),
+		Response:    bz,
+	})
+}
+
+// requestHash returns the hash of the protobuf encoding of an ABCI request.
+func requestHash(req proto.Message) []byte {
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode ABCI request: %v", err))
+	}
+	h := sha256.Sum256(bz)
+	return h[:]
+}
+
+// makeRecordHeader returns the header of a recording session starting at the
+// current state.
+func makeRecordHeader(state sm.State, blockStore sm.BlockStore, pubKey crypto.PubKey) (recordHeader, error) {
+	header := recordHeader{State: state.Bytes(), PubKey: pubKey}
+
+	height := state.LastBlockHeight
+	if height == 0 {
+		return header, nil
+	}
+	if block, _ := blockStore.LoadBlock(height); block != nil {
+		pb, err := block.ToProto()
+		if err != nil {
+			return header, err
+		}
+		if header.LastBlock, err = proto.Marshal(pb); err != nil {
+			return header, err
+		}
+	}
+
+	var lastCommit proto.Message
+	if state.ConsensusParams.Features().VoteExtensionsEnabled(height) {
+		if ec := blockStore.LoadBlockExtendedCommit(height); ec != nil {
+			lastCommit = ec.ToProto()
+		}
+	} else {
+		commit := blockStore.LoadSeenCommit(height)
+		if commit == nil {
+			commit = blockStore.LoadBlockCommit(height)
+		}
+		if commit != nil {
+			lastCommit = commit.ToProto()
+		}
+	}
+	if lastCommit == nil {
+		return header, fmt.Errorf("commit for height %v not found", height)
+	}
+	var err error
+	header.LastCommit, err = proto.Marshal(lastCommit)
+	return header, err
+}
+
+// recordingAppConnConsensus records the responses to the requests made
+// through the consensus connection.
+type recordingAppConnConsensus struct {
+	proxy.AppConnConsensus
+	rec *Recorder
+}
+
+func (c *recordingAppConnConsensus) PrepareProposal(
+	ctx context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	res, err := c.AppConnConsensus.PrepareProposal(ctx, req)
+	if err == nil {
+		c.rec.recordABCIResponse("PrepareProposal", req, res)
+	}
+	return res, err
+}
+
+func (c *recordingAppConnConsensus) ProcessProposal(
+	ctx context.Context,
+	req *abci.ProcessProposalRequest,
+) (*abci.ProcessProposalResponse, error) {
+	res, err := c.AppConnConsensus.ProcessProposal(ctx, req)
+	if err == nil {
+		c.rec.recordABCIResponse("ProcessProposal", req, res)
+	}
+	return res, err
+}
+
+func (c *recordingAppConnConsensus) ExtendVote(
+	ctx context.Context,
+	req *abci.ExtendVoteRequest,
+) (*abci.ExtendVoteResponse, error) {
+	res, err := c.AppConnConsensus.ExtendVote(ctx, req)
+	if err == nil {
+		c.rec.recordABCIResponse("ExtendVote", req, res)
+	}
+	return res, err
+}
+
+func (c *recordingAppConnConsensus) VerifyVoteExtension(
+	ctx context.Context,
+	req *abci.VerifyVoteExtensionRequest,
+) (*abci.VerifyVoteExtensionResponse, error) {
+	res, err := c.AppConnConsensus.VerifyVoteExtension(ctx, req)
+	if err == nil {
+		c.rec.recordABCIResponse("VerifyVoteExtension", req, res)
+	}
+	return res, err
+}
+
+func (c *recordingAppConnConsensus) FinalizeBlock(
+	ctx context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	res, err := c.AppConnConsensus.FinalizeBlock(ctx, req)
+	if err == nil {
+		c.rec.recordABCIResponse("FinalizeBlock", req, res)
+	}
+	return res, err
+}
+
+func (c *recordingAppConnConsensus) Commit(ctx context.Context) (*abci.CommitResponse, error) {
+	res, err := c.AppConnConsensus.Commit(ctx)
+	if err == nil {
+		c.rec.recordABCIResponse("Commit", &abci.CommitRequest{}, res)
+	}
+	return res, err
+}
+
+//--------------------------------------------------------
+// replay of a recording
+
+// ReplayResult is the outcome of ReplayRecording.
+type ReplayResult struct {
+	// Number of inputs of the consensus state that were replayed.
+	Inputs int
+	// State of the last block committed during the replay.
+	State sm.State
+	// Round state after the last replayed input.
+	RoundState *cstypes.RoundState
+}
+
+// recordSession is a recording session read from a recording.
+type recordSession struct {
+	header    recordHeader
+	inputs    []TimedRecordMessage
+	responses map[string][][]byte // by method and request hash, in order
+}
+
+// ReplayRecording re-runs the consensus state machine from a session of a
+// recording written by a Recorder. session is the index of the session in the
+// recording, starting at 0; if it is negative, the last session is replayed.
+//
+// The replay is deterministic: the inputs are processed one after the other,
+// in the recorded order, with the clock of the consensus state set to the
+// time they were recorded at. Timeouts only fire when they were recorded, the
+// requests to the application are answered with the recorded responses, and
+// the votes and proposals of the node's validator are taken from the
+// recording, instead of being signed again.
+//
+// If the consensus state panics, ReplayRecording returns the result up to the
+// failing input, and an error describing it.
+func ReplayRecording(
+	config *cfg.ConsensusConfig,
+	r io.Reader,
+	session int,
+	logger log.Logger,
+) (*ReplayResult, error) {
+	s, err := readRecordSession(r, session)
+	if err != nil {
+		return nil, err
+	}
+
+	stateProto := new(cmtstate.State)
+	if err := proto.Unmarshal(s.header.State, stateProto); err != nil {
+		return nil, fmt.Errorf("failed to decode recorded state: %w", err)
+	}
+	state, err := sm.FromProto(stateProto)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recorded state: %w", err)
+	}
+
+	if state.LastBlockHeight > 0 {
+		// Only the validators and consensus parameters of the heights the
+		// recording starts at are stored, so the changes must point to those
+		// heights, as with state sync.
+		state.LastHeightValidatorsChanged = state.LastBlockHeight + 2
+		state.LastHeightConsensusParamsChanged = state.LastBlockHeight + 1
+	}
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	if err := stateStore.Bootstrap(*state); err != nil {
+		return nil, err
+	}
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	offlineStateSyncHeight, err := restoreLastBlock(blockStore, *state, s.header)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCreator := proxy.NewLocalClientCreator(&replayApp{responses: s.responses})
+	cli, err := clientCreator.NewABCIConsensusClient()
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cli.Stop(); err != nil {
+			logger.Error("failed to stop replay ABCI client", "err", err)
+		}
+	}()
+	appConn := proxy.NewAppConnConsensus(cli, proxy.NopMetrics())
+
+	eventBus := types.NewEventBus()
+	if err := eventBus.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := eventBus.Stop(); err != nil {
+			logger.Error("failed to stop replay event bus", "err", err)
+		}
+	}()
+
+	blockExec := sm.NewBlockExecutor(stateStore, logger.With("module", "state"), appConn,
+		emptyMempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+	var now time.Time
+	cs := NewState(config, *state, blockExec, blockStore, emptyMempool{}, sm.EmptyEvidencePool{},
+		OfflineStateSyncHeight(offlineStateSyncHeight),
+		func(cs *State) { cs.now = func() time.Time { return now } },
+	)
+	cs.SetLogger(logger.With("module", "consensus"))
+	cs.SetEventBus(eventBus)
+	cs.timeoutTicker = replayTicker{}
+	cs.decideProposal = func(int64, int32) {}
+	cs.privValidatorPubKey = s.header.PubKey
+	cs.replayMode = true
+
+	// The reactor consumes the statistics on received votes and block parts.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-cs.statsMsgQueue:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	result := &ReplayResult{}
+	for i, input := range s.inputs {
+		now = input.Time
+		if err := replayInput(cs, input); err != nil {
+			err = fmt.Errorf("consensus failure at input #%d, recorded at %v: %w", i, input.Time, err)
+			result.State, result.RoundState = cs.GetState(), cs.GetRoundState()
+			return result, err
+		}
+		result.Inputs++
+	}
+	result.State, result.RoundState = cs.GetState(), cs.GetRoundState()
+	return result, nil
+}
+
+// replayInput processes a recorded input as receiveRoutine does, and returns
+// an error if the consensus state panics.
+func replayInput(cs *State, input TimedRecordMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			cs.Logger.Error("CONSENSUS FAILURE!!!", "err", r, "stack", string(debug.Stack()))
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	switch m := input.Msg.(type) {
+	case msgInfo:
+		cs.handleMsg(m)
+	case timeoutInfo:
+		cs.handleTimeout(m, cs.RoundState)
+	case txsAvailableInfo:
+		cs.handleTxsAvailable()
+	default:
+		return fmt.Errorf("unknown recorded input type %T", input.Msg)
+	}
+	return nil
+}
+
+// readRecordSession reads the session-th session of a recording, or the last
+// one if session is negative.
+func readRecordSession(r io.Reader, session int) (*recordSession, error) {
+	var (
+		s       *recordSession
+		current = -1
+		br      = bufio.NewReader(r)
+	)
+	for line := 1; ; line++ {
+		bz, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(bytes.TrimSpace(bz)) == 0 {
+			break
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		var msg TimedRecordMessage
+		if err := cmtjson.Unmarshal(bz, &msg); err != nil {
+			return nil, fmt.Errorf("failed to decode line %d of the recording: %w", line, err)
+		}
+		if header, ok := msg.Msg.(recordHeader); ok {
+			current++
+			if session >= 0 && current > session {
+				break
+			}
+			s = &recordSession{header: header, responses: make(map[string][][]byte)}
+			continue
+		}
+		if s == nil || (session >= 0 && current != session) {
+			continue
+		}
+		if res, ok := msg.Msg.(abciResponseInfo); ok {
+			key := responseKey(res.Method, res.RequestHash)
+			s.responses[key] = append(s.responses[key], res.Response)
+			continue
+		}
+		s.inputs = append(s.inputs, msg)
+	}
+
+	if s == nil || (session >= 0 && current < session) {
+		return nil, fmt.Errorf("recording session %d not found (%d sessions)", session, current+1)
+	}
+	return s, nil
+}
+
+// restoreLastBlock saves the recorded last block and its commit in
+// blockStore. If only the commit was recorded, because the node state synced
+// offline, it returns the height of the commit, to be used as the offline
+// state sync height.
+func restoreLastBlock(blockStore *store.BlockStore, state sm.State, header recordHeader) (int64, error) {
+	height := state.LastBlockHeight
+	if height == 0 {
+		return 0, nil
+	}
+
+	extensionsEnabled := state.ConsensusParams.Features().VoteExtensionsEnabled(height)
+	var (
+		commit    *types.Commit
+		extCommit *types.ExtendedCommit
+	)
+	if extensionsEnabled {
+		pb := new(cmtproto.ExtendedCommit)
+		if err := proto.Unmarshal(header.LastCommit, pb); err != nil {
+			return 0, fmt.Errorf("failed to decode recorded extended commit: %w", err)
+		}
+		var err error
+		if extCommit, err = types.ExtendedCommitFromProto(pb); err != nil {
+			return 0, fmt.Errorf("invalid recorded extended commit: %w", err)
+		}
+		commit = extCommit.ToCommit()
+	} else {
+		pb := new(cmtproto.Commit)
+		if err := proto.Unmarshal(header.LastCommit, pb); err != nil {
+			return 0, fmt.Errorf("failed to decode recorded commit: %w", err)
+		}
+		var err error
+		if commit, err = types.CommitFromProto(pb); err != nil {
+			return 0, fmt.Errorf("invalid recorded commit: %w", err)
+		}
+	}
+
+	if len(header.LastBlock) == 0 {
+		return height, blockStore.SaveSeenCommit(height, commit)
+	}
+
+	pb := new(cmtproto.Block)
+	if err := proto.Unmarshal(header.LastBlock, pb); err != nil {
+		return 0, fmt.Errorf("failed to decode recorded block: %w", err)
+	}
+	block, err := types.BlockFromProto(pb)
+	if err != nil {
+		return 0, fmt.Errorf("invalid recorded block: %w", err)
+	}
+	parts, err := state.MakePartSet(block)
+	if err != nil {
+		return 0, err
+	}
+	if extensionsEnabled {
+		blockStore.SaveBlockWithExtendedCommit(block, parts, extCommit)
+	} else {
+		blockStore.SaveBlock(block, parts, commit)
+	}
+	return 0, nil
+}
+
+func responseKey(method string, requestHash []byte) string {
+	return fmt.Sprintf("%s/%X", method, requestHash)
+}
+
+// replayApp answers the requests of the consensus connection with the
+// responses of a recording session.
+type replayApp struct {
+	abci.BaseApplication
+	responses map[string][][]byte
+}
+
+var _ abci.Application = (*replayApp)(nil)
+
+// response sets res to the next recorded response to req.
+func (app *replayApp) response(method string, req, res proto.Message) error {
+	key := responseKey(method, requestHash(req))
+	recorded := app.responses[key]
+	if len(recorded) == 0 {
+		return fmt.Errorf("no recorded response to %s request %X", method, requestHash(req))
+	}
+	app.responses[key] = recorded[1:]
+	return proto.Unmarshal(recorded[0], res)
+}
+
+func (app *replayApp) PrepareProposal(
+	_ context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	res := new(abci.PrepareProposalResponse)
+	return res, app.response("PrepareProposal", req, res)
+}
+
+func (app *replayApp) ProcessProposal(
+	_ context.Context,
+	req *abci.ProcessProposalRequest,
+) (*abci.ProcessProposalResponse, error) {
+	res := new(abci.ProcessProposalResponse)
+	return res, app.response("ProcessProposal", req, res)
+}
+
+func (app *replayApp) ExtendVote(_ context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+	res := new(abci.ExtendVoteResponse)
+	return res, app.response("ExtendVote", req, res)
+}
+
+func (app *replayApp) VerifyVoteExtension(
+	_ context.Context,
+	req *abci.VerifyVoteExtensionRequest,
+) (*abci.VerifyVoteExtensionResponse, error) {
+	res := new(abci.VerifyVoteExtensionResponse)
+	return res, app.response("VerifyVoteExtension", req, res)
+}
+
+func (app *replayApp) FinalizeBlock(
+	_ context.Context,
+	req *abci.FinalizeBlockRequest,
+) (*abci.FinalizeBlockResponse, error) {
+	res := new(abci.FinalizeBlockResponse)
+	return res, app.response("FinalizeBlock", req, res)
+}
+
+func (app *replayApp) Commit(_ context.Context, req *abci.CommitRequest) (*abci.CommitResponse, error) {
+	res := new(abci.CommitResponse)
+	return res, app.response("Commit", req, res)
+}
+
+// replayTicker never fires: the timeouts are replayed from the recording.
+type replayTicker struct{}
+
+var _ TimeoutTicker = replayTicker{}
+
+func (replayTicker) Start() error                { return nil }
+func (replayTicker) Stop() error                 { return nil }
+func (replayTicker) Chan() <-chan timeoutInfo    { return nil }
+func (replayTicker) ScheduleTimeout(timeoutInfo) {}
+func (replayTicker) SetLogger(log.Logger)        {}
@@ -0,0 +1,165 @@
+package consensus
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	sm "github.com/cometbft/cometbft/internal/state"
+	"github.com/cometbft/cometbft/internal/store"
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// A recording made over two runs of the consensus state is replayed to the
+// same states.
+func TestReplayRecording(t *testing.T) {
+	config := ResetConfig("consensus_record_test")
+	defer os.RemoveAll(config.RootDir)
+
+	consensusParams := types.DefaultConsensusParams()
+	consensusParams.Feature.VoteExtensionsEnableHeight = 2
+	state, privVals := randGenesisState(1, consensusParams)
+
+	app := kvstore.NewInMemoryApplication()
+	appConn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(cmtsync.Mutex), app), proxy.NopMetrics())
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, stateStore.Save(state))
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	recordFile := filepath.Join(config.RootDir, "cs.record")
+	rec, err := NewRecorder(recordFile)
+	require.NoError(t, err)
+
+	// run commits a few blocks, from the state in the store, and returns the
+	// state of the last committed block.
+	run := func() sm.State {
+		state, err := stateStore.Load()
+		require.NoError(t, err)
+		blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), rec.AppConnConsensus(appConn),
+			emptyMempool{}, sm.EmptyEvidencePool{}, blockStore)
+		cs := NewState(config.Consensus, state, blockExec, blockStore, emptyMempool{}, sm.EmptyEvidencePool{},
+			StateRecorder(rec))
+		cs.SetLogger(log.TestingLogger().With("module", "consensus"))
+		cs.SetPrivValidator(privVals[0])
+		eventBus := types.NewEventBus()
+		require.NoError(t, eventBus.Start())
+		defer func() { require.NoError(t, eventBus.Stop()) }()
+		cs.SetEventBus(eventBus)
+		// Blocks are committed faster than they are read.
+		blocksSub, err := eventBus.Subscribe(context.Background(), testSubscriber, types.EventQueryNewBlock, 100)
+		require.NoError(t, err)
+		newBlockCh := blocksSub.Out()
+
+		require.NoError(t, cs.Start())
+		for h := state.LastBlockHeight + 1; h <= state.LastBlockHeight+3; h++ {
+			ensureNewBlock(newBlockCh, h)
+		}
+		require.NoError(t, cs.Stop())
+		cs.Wait()
+		return cs.GetState()
+	}
+	state1 := run()
+	state2 := run()
+	require.NoError(t, rec.Close())
+
+	for i, want := range []sm.State{state1, state2} {
+		f, err := os.Open(recordFile)
+		require.NoError(t, err)
+		result, err := ReplayRecording(config.Consensus, f, i, log.TestingLogger())
+		f.Close()
+		require.NoError(t, err, "session %d", i)
+		require.Positive(t, result.Inputs)
+		require.Equal(t, want.LastBlockHeight, result.State.LastBlockHeight, "session %d", i)
+		require.Equal(t, want.AppHash, result.State.AppHash, "session %d", i)
+		require.Equal(t, want.LastBlockID, result.State.LastBlockID, "session %d", i)
+	}
+
+	// The last session is replayed by default, and there is no third session.
+	f, err := os.Open(recordFile)
+	require.NoError(t, err)
+	defer f.Close()
+	result, err := ReplayRecording(config.Consensus, f, -1, log.TestingLogger())
+	require.NoError(t, err)
+	require.Equal(t, state2.LastBlockHeight, result.State.LastBlockHeight)
+
+	_, err = f.Seek(0, 0)
+	require.NoError(t, err)
+	_, err = ReplayRecording(config.Consensus, f, 2, log.TestingLogger())
+	require.Error(t, err)
+}
+
+// The last block is restored with the part set it was committed with, which is
+// erasure coded if erasure coding is enabled.
+func TestRestoreLastBlockErasureCoded(t *testing.T) {
+	consensusParams := types.DefaultConsensusParams()
+	consensusParams.Block.MaxBytes = types.MaxErasureCodedBlockSizeBytes
+	consensusParams.Feature.ErasureCodingEnableHeight = 1
+	state, privVals := randGenesisState(1, consensusParams)
+
+	// The block spans several parts, so that it is erasure coded.
+	txs := []types.Tx{make([]byte, 2*types.BlockPartSizeBytes)}
+	block := state.MakeBlock(1, txs, &types.Commit{}, nil, state.Validators.GetProposer().Address)
+	parts, err := state.MakePartSet(block)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	commit, err := test.MakeCommit(blockID, 1, 0, state.Validators, privVals, state.ChainID, cmttime.Now())
+	require.NoError(t, err)
+
+	pb, err := block.ToProto()
+	require.NoError(t, err)
+	var header recordHeader
+	header.LastBlock, err = proto.Marshal(pb)
+	require.NoError(t, err)
+	header.LastCommit, err = proto.Marshal(commit.ToProto())
+	require.NoError(t, err)
+
+	state.LastBlockHeight = 1
+	state.LastBlockID = blockID
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	_, err = restoreLastBlock(blockStore, state, header)
+	require.NoError(t, err)
+	meta := blockStore.LoadBlockMeta(1)
+	require.NotNil(t, meta)
+	require.Equal(t, blockID, meta.BlockID)
+}
+
+// Messages are recorded at the time they were queued, not processed.
+func TestRecordReceiveTime(t *testing.T) {
+	cs, vss := randState(2)
+	rec, err := NewRecorder(filepath.Join(t.TempDir(), "cs.record"))
+	require.NoError(t, err)
+	cs.recorder = rec
+	rec.startSession(cmttime.Now(), recordHeader{})
+
+	queued := cmttime.Now()
+	cs.now = func() time.Time { return queued }
+	vote := signVote(vss[1], types.PrevoteType, nil, types.PartSetHeader{}, false)
+	_, err = cs.AddVote(vote, "peer")
+	require.NoError(t, err)
+
+	cs.now = func() time.Time { return queued.Add(time.Second) }
+	mi := <-cs.peerMsgQueue
+	cs.recordInput(mi, mi.ReceiveTime)
+	require.NoError(t, rec.Close())
+
+	f, err := os.Open(rec.file.Name())
+	require.NoError(t, err)
+	defer f.Close()
+	session, err := readRecordSession(f, 0)
+	require.NoError(t, err)
+	require.Len(t, session.inputs, 1)
+	require.True(t, queued.Equal(session.inputs[0].Time), "recorded at %v, queued at %v", session.inputs[0].Time, queued)
+}
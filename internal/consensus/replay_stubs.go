@@ -40,13 +40,14 @@ func (emptyMempool) Update(
 ) error {
 	return nil
 }
-func (emptyMempool) Flush()                                 {}
-func (emptyMempool) FlushAppConn() error                    { return nil }
-func (emptyMempool) TxsAvailable() <-chan struct{}          { return make(chan struct{}) }
-func (emptyMempool) EnableTxsAvailable()                    {}
-func (emptyMempool) SetTxRemovedCallback(func(types.TxKey)) {}
-func (emptyMempool) TxsBytes() int64                        { return 0 }
-func (emptyMempool) InMempool(types.TxKey) bool             { return false }
+func (emptyMempool) Flush()                                                        {}
+func (emptyMempool) FlushAppConn() error                                           { return nil }
+func (emptyMempool) TxsAvailable() <-chan struct{}                                 { return make(chan struct{}) }
+func (emptyMempool) EnableTxsAvailable()                                           {}
+func (emptyMempool) SetTxRemovedCallback(func(types.TxKey, mempl.TxRemovalReason)) {}
+func (emptyMempool) SenderUsage(string) (int, int64)                               { return 0, 0 }
+func (emptyMempool) TxsBytes() int64                                               { return 0 }
+func (emptyMempool) InMempool(types.TxKey) bool                                    { return false }
 
 func (emptyMempool) TxsFront() *clist.CElement    { return nil }
 func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
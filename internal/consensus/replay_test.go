@@ -154,6 +154,9 @@ func TestWALCrash(t *testing.T) {
 	for i, tc := range testCases {
 		tc := tc
 		consensusReplayConfig := ResetConfig(fmt.Sprintf("%s_%d", t.Name(), i))
+		// The private validator is reset before replaying the WAL of the
+		// crashed node, which the double-sign guard refuses.
+		consensusReplayConfig.Consensus.DoubleSignGuard = false
 		t.Run(tc.name, func(t *testing.T) {
 			crashWALandCheckLiveness(t, consensusReplayConfig, tc.initFn, tc.heightToStop)
 		})
@@ -38,6 +38,9 @@ var msgQueueSize = 1000
 type msgInfo struct {
 	Msg    Message `json:"msg"`
 	PeerID p2p.ID  `json:"peer_key"`
+
+	// When the message was queued. It is not written to the WAL.
+	ReceiveTime time.Time `json:"-"`
 }
 
 // internally generated messages which may update the state.
@@ -46,6 +49,9 @@ type timeoutInfo struct {
 	Height   int64                 `json:"height"`
 	Round    int32                 `json:"round"`
 	Step     cstypes.RoundStepType `json:"step"`
+
+	// When the timeout fired. It is not written to the WAL.
+	ReceiveTime time.Time `json:"-"`
 }
 
 func (ti *timeoutInfo) String() string {
@@ -135,6 +141,26 @@ type State struct {
 
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
+
+	// records when transactions are included in a proposal, or nil
+	txTracer *types.TxTracer
+
+	// records the inputs of the state machine, or nil
+	recorder *Recorder
+
+	// tracks how the validators signed the last commits, or nil
+	livenessTracker *types.LivenessTracker
+	// addresses of the validators whose liveness metrics are reported
+	livenessMetricsAddrs map[string]struct{}
+
+	// returns the current time; replaced by the time of the recorded inputs
+	// when replaying a recording
+	now func() time.Time
+
+	// delays observed in the last heights, for the adaptive propose and
+	// prevote timeouts
+	proposeDelays *delayWindow
+	prevoteDelays *delayWindow
 }
 
 // StateOption sets an optional parameter on the State.
@@ -165,6 +191,9 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		now:              cmttime.Now,
+		proposeDelays:    newDelayWindow(),
+		prevoteDelays:    newDelayWindow(),
 	}
 	for _, option := range options {
 		option(cs)
@@ -220,6 +249,23 @@ func OfflineStateSyncHeight(height int64) StateOption {
 	return func(cs *State) { cs.offlineStateSyncHeight = height }
 }
 
+// StateTxTracer records in tracer when transactions are included in a
+// complete proposal block.
+func StateTxTracer(tracer *types.TxTracer) StateOption {
+	return func(cs *State) { cs.txTracer = tracer }
+}
+
+// StateLivenessTracker records in tracker how the validators signed the
+// commits of the last heights.
+func StateLivenessTracker(tracker *types.LivenessTracker) StateOption {
+	return func(cs *State) { cs.livenessTracker = tracker }
+}
+
+// StateRecorder records the inputs of the state machine with rec.
+func StateRecorder(rec *Recorder) StateOption {
+	return func(cs *State) { cs.recorder = rec }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -314,6 +360,11 @@ func (cs *State) OnStart() error {
 		}
 	}
 
+	// Replaying the WAL may sign messages.
+	if err := cs.checkDoubleSignGuard(cs.wal); err != nil {
+		return err
+	}
+
 	// we need the timeoutRoutine for replay so
 	// we don't block on the tick chan.
 	// NOTE: we will get a build up of garbage go routines
@@ -323,6 +374,14 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	if cs.recorder != nil {
+		header, err := makeRecordHeader(cs.state, cs.blockStore, cs.privValidatorPubKey)
+		if err != nil {
+			return fmt.Errorf("failed to start consensus recording: %w", err)
+		}
+		cs.recorder.startSession(cs.now(), header)
+	}
+
 	// We may have lost some votes if the process crashed reload from consensus
 	// log to catchup.
 	if cs.doWALCatchup {
@@ -384,6 +443,8 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	cs.loadLiveness()
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
 
@@ -468,9 +529,9 @@ func (cs *State) OpenWAL(walFile string) (WAL, error) {
 // AddVote inputs a vote.
 func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, ""}
+		cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, "", cs.now()}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID}
+		cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID, cs.now()}
 	}
 
 	// TODO: wait for event?!
@@ -480,9 +541,9 @@ func (cs *State) AddVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 // SetProposal inputs a proposal.
 func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, ""}
+		cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", cs.now()}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID}
+		cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, cs.now()}
 	}
 
 	// TODO: wait for event?!
@@ -492,9 +553,9 @@ func (cs *State) SetProposal(proposal *types.Proposal, peerID p2p.ID) error {
 // AddProposalBlockPart inputs a part of the proposal block.
 func (cs *State) AddProposalBlockPart(height int64, round int32, part *types.Part, peerID p2p.ID) error {
 	if peerID == "" {
-		cs.internalMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, ""}
+		cs.internalMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, "", cs.now()}
 	} else {
-		cs.peerMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, peerID}
+		cs.peerMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, peerID, cs.now()}
 	}
 
 	// TODO: wait for event?!
@@ -546,14 +607,68 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
-	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	// cs.Logger.Info("scheduleRound0", "now", cs.now(), "startTime", cs.StartTime)
+	sleepDuration := rs.StartTime.Sub(cs.now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
 // Attempt to schedule a timeout (by sending timeoutInfo on the tickChan).
 func (cs *State) scheduleTimeout(duration time.Duration, height int64, round int32, step cstypes.RoundStepType) {
-	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
+	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{Duration: duration, Height: height, Round: round, Step: step})
+}
+
+// The timeouts below are taken from the TimeoutParams consensus params or, if
+// not set there, from the local config. With adaptive timeouts, the propose and
+// prevote timeouts of round 0 are instead derived from the observed delays.
+
+// proposeTimeout returns the amount of time to wait for a proposal in round.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	timeout := cs.adaptiveTimeout(cs.proposeDelays, timeoutParam(tp.Propose, cs.config.TimeoutPropose),
+		cs.config.TimeoutProposeMin, cs.config.TimeoutProposeMax)
+	return timeout + timeoutParam(tp.ProposeDelta, cs.config.TimeoutProposeDelta)*time.Duration(round)
+}
+
+// prevoteTimeout returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes in round.
+func (cs *State) prevoteTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	timeout := cs.adaptiveTimeout(cs.prevoteDelays, timeoutParam(tp.Vote, cs.config.TimeoutPrevote),
+		cs.config.TimeoutPrevoteMin, cs.config.TimeoutPrevoteMax)
+	return timeout + timeoutParam(tp.VoteDelta, cs.config.TimeoutPrevoteDelta)*time.Duration(round)
+}
+
+// precommitTimeout returns the amount of time to wait for straggler votes
+// after receiving any +2/3 precommits in round.
+func (cs *State) precommitTimeout(round int32) time.Duration {
+	tp := cs.state.ConsensusParams.Timeout
+	return timeoutParam(tp.Vote, cs.config.TimeoutPrecommit) +
+		timeoutParam(tp.VoteDelta, cs.config.TimeoutPrecommitDelta)*time.Duration(round)
+}
+
+// commitTimeout returns the amount of time to wait for straggler votes after
+// committing a block, given the timeout params of the next height.
+func (cs *State) commitTimeout(tp types.TimeoutParams) time.Duration {
+	return timeoutParam(tp.Commit, cs.config.TimeoutCommit)
+}
+
+// bypassCommitTimeout returns true if the next height can start as soon as
+// all precommits are received. As a boolean cannot be unset, the local
+// SkipTimeoutCommit is only used if none of the TimeoutParams are set.
+func (cs *State) bypassCommitTimeout() bool {
+	tp := cs.state.ConsensusParams.Timeout
+	if tp == (types.TimeoutParams{}) {
+		return cs.config.SkipTimeoutCommit
+	}
+	return tp.BypassCommitTimeout
+}
+
+// timeoutParam returns param if it is set, and local otherwise.
+func timeoutParam(param, local time.Duration) time.Duration {
+	if param > 0 {
+		return param
+	}
+	return local
 }
 
 // send a msg into the receiveRoutine regarding our own proposal, block part, or vote.
@@ -587,7 +702,7 @@ func (cs *State) reconstructSeenCommit(state sm.State) {
 // the method will panic on an absent ExtendedCommit or an ExtendedCommit without
 // extension data.
 func (cs *State) reconstructLastCommit(state sm.State) {
-	extensionsEnabled := state.ConsensusParams.ABCI.VoteExtensionsEnabled(state.LastBlockHeight)
+	extensionsEnabled := state.ConsensusParams.Features().VoteExtensionsEnabled(state.LastBlockHeight)
 	if !extensionsEnabled {
 		cs.reconstructSeenCommit(state)
 		return
@@ -717,13 +832,14 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(cmttime.Now())
+		cs.StartTime = cs.now().Add(cs.commitTimeout(state.ConsensusParams.Timeout))
 	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
+		cs.StartTime = cs.CommitTime.Add(cs.commitTimeout(state.ConsensusParams.Timeout))
 	}
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -732,7 +848,7 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidRound = -1
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
-	if state.ConsensusParams.ABCI.VoteExtensionsEnabled(height) {
+	if state.ConsensusParams.Features().VoteExtensionsEnabled(height) {
 		cs.Votes = cstypes.NewExtendedHeightVoteSet(state.ChainID, height, validators)
 	} else {
 		cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, validators)
@@ -817,12 +933,14 @@ func (cs *State) receiveRoutine(maxSteps int) {
 
 		select {
 		case <-cs.txNotifier.TxsAvailable():
+			cs.recordInput(txsAvailableInfo{}, cs.now())
 			cs.handleTxsAvailable()
 
 		case mi = <-cs.peerMsgQueue:
 			if err := cs.wal.Write(mi); err != nil {
 				cs.Logger.Error("failed writing to WAL", "err", err)
 			}
+			cs.recordInput(mi, mi.ReceiveTime)
 			// handles proposals, block parts, votes
 			// may generate internal events (votes, complete proposals, 2/3 majorities)
 			cs.handleMsg(mi)
@@ -835,6 +953,7 @@ func (cs *State) receiveRoutine(maxSteps int) {
 					mi, err,
 				))
 			}
+			cs.recordInput(mi, mi.ReceiveTime)
 
 			if _, ok := mi.Msg.(*VoteMessage); ok {
 				// we actually want to simulate failing during
@@ -851,6 +970,7 @@ func (cs *State) receiveRoutine(maxSteps int) {
 			if err := cs.wal.Write(ti); err != nil {
 				cs.Logger.Error("failed writing to WAL", "err", err)
 			}
+			cs.recordInput(ti, ti.ReceiveTime)
 
 			// if the timeout is relevant to the rs
 			// go to the next step
@@ -863,6 +983,19 @@ func (cs *State) receiveRoutine(maxSteps int) {
 	}
 }
 
+// recordInput records an input of the state machine received at
+// receiveTime, if recording is enabled. Inputs without a receive time, e.g.
+// replayed from the WAL, are recorded at the current time.
+func (cs *State) recordInput(msg RecordMessage, receiveTime time.Time) {
+	if cs.recorder == nil {
+		return
+	}
+	if receiveTime.IsZero() {
+		receiveTime = cs.now()
+	}
+	cs.recorder.record(receiveTime, msg)
+}
+
 // state transitions on complete-proposal, 2/3-any, 2/3-one.
 func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
@@ -1021,7 +1154,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1035,7 +1168,7 @@ func (cs *State) handleTxsAvailable() {
 
 // Enter: `timeoutNewHeight` by startTime (commitTime+timeoutCommit),
 //
-//	or, if SkipTimeoutCommit==true, after receiving all precommits from (height,round-1)
+//	or, if the commit timeout is bypassed, after receiving all precommits from (height,round-1)
 //
 // Enter: `timeoutPrecommits` after any +2/3 precommits from (height,round-1)
 // Enter: +2/3 precommits for nil at (height,round-1)
@@ -1052,7 +1185,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1076,6 +1209,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	if round != 0 {
 		logger.Info("resetting proposal info", "proposer", propAddress)
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1139,6 +1273,17 @@ func (cs *State) enterPropose(height int64, round int32) {
 		return
 	}
 
+	// With proposer-based timestamps, the proposer waits until its local
+	// time is after the time of the previous block, so that the time of its
+	// block is valid.
+	if cs.isPBTSEnabled(height) && cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address()) {
+		if waitTime := proposerWaitTime(cs.now(), cs.state.LastBlockTime); waitTime > 0 {
+			logger.Debug("propose step; waiting for the time of the previous block", "wait_time", waitTime)
+			cs.scheduleTimeout(waitTime, height, round, cstypes.RoundStepNewRound)
+			return
+		}
+	}
+
 	logger.Debug("entering propose step", "current", log.NewLazySprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
 	defer func() {
@@ -1155,7 +1300,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1192,6 +1337,21 @@ func (cs *State) isProposer(address []byte) bool {
 	return bytes.Equal(cs.Validators.GetProposer().Address, address)
 }
 
+// isPBTSEnabled returns true if proposer-based timestamps are enabled at
+// height.
+func (cs *State) isPBTSEnabled(height int64) bool {
+	return cs.state.ConsensusParams.Feature.PBTSEnabled(height)
+}
+
+// proposerWaitTime returns how long the proposer must wait, at time now, for
+// its local time to be after lastBlockTime.
+func proposerWaitTime(now, lastBlockTime time.Time) time.Duration {
+	if now.After(lastBlockTime) {
+		return 0
+	}
+	return lastBlockTime.Sub(now) + time.Millisecond
+}
+
 func (cs *State) defaultDecideProposal(height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
@@ -1211,7 +1371,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 			panic("Method createProposalBlock should not provide a nil block without errors")
 		}
 		cs.metrics.ProposalCreateCount.Add(1)
-		blockParts, err = block.MakePartSet(types.BlockPartSizeBytes)
+		blockParts, err = cs.state.MakePartSet(block)
 		if err != nil {
 			cs.Logger.Error("unable to create proposal block part set", "error", err)
 			return
@@ -1227,16 +1387,20 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	if cs.isPBTSEnabled(height) {
+		// The proposal is timely if the block is.
+		proposal.Timestamp = block.Time
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
 
 		// send proposal and block parts on internal msg queue
-		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, ""})
+		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cs.now()})
 
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
-			cs.sendInternalMessage(msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, ""})
+			cs.sendInternalMessage(msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, "", cs.now()})
 		}
 
 		cs.Logger.Debug("signed proposal", "height", height, "round", round, "proposal", proposal)
@@ -1282,7 +1446,7 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 
 	case cs.LastCommit.HasTwoThirdsMajority():
 		// Make the commit from LastCommit
-		lastExtCommit = cs.LastCommit.MakeExtendedCommit(cs.state.ConsensusParams.ABCI)
+		lastExtCommit = cs.LastCommit.MakeExtendedCommit(cs.state.ConsensusParams.Features())
 
 	default: // This shouldn't happen.
 		return nil, ErrProposalWithoutPreviousCommit
@@ -1303,6 +1467,34 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 	return ret, nil
 }
 
+// speculateProposalBlock starts creating the proposal block of round 0 of the
+// new height, if proposals are pipelined and this node is its proposer, for
+// createProposalBlock to use it at the end of timeout_commit.
+func (cs *State) speculateProposalBlock() {
+	if !cs.config.PipelineProposals || cs.replayMode || cs.config.WaitForTxs() {
+		return
+	}
+	if cs.privValidatorPubKey == nil || !cs.isProposer(cs.privValidatorPubKey.Address()) {
+		return
+	}
+	if !cs.LastCommit.HasTwoThirdsMajority() {
+		return
+	}
+	lastExtCommit := cs.LastCommit.MakeExtendedCommit(cs.state.ConsensusParams.Features())
+	cs.blockExec.SpeculateProposalBlock(cs.Height, cs.state, lastExtCommit, cs.privValidatorPubKey.Address())
+}
+
+// proposalIsTimely returns true if the proposal of the current round was
+// received in time, given the synchrony parameters. The proposal of the first
+// height must have the genesis time.
+func (cs *State) proposalIsTimely() bool {
+	if cs.Height == cs.state.InitialHeight {
+		return cs.state.LastBlockTime.Equal(cs.Proposal.Timestamp)
+	}
+	sp := cs.state.ConsensusParams.Synchrony.InRound(cs.Proposal.Round)
+	return cs.Proposal.IsTimely(cs.ProposalReceiveTime, sp)
+}
+
 // Enter: `timeoutPropose` after entering Propose.
 // Enter: proposal block and POL is ready.
 // If we received a valid proposal within this round and we are not locked on a block,
@@ -1346,6 +1538,24 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	if cs.isPBTSEnabled(height) {
+		if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Time) {
+			logger.Debug("prevote step: proposal timestamp not equal to block time; prevoting nil",
+				"proposal_timestamp", cs.Proposal.Timestamp, "block_time", cs.ProposalBlock.Time)
+			cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{}, nil)
+			return
+		}
+
+		// Only new proposals must be timely: a block with a POL was already
+		// deemed timely by a correct validator.
+		if cs.Proposal.POLRound == -1 && !cs.proposalIsTimely() {
+			logger.Debug("prevote step: proposal is not timely; prevoting nil",
+				"proposal_timestamp", cs.Proposal.Timestamp, "receive_time", cs.ProposalReceiveTime)
+			cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{}, nil)
+			return
+		}
+	}
+
 	// Validate proposal block, from consensus' perspective
 	err := cs.blockExec.ValidateBlock(cs.state, cs.ProposalBlock)
 	if err != nil {
@@ -1515,7 +1725,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.prevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1619,7 +1829,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 
 	if !cs.ProposalBlockParts.HasHeader(blockID.PartSetHeader) {
 		cs.ProposalBlock = nil
-		cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+		cs.ProposalBlockParts = cs.state.NewPartSetFromHeader(height, blockID.PartSetHeader)
 	}
 
 	cs.signAddVote(types.PrecommitType, nil, types.PartSetHeader{}, nil)
@@ -1654,7 +1864,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.precommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block.
@@ -1676,7 +1886,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1709,7 +1919,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 			// We're getting the wrong block.
 			// Set up ProposalBlockParts and keep waiting.
 			cs.ProposalBlock = nil
-			cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+			cs.ProposalBlockParts = cs.state.NewPartSetFromHeader(height, blockID.PartSetHeader)
 
 			if err := cs.eventBus.PublishEventValidBlock(cs.RoundStateEvent()); err != nil {
 				logger.Error("failed publishing valid block", "err", err)
@@ -1793,8 +2003,8 @@ func (cs *State) finalizeCommit(height int64) {
 	if cs.blockStore.Height() < block.Height {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
-		seenExtendedCommit := cs.Votes.Precommits(cs.CommitRound).MakeExtendedCommit(cs.state.ConsensusParams.ABCI)
-		if cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(block.Height) {
+		seenExtendedCommit := cs.Votes.Precommits(cs.CommitRound).MakeExtendedCommit(cs.state.ConsensusParams.Features())
+		if cs.state.ConsensusParams.Features().VoteExtensionsEnabled(block.Height) {
 			cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, seenExtendedCommit)
 		} else {
 			cs.blockStore.SaveBlock(block, blockParts, seenExtendedCommit.ToCommit())
@@ -1864,6 +2074,7 @@ func (cs *State) finalizeCommit(height int64) {
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
+	cs.speculateProposalBlock()
 
 	// By here,
 	// * cs.Height has been increment to height+1
@@ -1926,6 +2137,9 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 	}
 	cs.metrics.MissingValidators.Set(float64(missingValidators))
 	cs.metrics.MissingValidatorsPower.Set(float64(missingValidatorsPower))
+	if height > cs.state.InitialHeight {
+		cs.addLivenessCommit(block.LastCommit, cs.LastValidators)
+	}
 
 	// NOTE: byzantine validators power and count is only for consensus evidence i.e. duplicate vote
 	var (
@@ -1999,11 +2213,12 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = cs.now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
 	if cs.ProposalBlockParts == nil {
-		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
+		cs.ProposalBlockParts = cs.state.NewPartSetFromHeader(proposal.Height, proposal.BlockID.PartSetHeader)
 	}
 
 	cs.Logger.Info("received proposal", "proposal", proposal, "proposer", pubKey.Address())
@@ -2086,6 +2301,7 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		}
 
 		cs.ProposalBlock = block
+		cs.txTracer.Proposed(block.Txs)
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
@@ -2225,7 +2441,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		cs.evsw.FireEvent(types.EventVote, vote)
 
 		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		if cs.bypassCommitTimeout() && cs.LastCommit.HasAll() {
 			// go straight to new round (skip timeout commit)
 			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
 			cs.enterNewRound(cs.Height, 0)
@@ -2242,7 +2458,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 	}
 
 	// Check to see if the chain is configured to extend votes.
-	extEnabled := cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(vote.Height)
+	extEnabled := cs.state.ConsensusParams.Features().VoteExtensionsEnabled(vote.Height)
 	if extEnabled {
 		// The chain is configured to extend votes, check that the vote is
 		// not for a nil block and verify the extensions signature against the
@@ -2332,7 +2548,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				}
 
 				if !cs.ProposalBlockParts.HasHeader(blockID.PartSetHeader) {
-					cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+					cs.ProposalBlockParts = cs.state.NewPartSetFromHeader(height, blockID.PartSetHeader)
 				}
 
 				cs.evsw.FireEvent(types.EventValidBlock, &cs.RoundState)
@@ -2380,7 +2596,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 			if !blockID.IsNil() {
 				cs.enterCommit(height, vote.Round)
-				if cs.config.SkipTimeoutCommit && precommits.HasAll() {
+				if cs.bypassCommitTimeout() && precommits.HasAll() {
 					cs.enterNewRound(cs.Height, 0)
 				}
 			} else {
@@ -2395,6 +2611,17 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		panic(fmt.Sprintf("unexpected vote type %v", vote.Type))
 	}
 
+	// Round-skip if validators with more than 1/3 of the voting power are in a
+	// higher round: at least one correct validator is ahead of us. Never leave
+	// the commit step, the decided block must still be finalized.
+	if cs.Height == height && cs.Step < cstypes.RoundStepCommit &&
+		cs.Round < vote.Round && cs.Votes.HasOneThirdAny(vote.Round) {
+		cs.Logger.Debug("skipping to higher round with +1/3 of voting power",
+			"height", height, "round", cs.Round, "vote_round", vote.Round)
+		cs.metrics.RoundSkips.Add(1)
+		cs.enterNewRound(height, vote.Round)
+	}
+
 	return added, err
 }
 
@@ -2428,7 +2655,7 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 
-	extEnabled := cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(vote.Height)
+	extEnabled := cs.state.ConsensusParams.Features().VoteExtensionsEnabled(vote.Height)
 	if msgType == types.PrecommitType && !vote.BlockID.IsNil() {
 		// if the signedMessage type is for a non-nil precommit, add
 		// VoteExtension
@@ -2450,7 +2677,12 @@ func (cs *State) signVote(
 }
 
 func (cs *State) voteTime() time.Time {
-	now := cmttime.Now()
+	now := cs.now()
+	// With proposer-based timestamps, the time of votes is not used to
+	// compute the time of blocks.
+	if cs.isPBTSEnabled(cs.Height) {
+		return now
+	}
 	minVoteTime := now
 	// Minimum time increment between blocks
 	const timeIota = time.Millisecond
@@ -2500,12 +2732,12 @@ func (cs *State) signAddVote(
 		return
 	}
 	hasExt := len(vote.ExtensionSignature) > 0
-	extEnabled := cs.state.ConsensusParams.ABCI.VoteExtensionsEnabled(vote.Height)
+	extEnabled := cs.state.ConsensusParams.Features().VoteExtensionsEnabled(vote.Height)
 	if vote.Type == types.PrecommitType && !vote.BlockID.IsNil() && hasExt != extEnabled {
 		panic(fmt.Errorf("vote extension absence/presence does not match extensions enabled %t!=%t, height %d, type %v",
 			hasExt, extEnabled, vote.Height, vote.Type))
 	}
-	cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, ""})
+	cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, "", cs.now()})
 	cs.Logger.Debug("signed and pushed vote", "height", cs.Height, "round", cs.Round, "vote", vote)
 }
 
@@ -2567,13 +2799,24 @@ func (cs *State) calculatePrevoteMessageDelayMetrics() {
 		_, val := cs.Validators.GetByAddress(v.ValidatorAddress)
 		votingPowerSeen += val.VotingPower
 		if votingPowerSeen >= cs.Validators.TotalVotingPower()*2/3+1 {
-			cs.metrics.QuorumPrevoteDelay.With("proposer_address", cs.Validators.GetProposer().Address.String()).Set(v.Timestamp.Sub(cs.Proposal.Timestamp).Seconds())
+			quorumDelay := v.Timestamp.Sub(cs.Proposal.Timestamp)
+			cs.metrics.QuorumPrevoteDelay.With("proposer_address", cs.Validators.GetProposer().Address.String()).Set(quorumDelay.Seconds())
+
+			// The proposal reached, and was prevoted by, +2/3 of the voting
+			// power within quorumDelay; the prevotes received after the
+			// quorum were late by the delay until the last one.
+			cs.proposeDelays.add(quorumDelay)
+			cs.prevoteDelays.add(pl[len(pl)-1].Timestamp.Sub(v.Timestamp))
 			break
 		}
 	}
 	if ps.HasAll() {
 		cs.metrics.FullPrevoteDelay.With("proposer_address", cs.Validators.GetProposer().Address.String()).Set(pl[len(pl)-1].Timestamp.Sub(cs.Proposal.Timestamp).Seconds())
 	}
+	if cs.config.AdaptiveTimeouts {
+		cs.metrics.AdaptiveTimeoutSeconds.With("step", "propose").Set(cs.proposeTimeout(0).Seconds())
+		cs.metrics.AdaptiveTimeoutSeconds.With("step", "prevote").Set(cs.prevoteTimeout(0).Seconds())
+	}
 }
 
 //---------------------------------------------------------
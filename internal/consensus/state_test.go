@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -21,11 +23,13 @@ import (
 	"github.com/cometbft/cometbft/internal/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/internal/pubsub"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
 	"github.com/cometbft/cometbft/internal/test"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/log"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 /*
@@ -264,6 +268,518 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, types.PrecommitType, propBlock.Hash(), bps2.Header(), true, vs2)
 }
 
+// TestStatePBTSProposal tests that, with proposer-based timestamps, the
+// proposer sets the timestamp of its proposal to the time of its block and
+// prevotes for it.
+func TestStatePBTSProposal(t *testing.T) {
+	c := test.ConsensusParams()
+	c.Feature.PBTSEnableHeight = 1
+	cs1, vss := randStateWithAppImpl(1, kvstore.NewInMemoryApplication(), c)
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	rs := cs1.GetRoundState()
+	require.True(t, rs.Proposal.Timestamp.Equal(rs.ProposalBlock.Time))
+	require.False(t, rs.ProposalReceiveTime.IsZero())
+
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], rs.ProposalBlock.Hash())
+}
+
+// TestStatePBTSProposalTimestampMismatch tests that, with proposer-based
+// timestamps, a validator prevotes nil for a proposal whose timestamp is not
+// the time of its block.
+func TestStatePBTSProposalTimestampMismatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := test.ConsensusParams()
+	c.Feature.PBTSEnableHeight = 1
+	cs1, vss := randStateWithAppImpl(2, kvstore.NewInMemoryApplication(), c)
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	propBlock, err := cs1.createProposalBlock(ctx)
+	require.NoError(t, err)
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vss[1:]...)
+
+	propBlockParts, err := propBlock.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+	proposal.Timestamp = propBlock.Time.Add(time.Millisecond)
+	p := proposal.ToProto()
+	require.NoError(t, vs2.SignProposal(cs1.state.ChainID, p))
+	proposal.Signature = p.Signature
+
+	require.NoError(t, cs1.SetProposalAndBlock(proposal, propBlock, propBlockParts, "some peer"))
+
+	startTestRound(cs1, height, round)
+	ensureProposal(proposalCh, height, round, blockID)
+
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+}
+
+func TestStateProposalIsTimely(t *testing.T) {
+	cs1, _ := randState(1)
+	cs1.state.ConsensusParams.Synchrony = types.SynchronyParams{
+		Precision:    10 * time.Millisecond,
+		MessageDelay: 100 * time.Millisecond,
+	}
+	cs1.state.ConsensusParams.Feature.PBTSEnableHeight = 1
+
+	// At the initial height, the proposal must have the genesis time.
+	genesisTime := cs1.state.LastBlockTime
+	cs1.Proposal = &types.Proposal{Height: cs1.Height, Timestamp: genesisTime}
+	cs1.ProposalReceiveTime = genesisTime.Add(time.Hour)
+	assert.True(t, cs1.proposalIsTimely())
+	cs1.Proposal.Timestamp = genesisTime.Add(time.Millisecond)
+	assert.False(t, cs1.proposalIsTimely())
+
+	// Later, the proposal must be received within the synchrony bounds, which
+	// grow with the round.
+	cs1.Height = cs1.state.InitialHeight + 1
+	cs1.ProposalReceiveTime = cmttime.Now()
+	cs1.Proposal.Timestamp = cs1.ProposalReceiveTime.Add(-50 * time.Millisecond)
+	assert.True(t, cs1.proposalIsTimely())
+	cs1.Proposal.Timestamp = cs1.ProposalReceiveTime.Add(20 * time.Millisecond)
+	assert.False(t, cs1.proposalIsTimely())
+	cs1.Proposal.Timestamp = cs1.ProposalReceiveTime.Add(-200 * time.Millisecond)
+	assert.False(t, cs1.proposalIsTimely())
+	cs1.Proposal.Round = 10
+	assert.True(t, cs1.proposalIsTimely())
+}
+
+func TestProposerWaitTime(t *testing.T) {
+	now := cmttime.Now()
+	assert.Zero(t, proposerWaitTime(now, now.Add(-time.Second)))
+	assert.Equal(t, time.Second+time.Millisecond, proposerWaitTime(now, now.Add(time.Second)))
+	assert.Equal(t, time.Millisecond, proposerWaitTime(now, now))
+}
+
+// TestStateTimeoutParams tests that the timeouts set in the consensus params
+// are used, and that the local config is used for the ones that are not set.
+func TestStateTimeoutParams(t *testing.T) {
+	cs1, _ := randState(1)
+	cfg := cs1.config
+
+	// By default, the local config is used.
+	assert.Equal(t, cfg.Propose(2), cs1.proposeTimeout(2))
+	assert.Equal(t, cfg.Prevote(2), cs1.prevoteTimeout(2))
+	assert.Equal(t, cfg.Precommit(2), cs1.precommitTimeout(2))
+	assert.Equal(t, cfg.TimeoutCommit, cs1.commitTimeout(cs1.state.ConsensusParams.Timeout))
+	assert.Equal(t, cfg.SkipTimeoutCommit, cs1.bypassCommitTimeout())
+
+	cfg.SkipTimeoutCommit = false
+	cs1.state.ConsensusParams.Timeout = types.TimeoutParams{
+		Propose:             time.Second,
+		Vote:                2 * time.Second,
+		VoteDelta:           100 * time.Millisecond,
+		Commit:              3 * time.Second,
+		BypassCommitTimeout: true,
+	}
+	assert.Equal(t, time.Second+2*cfg.TimeoutProposeDelta, cs1.proposeTimeout(2))
+	assert.Equal(t, 2200*time.Millisecond, cs1.prevoteTimeout(2))
+	assert.Equal(t, 2200*time.Millisecond, cs1.precommitTimeout(2))
+	assert.Equal(t, 3*time.Second, cs1.commitTimeout(cs1.state.ConsensusParams.Timeout))
+	assert.True(t, cs1.bypassCommitTimeout())
+
+	// Once the timeout params are set, the local SkipTimeoutCommit is ignored.
+	cfg.SkipTimeoutCommit = true
+	cs1.state.ConsensusParams.Timeout.BypassCommitTimeout = false
+	assert.False(t, cs1.bypassCommitTimeout())
+
+	// It is only used if none of them is set.
+	cs1.state.ConsensusParams.Timeout = types.TimeoutParams{}
+	assert.True(t, cs1.bypassCommitTimeout())
+}
+
+// TestStateAdaptiveTimeouts tests that, with adaptive timeouts, the propose
+// and prevote timeouts of round 0 track the observed delays within their
+// bounds, once enough delays were observed.
+func TestStateAdaptiveTimeouts(t *testing.T) {
+	cs1, _ := randState(1)
+	cfg := *cs1.config
+	cs1.config = &cfg
+	cfg.AdaptiveTimeouts = true
+	cfg.AdaptiveTimeoutPercentile = 0.9
+	cfg.TimeoutProposeMin, cfg.TimeoutProposeMax = 100*time.Millisecond, 2*time.Second
+	cfg.TimeoutPrevoteMin, cfg.TimeoutPrevoteMax = 50*time.Millisecond, time.Second
+
+	addDelays := func(n int, delay func(i int) time.Duration) {
+		for i := 1; i <= n; i++ {
+			cs1.proposeDelays.add(delay(i))
+			cs1.prevoteDelays.add(delay(i))
+		}
+	}
+
+	// Until enough delays are observed, the static timeouts are used.
+	addDelays(delayWindowMinSamples-1, func(i int) time.Duration { return time.Duration(i) * 100 * time.Millisecond })
+	assert.Equal(t, cfg.Propose(2), cs1.proposeTimeout(2))
+	assert.Equal(t, cfg.Prevote(2), cs1.prevoteTimeout(2))
+
+	// The 90th percentile of 100ms, 200ms, ..., 1s is 900ms.
+	addDelays(1, func(int) time.Duration { return time.Second })
+	assert.Equal(t, 900*time.Millisecond+2*cfg.TimeoutProposeDelta, cs1.proposeTimeout(2))
+	assert.Equal(t, 900*time.Millisecond+2*cfg.TimeoutPrevoteDelta, cs1.prevoteTimeout(2))
+
+	// Once the old delays are out of the window, the timeouts are bounded.
+	addDelays(delayWindowSize, func(int) time.Duration { return time.Minute })
+	assert.Equal(t, cfg.TimeoutProposeMax, cs1.proposeTimeout(0))
+	assert.Equal(t, cfg.TimeoutPrevoteMax, cs1.prevoteTimeout(0))
+	addDelays(delayWindowSize, func(int) time.Duration { return -time.Second })
+	assert.Equal(t, cfg.TimeoutProposeMin, cs1.proposeTimeout(0))
+	assert.Equal(t, cfg.TimeoutPrevoteMin, cs1.prevoteTimeout(0))
+
+	cfg.AdaptiveTimeouts = false
+	assert.Equal(t, cfg.Propose(2), cs1.proposeTimeout(2))
+	assert.Equal(t, cfg.Prevote(2), cs1.prevoteTimeout(2))
+
+	// The delays are observed at each height.
+	app := newHaltingApp(3)
+	cs2, _ := randStateWithApp(1, app)
+	require.NoError(t, cs2.Start())
+	select {
+	case <-app.halted:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the blocks")
+	}
+	assert.GreaterOrEqual(t, len(cs2.proposeDelays.delays), 2)
+	assert.GreaterOrEqual(t, len(cs2.prevoteDelays.delays), 2)
+}
+
+func TestStateLivenessTracker(t *testing.T) {
+	app := newHaltingApp(5)
+	cs1, _ := randStateWithApp(1, app)
+	cs1.livenessTracker = types.NewLivenessTracker(int(cs1.config.LivenessWindow))
+	height := cs1.Height
+
+	require.NoError(t, cs1.Start())
+	select {
+	case <-app.halted:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the blocks")
+	}
+
+	// The commit of each block is added once the next block is committed.
+	lastHeight := cs1.blockStore.Height() - 1
+	assert.Equal(t, lastHeight, cs1.livenessTracker.LastHeight())
+	from, to, liveness := cs1.livenessTracker.Liveness()
+	assert.Equal(t, height, from)
+	assert.Equal(t, lastHeight, to)
+	require.Len(t, liveness, 1)
+	assert.Equal(t, cs1.privValidatorPubKey.Address(), liveness[0].Address)
+	assert.Equal(t, to-from+1, liveness[0].Commits)
+	assert.Zero(t, liveness[0].Absent)
+	assert.Equal(t, lastHeight, liveness[0].LastSignedHeight)
+
+	// On restart, the commits of the window are loaded from the block store.
+	cfg := *cs1.config
+	cfg.LivenessWindow = 2
+	cs2 := NewState(&cfg, cs1.state, cs1.blockExec, cs1.blockStore, cs1.txNotifier, cs1.evpool,
+		StateLivenessTracker(types.NewLivenessTracker(int(cfg.LivenessWindow))))
+	cs2.loadLiveness()
+	from, to, liveness = cs2.livenessTracker.Liveness()
+	assert.Equal(t, lastHeight-1, from)
+	assert.Equal(t, lastHeight, to)
+	require.Len(t, liveness, 1)
+	assert.EqualValues(t, 2, liveness[0].Commits)
+	assert.Equal(t, lastHeight, liveness[0].LastSignedHeight)
+}
+
+// The liveness metrics of the validators that leave the liveness window are
+// zeroed.
+func TestStateLivenessMetrics(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	absent, nilVote := &labeledGauge{}, &labeledGauge{}
+	cs := &State{
+		livenessTracker: types.NewLivenessTracker(1),
+		metrics: &Metrics{
+			ValidatorLivenessAbsent:          absent,
+			ValidatorLivenessNil:             nilVote,
+			ValidatorLivenessLatenessSeconds: &labeledGauge{},
+		},
+	}
+	addr0, addr1 := vals.Validators[0].Address.String(), vals.Validators[1].Address.String()
+
+	cs.addLivenessCommit(&types.Commit{Height: 1, Signatures: []types.CommitSig{
+		types.NewCommitSigAbsent(),
+		{BlockIDFlag: types.BlockIDFlagNil, ValidatorAddress: vals.Validators[1].Address, Timestamp: time.Now()},
+	}}, vals)
+	assert.InDelta(t, 1, absent.value(addr0), 0)
+	assert.InDelta(t, 1, nilVote.value(addr1), 0)
+
+	// The second validator leaves the window.
+	newVals := types.NewValidatorSet([]*types.Validator{vals.Validators[0]})
+	cs.addLivenessCommit(&types.Commit{Height: 2, Signatures: []types.CommitSig{
+		types.NewCommitSigAbsent(),
+	}}, newVals)
+	assert.InDelta(t, 1, absent.value(addr0), 0)
+	assert.InDelta(t, 0, nilVote.value(addr1), 0)
+}
+
+// labeledGauge is a metrics.Gauge recording the values set for each label
+// value.
+type labeledGauge struct {
+	mtx    cmtsync.Mutex
+	values map[string]float64
+	parent *labeledGauge
+	label  string
+}
+
+func (g *labeledGauge) With(labelValues ...string) metrics.Gauge {
+	return &labeledGauge{parent: g, label: strings.Join(labelValues, ",")}
+}
+
+func (g *labeledGauge) Set(value float64) {
+	g.parent.mtx.Lock()
+	defer g.parent.mtx.Unlock()
+	if g.parent.values == nil {
+		g.parent.values = make(map[string]float64)
+	}
+	g.parent.values[g.label] = value
+}
+
+func (g *labeledGauge) Add(delta float64) {
+	g.parent.mtx.Lock()
+	defer g.parent.mtx.Unlock()
+	if g.parent.values == nil {
+		g.parent.values = make(map[string]float64)
+	}
+	g.parent.values[g.label] += delta
+}
+
+func (g *labeledGauge) value(addr string) float64 {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.values["validator_address,"+addr]
+}
+
+// haltingApp blocks forever in PrepareProposal at the given height, so that
+// tests can inspect a state machine which committed the previous heights
+// without stopping it while it runs.
+type haltingApp struct {
+	*kvstore.Application
+
+	height int64
+	halted chan struct{} // closed once the state machine is blocked
+}
+
+func newHaltingApp(height int64) *haltingApp {
+	return &haltingApp{
+		Application: kvstore.NewInMemoryApplication(),
+		height:      height,
+		halted:      make(chan struct{}),
+	}
+}
+
+func (app *haltingApp) PrepareProposal(
+	ctx context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	if req.Height == app.height {
+		close(app.halted)
+		select {}
+	}
+	return app.Application.PrepareProposal(ctx, req)
+}
+
+// prepareProposalHeightsApp records the heights of the PrepareProposal calls,
+// and sends them to prepared.
+type prepareProposalHeightsApp struct {
+	*kvstore.Application
+
+	mtx      cmtsync.Mutex
+	heights  []int64
+	prepared chan int64
+}
+
+func newPrepareProposalHeightsApp() *prepareProposalHeightsApp {
+	return &prepareProposalHeightsApp{
+		Application: kvstore.NewInMemoryApplication(),
+		prepared:    make(chan int64, 100),
+	}
+}
+
+func (app *prepareProposalHeightsApp) PrepareProposal(
+	ctx context.Context,
+	req *abci.PrepareProposalRequest,
+) (*abci.PrepareProposalResponse, error) {
+	app.mtx.Lock()
+	app.heights = append(app.heights, req.Height)
+	app.mtx.Unlock()
+	select {
+	case app.prepared <- req.Height:
+	default:
+	}
+	return app.Application.PrepareProposal(ctx, req)
+}
+
+func (app *prepareProposalHeightsApp) Heights() []int64 {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return slices.Clone(app.heights)
+}
+
+// ensurePrepareProposal waits for PrepareProposal to be called at height, and
+// fails if a new round starts first.
+func ensurePrepareProposal(
+	t *testing.T,
+	app *prepareProposalHeightsApp,
+	newRoundCh <-chan cmtpubsub.Message,
+	height int64,
+) {
+	t.Helper()
+	for {
+		select {
+		case h := <-app.prepared:
+			if h == height {
+				return
+			}
+		case msg := <-newRoundCh:
+			t.Fatalf("new round started before PrepareProposal was called at height %d: %v", height, msg.Data())
+		case <-time.After(ensureTimeout):
+			t.Fatalf("timeout expired while waiting for PrepareProposal at height %d", height)
+		}
+	}
+}
+
+// TestStatePipelineProposals tests that, with pipelined proposals, the
+// proposer of the next height calls PrepareProposal during timeout_commit, and
+// that the block it creates then is proposed.
+func TestStatePipelineProposals(t *testing.T) {
+	app := newPrepareProposalHeightsApp()
+	cs1, _ := randStateWithApp(1, app)
+	cfg := *cs1.config
+	cs1.config = &cfg
+	cfg.PipelineProposals = true
+	cfg.SkipTimeoutCommit = false
+	cfg.TimeoutCommit = ensureTimeout * 3 / 4
+	height := cs1.Height
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	require.NoError(t, cs1.Start())
+	defer func() {
+		require.NoError(t, cs1.Stop())
+		cs1.Wait()
+	}()
+	ensureNewRound(newRoundCh, height, 0)
+	ensureNewBlock(newBlockCh, height)
+
+	// The proposal block of the next height is created during timeout_commit,
+	// before the next round starts.
+	ensurePrepareProposal(t, app, newRoundCh, height+1)
+
+	ensureNewRound(newRoundCh, height+1, 0)
+	ensureNewBlock(newBlockCh, height+1)
+	// PrepareProposal was not called again for this height.
+	heights := app.Heights()
+	assert.Equal(t, []int64{height, height + 1}, heights[:2])
+	assert.NotContains(t, heights[2:], height+1)
+}
+
+// TestStatePipelineProposalsLatePrecommits tests that, with pipelined
+// proposals and 4 validators, the block created during timeout_commit is
+// proposed even if precommits for the last block are received after it was
+// created.
+func TestStatePipelineProposalsLatePrecommits(t *testing.T) {
+	app := newPrepareProposalHeightsApp()
+	cs1, vss := randStateWithApp(4, app)
+	cfg := *cs1.config
+	cs1.config = &cfg
+	cfg.PipelineProposals = true
+	cfg.SkipTimeoutCommit = false
+	cfg.TimeoutCommit = ensureTimeout * 3 / 4
+	height := cs1.Height
+	addr := cs1.privValidatorPubKey.Address()
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	require.NoError(t, cs1.Start())
+	defer func() {
+		require.NoError(t, cs1.Stop())
+		cs1.Wait()
+	}()
+
+	// ensureProposal waits for the complete proposal of round 0, made by
+	// another validator if cs1 is not the proposer.
+	ensureProposal := func() types.BlockID {
+		ensureNewRound(newRoundCh, height, 0)
+		cs1.mtx.RLock()
+		proposer := cs1.Validators.GetProposer().Address
+		idx, _ := cs1.Validators.GetByAddress(proposer)
+		cs1.mtx.RUnlock()
+		if !bytes.Equal(proposer, addr) {
+			proposal, block := decideProposal(context.Background(), t, cs1, vss[idx], height, 0)
+			parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+			require.NoError(t, err)
+			require.NoError(t, cs1.SetProposalAndBlock(proposal, block, parts, "some peer"))
+		}
+		ensureNewProposal(proposalCh, height, 0)
+		rs := cs1.GetRoundState()
+		return types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+	}
+
+	// Commit blocks until cs1 is the proposer of the next height.
+	for {
+		cs1.mtx.RLock()
+		nextProposer := cs1.Validators.CopyIncrementProposerPriority(1).GetProposer().Address
+		cs1.mtx.RUnlock()
+		if bytes.Equal(nextProposer, addr) {
+			break
+		}
+		blockID := ensureProposal()
+		signAddVotes(cs1, types.PrevoteType, blockID.Hash, blockID.PartSetHeader, false, vss[1:]...)
+		signAddVotes(cs1, types.PrecommitType, blockID.Hash, blockID.PartSetHeader, true, vss[1:]...)
+		ensureNewBlock(newBlockCh, height)
+		incrementHeight(vss[1:]...)
+		height++
+	}
+
+	// The block is committed with the precommits of cs1, vs2 and vs3.
+	blockID := ensureProposal()
+	signAddVotes(cs1, types.PrevoteType, blockID.Hash, blockID.PartSetHeader, false, vss[1:]...)
+	signAddVotes(cs1, types.PrecommitType, blockID.Hash, blockID.PartSetHeader, true, vss[1:3]...)
+	ensureNewBlock(newBlockCh, height)
+
+	// The proposal block of the next height is created during timeout_commit,
+	// then the precommit of vs4 is received. A vote event is only published
+	// for it if it is added to the last commit, during timeout_commit.
+	ensurePrepareProposal(t, app, newRoundCh, height+1)
+	pv4, err := vss[3].GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pv4.Address())
+	signAddVotes(cs1, types.PrecommitType, blockID.Hash, blockID.PartSetHeader, true, vss[3])
+	ensurePrecommit(voteCh, height, 0)
+
+	// The block created during timeout_commit is proposed, with the last
+	// commit it was created with.
+	height++
+	ensureNewRound(newRoundCh, height, 0)
+	ensureNewProposal(proposalCh, height, 0)
+	rs := cs1.GetRoundState()
+	assert.Len(t, slices.DeleteFunc(slices.Clone(rs.ProposalBlock.LastCommit.Signatures), func(sig types.CommitSig) bool {
+		return sig.BlockIDFlag == types.BlockIDFlagAbsent
+	}), 3)
+	heights := app.Heights()
+	assert.Equal(t, 1, len(slices.DeleteFunc(heights, func(h int64) bool { return h != height })),
+		"PrepareProposal must be called once at height %d", height)
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	const maxBytes = int64(types.BlockPartSizeBytes)
 
@@ -2207,7 +2723,7 @@ func TestVerifyVoteExtensionNotCalledOnAbsentPrecommit(t *testing.T) {
 	m.On("Commit", mock.Anything, mock.Anything).Return(&abci.CommitResponse{}, nil).Maybe()
 	cs1, vss := randStateWithApp(4, m)
 	height, round := cs1.Height, cs1.Round
-	cs1.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = cs1.Height
+	cs1.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = cs1.Height //nolint:staticcheck
 
 	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
 	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
@@ -2509,7 +3025,7 @@ func TestVoteExtensionEnableHeight(t *testing.T) {
 			m.On("FinalizeBlock", mock.Anything, mock.Anything).Return(&abci.FinalizeBlockResponse{}, nil).Maybe()
 			m.On("Commit", mock.Anything, mock.Anything).Return(&abci.CommitResponse{}, nil).Maybe()
 			cs1, vss := randStateWithAppWithHeight(numValidators, m, testCase.enableHeight)
-			cs1.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.enableHeight
+			cs1.state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.enableHeight //nolint:staticcheck
 			height, round := cs1.Height, cs1.Round
 
 			timeoutCh := subscribe(cs1.eventBus, types.EventQueryTimeoutPropose)
@@ -2645,6 +3161,132 @@ func TestRoundSkipOnNilPolkaFromHigherRound(t *testing.T) {
 	ensureNewRound(newRoundCh, height, round)
 }
 
+// 4 vals, P0 lagging behind in round 0.
+// What we want:
+// P0 does not skip rounds on the votes of a single validator, nor on +1/3 of
+// the voting power spread over several higher rounds. It skips to round 2 as
+// soon as it has votes from +1/3 of the voting power in round 2.
+func TestRoundSkipOnOneThirdFromHigherRound(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	addr := pv1.Address()
+	voteCh := subscribeToVoter(cs1, addr)
+
+	// start round
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	ensurePrevote(voteCh, height, round)
+
+	// vs2 is in round 1, vs3 and vs4 in round 2
+	incrementRound(vs2, vs3, vs4)
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs2)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	incrementRound(vs3, vs4)
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs3)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs4)
+	round += 2 // skipping to round 2
+	ensureNewRound(newRoundCh, height, round)
+
+	// P0 is not the proposer of round 2, and prevotes nil once timeoutPropose expires
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+}
+
+// 4 vals, P0 lagging behind in round 0, a Prevote and a Precommit from
+// different validators in round 1.
+// What we want:
+// P0 counts the validators that voted in round 1, whatever the type of their
+// vote, and skips to round 1.
+func TestRoundSkipOnOneThirdMixedVotesFromHigherRound(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3 := vss[1], vss[2]
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	addr := pv1.Address()
+	voteCh := subscribeToVoter(cs1, addr)
+
+	// start round
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	ensurePrevote(voteCh, height, round)
+
+	incrementRound(vs2, vs3)
+	signAddVotes(cs1, types.PrecommitType, nil, types.PartSetHeader{}, true, vs2)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	// a second vote of vs2 in round 1 does not count twice
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs2)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs3)
+	round++ // skipping to round 1
+	ensureNewRound(newRoundCh, height, round)
+
+	rs := cs1.GetRoundState()
+	assert.Equal(t, round, rs.Round)
+	assert.Equal(t, cstypes.RoundStepPropose, rs.Step)
+}
+
+// 4 vals, P0 in the commit step for a block of round 0 it has not received
+// yet, then votes from +1/3 of the voting power in round 2.
+// What we want:
+// P0 stays in the commit step and finalizes the block once it receives it.
+func TestRoundSkipNotInCommitStep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, int32(1)
+
+	partSize := types.BlockPartSizeBytes
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	validBlockCh := subscribe(cs1.eventBus, types.EventQueryValidBlock)
+
+	prop, propBlock := decideProposal(ctx, t, cs1, vs2, vs2.Height, vs2.Round)
+	propBlockHash := propBlock.Hash()
+	propBlockParts, err := propBlock.MakePartSet(partSize)
+	require.NoError(t, err)
+
+	// start round in which PO is not proposer
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// vs2, vs3 and vs4 send precommit for propBlock for round 0
+	signAddVotes(cs1, types.PrecommitType, propBlockHash, propBlockParts.Header(), true, vs2, vs3, vs4)
+	ensureNewValidBlock(validBlockCh, height, round)
+
+	// vs2 and vs3 time out of round 0 and move on to round 2
+	incrementRound(vs2, vs3)
+	incrementRound(vs2, vs3)
+	signAddVotes(cs1, types.PrevoteType, nil, types.PartSetHeader{}, false, vs2, vs3)
+	ensureNoNewEventOnChannel(newRoundCh)
+
+	rs := cs1.GetRoundState()
+	assert.Equal(t, cstypes.RoundStepCommit, rs.Step)
+	assert.Equal(t, round, rs.Round)
+	assert.True(t, rs.ProposalBlockParts.Header().Equals(propBlockParts.Header()))
+
+	err = cs1.SetProposalAndBlock(prop, propBlock, propBlockParts, "some peer")
+	require.NoError(t, err)
+
+	ensureNewRound(newRoundCh, height+1, 0)
+}
+
 // 4 vals, 3 Prevotes for nil in the current round.
 // What we want:
 // P0 wait for timeoutPropose to expire before sending prevote.
@@ -3057,26 +3699,26 @@ func TestStateOutputsBlockPartsStats(t *testing.T) {
 	}
 
 	cs.ProposalBlockParts = types.NewPartSetFromHeader(parts.Header())
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{msg, peer.ID(), time.Time{}})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, msg, statsMessage.Msg, "")
 	require.Equal(t, peer.ID(), statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(msgInfo{msg, "peer2"})
+	cs.handleMsg(msgInfo{msg, "peer2", time.Time{}})
 
 	// sending the part with the same height, but different round
 	msg.Round = 1
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{msg, peer.ID(), time.Time{}})
 
 	// sending the part from the smaller height
 	msg.Height = 0
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{msg, peer.ID(), time.Time{}})
 
 	// sending the part from the bigger height
 	msg.Height = 3
-	cs.handleMsg(msgInfo{msg, peer.ID()})
+	cs.handleMsg(msgInfo{msg, peer.ID(), time.Time{}})
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -3095,20 +3737,20 @@ func TestStateOutputVoteStats(t *testing.T) {
 	vote := signVote(vss[1], types.PrecommitType, randBytes, types.PartSetHeader{}, true)
 
 	voteMessage := &VoteMessage{vote}
-	cs.handleMsg(msgInfo{voteMessage, peer.ID()})
+	cs.handleMsg(msgInfo{voteMessage, peer.ID(), time.Time{}})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, voteMessage, statsMessage.Msg, "")
 	require.Equal(t, peer.ID(), statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(msgInfo{&VoteMessage{vote}, "peer2"})
+	cs.handleMsg(msgInfo{&VoteMessage{vote}, "peer2", time.Time{}})
 
 	// sending the vote for the bigger height
 	incrementHeight(vss[1])
 	vote = signVote(vss[1], types.PrecommitType, randBytes, types.PartSetHeader{}, true)
 
-	cs.handleMsg(msgInfo{&VoteMessage{vote}, peer.ID()})
+	cs.handleMsg(msgInfo{&VoteMessage{vote}, peer.ID(), time.Time{}})
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -45,6 +45,7 @@ type HeightVoteSet struct {
 	round             int32                  // max tracked round
 	roundVoteSets     map[int32]RoundVoteSet // keys: [0...round]
 	peerCatchupRounds map[p2p.ID][]int32     // keys: peer.ID; values: at most 2 rounds
+	roundAnyPower     map[int32]int64        // keys: round; values: power of the validators that voted in it
 }
 
 func NewHeightVoteSet(chainID string, height int64, valSet *types.ValidatorSet) *HeightVoteSet {
@@ -73,6 +74,7 @@ func (hvs *HeightVoteSet) Reset(height int64, valSet *types.ValidatorSet) {
 	hvs.valSet = valSet
 	hvs.roundVoteSets = make(map[int32]RoundVoteSet)
 	hvs.peerCatchupRounds = make(map[p2p.ID][]int32)
+	hvs.roundAnyPower = make(map[int32]int64)
 
 	hvs.addRound(0)
 	hvs.round = 0
@@ -149,9 +151,29 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID p2p.ID, extEnabled bo
 		hvs.peerCatchupRounds[peerID] = append(rndz, vote.Round)
 	}
 	added, err = voteSet.AddVote(vote)
+	if added {
+		hvs.tallyAnyVote(vote)
+	}
 	return
 }
 
+// tallyAnyVote adds the voting power of the vote's validator to the tally of
+// its round, unless the validator already voted in it with the other vote type.
+func (hvs *HeightVoteSet) tallyAnyVote(vote *types.Vote) {
+	otherType := types.PrecommitType
+	if vote.Type == types.PrecommitType {
+		otherType = types.PrevoteType
+	}
+	if hvs.getVoteSet(vote.Round, otherType).GetByIndex(vote.ValidatorIndex) != nil {
+		return
+	}
+	_, val := hvs.valSet.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return
+	}
+	hvs.roundAnyPower[vote.Round] += val.VotingPower
+}
+
 func (hvs *HeightVoteSet) Prevotes(round int32) *types.VoteSet {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -179,6 +201,15 @@ func (hvs *HeightVoteSet) POLInfo() (polRound int32, polBlockID types.BlockID) {
 	return -1, types.BlockID{}
 }
 
+// HasOneThirdAny returns true if validators with more than 1/3 of the voting
+// power have voted in the given round, whether they prevoted or precommitted,
+// and for whatever block. Each validator is counted once.
+func (hvs *HeightVoteSet) HasOneThirdAny(round int32) bool {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	return hvs.roundAnyPower[round] > hvs.valSet.TotalVotingPower()/3
+}
+
 func (hvs *HeightVoteSet) getVoteSet(round int32, voteType types.SignedMsgType) *types.VoteSet {
 	rvs, ok := hvs.roundVoteSets[round]
 	if !ok {
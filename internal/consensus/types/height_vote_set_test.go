@@ -72,6 +72,43 @@ func TestInconsistentExtensionData(t *testing.T) {
 	})
 }
 
+func TestHasOneThirdAny(t *testing.T) {
+	valSet, privVals := types.RandValidatorSet(4, 1)
+
+	hvs := NewHeightVoteSet(test.DefaultTestChainID, 1, valSet)
+	hvs.SetRound(1)
+	require.False(t, hvs.HasOneThirdAny(1))
+
+	addVote := func(valIndex int32, voteType types.SignedMsgType) {
+		t.Helper()
+		vote, err := types.MakeVote(
+			privVals[valIndex],
+			test.DefaultTestChainID,
+			valIndex,
+			1,
+			1,
+			voteType,
+			types.BlockID{},
+			cmttime.Now(),
+		)
+		require.NoError(t, err)
+		added, err := hvs.AddVote(vote, "peer1", false)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+
+	addVote(0, types.PrevoteType)
+	require.False(t, hvs.HasOneThirdAny(1))
+
+	// the second vote of a validator in the round does not count twice
+	addVote(0, types.PrecommitType)
+	require.False(t, hvs.HasOneThirdAny(1))
+
+	addVote(1, types.PrecommitType)
+	require.True(t, hvs.HasOneThirdAny(1))
+	require.False(t, hvs.HasOneThirdAny(0))
+}
+
 func makeVoteHR(
 	round int32,
 	privVals []types.PrivValidator,
@@ -25,6 +25,9 @@ type PeerRoundState struct {
 	ProposalBlockPartSetHeader types.PartSetHeader `json:"proposal_block_part_set_header"`
 	// This bit array is length(# of block parts)
 	ProposalBlockParts *bits.BitArray `json:"proposal_block_parts"`
+	// When the proposal block was sent to the peer as a compact block. Zero
+	// if it was not.
+	CompactBlockSent time.Time `json:"-"`
 	// Proposal's POL round. -1 if none.
 	ProposalPOLRound int32 `json:"proposal_pol_round"`
 
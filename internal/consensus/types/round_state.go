@@ -80,6 +80,10 @@ type RoundState struct {
 	LockedBlock        *types.Block        `json:"locked_block"`
 	LockedBlockParts   *types.PartSet      `json:"locked_block_parts"`
 
+	// Local time at which Proposal was received, used to check its
+	// timeliness when proposer-based timestamps are enabled.
+	ProposalReceiveTime time.Time `json:"proposal_receive_time"`
+
 	// The variables below starting with "Valid..." derive their name from
 	// the algorithm presented in this paper:
 	// [The latest gossip on BFT consensus](https://arxiv.org/abs/1807.04938).
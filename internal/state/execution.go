@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -45,6 +46,19 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// records when transactions are committed, or nil
+	txTracer *types.TxTracer
+
+	// proposal block being created ahead of time, or nil
+	speculationMtx sync.Mutex
+	speculation    *proposalSpeculation
+
+	// execute the proposals accepted by ProcessProposal before they are
+	// decided, and the last such execution, or nil
+	optimisticExecution bool
+	optimisticMtx       sync.Mutex
+	optimistic          *optimisticExecution
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -61,6 +75,27 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+func BlockExecutorWithTxTracer(tracer *types.TxTracer) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.txTracer = tracer
+	}
+}
+
+// BlockExecutorWithOptimisticExecution makes the BlockExecutor call
+// FinalizeBlock for a proposal as soon as ProcessProposal accepts it, while
+// the votes for it are being gathered, and use the response in ApplyBlock if
+// the proposal is decided.
+//
+// The application must then support FinalizeBlock being called for a block
+// that is not decided, before ExtendVote and VerifyVoteExtension are called
+// at its height, and more than once per height: only the last call before
+// Commit is for the decided block.
+func BlockExecutorWithOptimisticExecution(enabled bool) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.optimisticExecution = enabled
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -105,6 +140,9 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // The block space is first allocated to outstanding evidence.
 // The rest is given to txs, up to the max gas.
 //
+// If a block was created ahead of time by SpeculateProposalBlock from the same
+// inputs, it is returned instead.
+//
 // Contract: application will not return more bytes than are sent over the wire.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	ctx context.Context,
@@ -112,6 +150,20 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	state State,
 	lastExtCommit *types.ExtendedCommit,
 	proposerAddr []byte,
+) (*types.Block, error) {
+	blockExec.waitForOptimisticExecution()
+	if block, ok := blockExec.speculativeProposalBlock(ctx, height, state, lastExtCommit, proposerAddr); ok {
+		return block, nil
+	}
+	return blockExec.createProposalBlock(ctx, height, state, lastExtCommit, proposerAddr)
+}
+
+func (blockExec *BlockExecutor) createProposalBlock(
+	ctx context.Context,
+	height int64,
+	state State,
+	lastExtCommit *types.ExtendedCommit,
+	proposerAddr []byte,
 ) (*types.Block, error) {
 	maxBytes := state.ConsensusParams.Block.MaxBytes
 	emptyMaxBytes := maxBytes == -1
@@ -124,7 +176,7 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	evidence, evSize := blockExec.evpool.PendingEvidence(state.ConsensusParams.Evidence.MaxBytes)
 
 	// Fetch a limited amount of valid txs
-	maxDataBytes := types.MaxDataBytes(maxBytes, evSize, state.Validators.Size())
+	maxDataBytes := types.MaxDataBytesForValidators(maxBytes, evSize, state.Validators)
 	maxReapBytes := maxDataBytes
 	if emptyMaxBytes {
 		maxReapBytes = -1
@@ -138,7 +190,7 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 		&abci.PrepareProposalRequest{
 			MaxTxBytes:         maxDataBytes,
 			Txs:                block.Txs.ToSliceOfBytes(),
-			LocalLastCommit:    buildExtendedCommitInfoFromStore(lastExtCommit, blockExec.store, state.InitialHeight, state.ConsensusParams.ABCI),
+			LocalLastCommit:    buildExtendedCommitInfoFromStore(lastExtCommit, blockExec.store, state.InitialHeight, state.ConsensusParams.Features()),
 			Misbehavior:        block.Evidence.Evidence.ToABCI(),
 			Height:             block.Height,
 			Time:               block.Time,
@@ -163,13 +215,160 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 		return nil, err
 	}
 
-	return state.MakeBlock(height, txl, commit, evidence, proposerAddr), nil
+	// The block keeps the time given to the application.
+	return state.makeBlock(height, txl, commit, evidence, proposerAddr, block.Time), nil
+}
+
+// proposalSpeculation is a proposal block created ahead of time, with the
+// inputs it is created from.
+type proposalSpeculation struct {
+	height       int64
+	lastBlockID  types.BlockID
+	lastCommit   *types.Commit
+	proposerAddr []byte
+
+	done  chan struct{} // closed once block and err are set
+	block *types.Block
+	err   error
+}
+
+// matches returns true if the block can be proposed given these inputs. As
+// precommits for the last block keep arriving during timeout_commit, the last
+// commit may have more signatures than the one the block was created with,
+// which remains a valid last commit for the block.
+func (s *proposalSpeculation) matches(
+	height int64,
+	state State,
+	lastExtCommit *types.ExtendedCommit,
+	proposerAddr []byte,
+) bool {
+	return s.height == height &&
+		s.lastBlockID.Equals(state.LastBlockID) &&
+		isSubCommit(s.lastCommit, lastExtCommit.ToCommit()) &&
+		bytes.Equal(s.proposerAddr, proposerAddr)
+}
+
+// isSubCommit returns true if commit has all the signatures of sub, for the
+// same block and round.
+func isSubCommit(sub, commit *types.Commit) bool {
+	if sub.Height != commit.Height || sub.Round != commit.Round ||
+		!sub.BlockID.Equals(commit.BlockID) || len(sub.Signatures) != len(commit.Signatures) {
+		return false
+	}
+	for i, sig := range sub.Signatures {
+		if sig.BlockIDFlag == types.BlockIDFlagAbsent {
+			continue
+		}
+		other := commit.Signatures[i]
+		if sig.BlockIDFlag != other.BlockIDFlag || !sig.Timestamp.Equal(other.Timestamp) ||
+			!bytes.Equal(sig.Signature, other.Signature) {
+			return false
+		}
+	}
+	return true
+}
+
+// SpeculateProposalBlock starts creating the proposal block at height in the
+// background, so that PrepareProposal runs while the previous block is in
+// timeout_commit. The next call to CreateProposalBlock returns this block if
+// it is given the same inputs, or a last commit with more signatures, and
+// discards it otherwise; the transactions and evidence are not compared.
+// The other calls to the application wait for the block to be created.
+//
+// It does nothing at heights where PBTS is enabled, as the time of a
+// proposal block is then the time at which it is created.
+func (blockExec *BlockExecutor) SpeculateProposalBlock(
+	height int64,
+	state State,
+	lastExtCommit *types.ExtendedCommit,
+	proposerAddr []byte,
+) {
+	if state.ConsensusParams.Feature.PBTSEnabled(height) {
+		return
+	}
+	s := &proposalSpeculation{
+		height:       height,
+		lastBlockID:  state.LastBlockID,
+		lastCommit:   lastExtCommit.ToCommit(),
+		proposerAddr: proposerAddr,
+		done:         make(chan struct{}),
+	}
+	state = state.Copy()
+
+	blockExec.speculationMtx.Lock()
+	prev := blockExec.speculation
+	blockExec.speculation = s
+	optimistic := blockExec.optimisticExecutionInProgress()
+	blockExec.speculationMtx.Unlock()
+
+	go func() {
+		defer close(s.done)
+		// Calls to the application are not concurrent.
+		if prev != nil {
+			<-prev.done
+		}
+		if optimistic != nil {
+			<-optimistic.done
+		}
+		s.block, s.err = blockExec.createProposalBlock(context.Background(), height, state, lastExtCommit, proposerAddr)
+	}()
+}
+
+// speculativeProposalBlock returns the block created by SpeculateProposalBlock
+// if it was created from the given inputs, and discards it in any case.
+func (blockExec *BlockExecutor) speculativeProposalBlock(
+	ctx context.Context,
+	height int64,
+	state State,
+	lastExtCommit *types.ExtendedCommit,
+	proposerAddr []byte,
+) (*types.Block, bool) {
+	blockExec.speculationMtx.Lock()
+	s := blockExec.speculation
+	blockExec.speculation = nil
+	blockExec.speculationMtx.Unlock()
+	if s == nil {
+		return nil, false
+	}
+
+	// Wait for it even if it is discarded, as calls to the application are
+	// not concurrent.
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return nil, false
+	}
+	switch {
+	case !s.matches(height, state, lastExtCommit, proposerAddr):
+		blockExec.logger.Debug("discarding speculative proposal block; inputs changed", "height", height)
+	case s.err != nil:
+		blockExec.logger.Error("discarding speculative proposal block", "height", height, "err", s.err)
+	default:
+		blockExec.metrics.SpeculativeProposalBlocks.With("status", "used").Add(1)
+		return s.block, true
+	}
+	blockExec.metrics.SpeculativeProposalBlocks.With("status", "discarded").Add(1)
+	return nil, false
+}
+
+// waitForSpeculation waits for the proposal block being created by
+// SpeculateProposalBlock, if any, as calls to the application are not
+// concurrent. The block is kept for CreateProposalBlock.
+func (blockExec *BlockExecutor) waitForSpeculation() {
+	blockExec.speculationMtx.Lock()
+	s := blockExec.speculation
+	blockExec.speculationMtx.Unlock()
+	if s != nil {
+		<-s.done
+	}
 }
 
 func (blockExec *BlockExecutor) ProcessProposal(
 	block *types.Block,
 	state State,
 ) (bool, error) {
+	blockExec.waitForSpeculation()
+	blockExec.waitForOptimisticExecution()
 	resp, err := blockExec.proxyApp.ProcessProposal(context.TODO(), &abci.ProcessProposalRequest{
 		Hash:               block.Header.Hash(),
 		Height:             block.Header.Height,
@@ -187,9 +386,129 @@ func (blockExec *BlockExecutor) ProcessProposal(
 		panic("ProcessProposal responded with status " + resp.Status.String())
 	}
 
+	if resp.IsAccepted() && blockExec.optimisticExecution {
+		blockExec.executeOptimistically(state, block)
+	}
 	return resp.IsAccepted(), nil
 }
 
+// optimisticExecution is the execution of a block before it is decided.
+type optimisticExecution struct {
+	blockHash []byte
+
+	done           chan struct{} // closed once resp, processingTime and err are set
+	resp           *abci.FinalizeBlockResponse
+	processingTime time.Duration
+	err            error
+}
+
+// executeOptimistically starts executing block in the background, replacing
+// the previous optimistic execution.
+func (blockExec *BlockExecutor) executeOptimistically(state State, block *types.Block) {
+	e := &optimisticExecution{
+		blockHash: block.Hash(),
+		done:      make(chan struct{}),
+	}
+
+	// Holding speculationMtx orders the start of the execution with the
+	// one of the speculative proposal blocks, so that each only waits for
+	// the other if it started before.
+	blockExec.speculationMtx.Lock()
+	speculation := blockExec.speculation
+	blockExec.optimisticMtx.Lock()
+	prev := blockExec.optimistic
+	blockExec.optimistic = e
+	blockExec.optimisticMtx.Unlock()
+	blockExec.speculationMtx.Unlock()
+
+	go func() {
+		defer close(e.done)
+		// Calls to the application are not concurrent.
+		if prev != nil {
+			<-prev.done
+		}
+		if speculation != nil {
+			<-speculation.done
+		}
+		e.resp, e.processingTime, e.err = blockExec.finalizeBlock(state.InitialHeight, block)
+	}()
+}
+
+// optimisticExecutionInProgress returns the last optimistic execution, which
+// may be done, or nil.
+func (blockExec *BlockExecutor) optimisticExecutionInProgress() *optimisticExecution {
+	blockExec.optimisticMtx.Lock()
+	defer blockExec.optimisticMtx.Unlock()
+	return blockExec.optimistic
+}
+
+// waitForOptimisticExecution waits for the optimistic execution of a block,
+// if any, as calls to the application are not concurrent. Its response is
+// kept for ApplyBlock.
+func (blockExec *BlockExecutor) waitForOptimisticExecution() {
+	if e := blockExec.optimisticExecutionInProgress(); e != nil {
+		<-e.done
+	}
+}
+
+// finalizeBlockResponse returns the response of the application to
+// FinalizeBlock for block, reusing the one of its optimistic execution if
+// there is one. The processing time of the response is recorded, but not the
+// one of discarded optimistic executions.
+func (blockExec *BlockExecutor) finalizeBlockResponse(state State, block *types.Block) (*abci.FinalizeBlockResponse, error) {
+	blockExec.optimisticMtx.Lock()
+	e := blockExec.optimistic
+	blockExec.optimistic = nil
+	blockExec.optimisticMtx.Unlock()
+
+	if e != nil {
+		// Wait for it even if it is discarded, as calls to the application
+		// are not concurrent.
+		<-e.done
+		switch {
+		case !bytes.Equal(e.blockHash, block.Hash()):
+			blockExec.logger.Debug("discarding optimistic execution of another block", "height", block.Height)
+		case e.err != nil:
+			blockExec.logger.Error("discarding failed optimistic execution", "height", block.Height, "err", e.err)
+		default:
+			blockExec.metrics.OptimisticExecutions.With("status", "used").Add(1)
+			blockExec.observeBlockProcessingTime(e.processingTime)
+			return e.resp, nil
+		}
+		blockExec.metrics.OptimisticExecutions.With("status", "discarded").Add(1)
+	}
+	blockExec.waitForSpeculation()
+	resp, processingTime, err := blockExec.finalizeBlock(state.InitialHeight, block)
+	blockExec.observeBlockProcessingTime(processingTime)
+	return resp, err
+}
+
+func (blockExec *BlockExecutor) observeBlockProcessingTime(d time.Duration) {
+	blockExec.metrics.BlockProcessingTime.Observe(float64(d.Nanoseconds()) / 1000000)
+}
+
+// finalizeBlock calls FinalizeBlock for block, and returns the response with
+// the time the application took to process it. The caller must wait for the
+// other calls to the application.
+func (blockExec *BlockExecutor) finalizeBlock(
+	initialHeight int64,
+	block *types.Block,
+) (*abci.FinalizeBlockResponse, time.Duration, error) {
+	startTime := time.Now().UnixNano()
+	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, initialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	})
+	endTime := time.Now().UnixNano()
+	return abciResponse, time.Duration(endTime - startTime), err
+}
+
 // ValidateBlock validates the given block against the given state.
 // If the block is invalid, it returns an error.
 // Validation does not mutate state, but does require historical information from the stateDB,
@@ -226,19 +545,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block) (State, error) {
-	startTime := time.Now().UnixNano()
-	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.FinalizeBlockRequest{
-		Hash:               block.Hash(),
-		NextValidatorsHash: block.NextValidatorsHash,
-		ProposerAddress:    block.ProposerAddress,
-		Height:             block.Height,
-		Time:               block.Time,
-		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
-		Misbehavior:        block.Evidence.Evidence.ToABCI(),
-		Txs:                block.Txs.ToSliceOfBytes(),
-	})
-	endTime := time.Now().UnixNano()
-	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	abciResponse, err := blockExec.finalizeBlockResponse(state, block)
 	if err != nil {
 		blockExec.logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -297,6 +604,7 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 	if err != nil {
 		return state, fmt.Errorf("commit failed for application: %w", err)
 	}
+	blockExec.txTracer.Committed(block.Txs, block.Height)
 
 	// Update evpool with the latest state.
 	blockExec.evpool.Update(state, block.Evidence.Evidence)
@@ -338,6 +646,8 @@ func (blockExec *BlockExecutor) ExtendVote(
 	if vote.Height != block.Height {
 		panic(fmt.Sprintf("vote's and block's heights do not match %d!=%d", block.Height, vote.Height))
 	}
+	blockExec.waitForSpeculation()
+	blockExec.waitForOptimisticExecution()
 	req := abci.ExtendVoteRequest{
 		Hash:               vote.BlockID.Hash,
 		Height:             vote.Height,
@@ -357,6 +667,8 @@ func (blockExec *BlockExecutor) ExtendVote(
 }
 
 func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *types.Vote) error {
+	blockExec.waitForSpeculation()
+	blockExec.waitForOptimisticExecution()
 	req := abci.VerifyVoteExtensionRequest{
 		Hash:             vote.BlockID.Hash,
 		ValidatorAddress: vote.ValidatorAddress,
@@ -495,7 +807,7 @@ func BuildLastCommitInfo(block *types.Block, lastValSet *types.ValidatorSet, ini
 // data, it returns an empty record.
 //
 // Assumes that the commit signatures are sorted according to validator index.
-func buildExtendedCommitInfoFromStore(ec *types.ExtendedCommit, store Store, initialHeight int64, ap types.ABCIParams) abci.ExtendedCommitInfo {
+func buildExtendedCommitInfoFromStore(ec *types.ExtendedCommit, store Store, initialHeight int64, vp types.VoteExtensionsParams) abci.ExtendedCommitInfo {
 	if ec.Height < initialHeight {
 		// There are no extended commits for heights below the initial height.
 		return abci.ExtendedCommitInfo{}
@@ -506,13 +818,13 @@ func buildExtendedCommitInfoFromStore(ec *types.ExtendedCommit, store Store, ini
 		panic(fmt.Errorf("failed to load validator set at height %d, initial height %d: %w", ec.Height, initialHeight, err))
 	}
 
-	return BuildExtendedCommitInfo(ec, valSet, initialHeight, ap)
+	return BuildExtendedCommitInfo(ec, valSet, initialHeight, vp)
 }
 
 // BuildExtendedCommitInfo builds an ExtendedCommitInfo from the given block and validator set.
 // If you want to load the validator set from the store instead of providing it,
 // use buildExtendedCommitInfoFromStore.
-func BuildExtendedCommitInfo(ec *types.ExtendedCommit, valSet *types.ValidatorSet, initialHeight int64, ap types.ABCIParams) abci.ExtendedCommitInfo {
+func BuildExtendedCommitInfo(ec *types.ExtendedCommit, valSet *types.ValidatorSet, initialHeight int64, vp types.VoteExtensionsParams) abci.ExtendedCommitInfo {
 	if ec.Height < initialHeight {
 		// There are no extended commits for heights below the initial height.
 		return abci.ExtendedCommitInfo{}
@@ -549,7 +861,7 @@ func BuildExtendedCommitInfo(ec *types.ExtendedCommit, valSet *types.ValidatorSe
 		// during that height, we ensure they are present and deliver the data to
 		// the proposer. If they were not enabled during this previous height, we
 		// will not deliver extension data.
-		if err := ecs.EnsureExtension(ap.VoteExtensionsEnabled(ec.Height)); err != nil {
+		if err := ecs.EnsureExtension(vp.VoteExtensionsEnabled(ec.Height)); err != nil {
 			panic(fmt.Errorf("commit at height %d has problems with vote extension data; err %w", ec.Height, err))
 		}
 
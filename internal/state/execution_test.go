@@ -3,9 +3,12 @@ package state_test
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -78,6 +81,113 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// finalizeBlockHashesApp records the hashes of the blocks FinalizeBlock is
+// called for.
+type finalizeBlockHashesApp struct {
+	testApp
+
+	mtx    sync.Mutex
+	hashes [][]byte
+}
+
+func (app *finalizeBlockHashesApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	app.hashes = append(app.hashes, req.Hash)
+	app.mtx.Unlock()
+	return app.testApp.FinalizeBlock(ctx, req)
+}
+
+// observationCounter is a histogram counting its observations.
+type observationCounter struct {
+	mtx sync.Mutex
+	n   int
+}
+
+func (h *observationCounter) With(...string) metrics.Histogram { return h }
+
+func (h *observationCounter) Observe(float64) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.n++
+}
+
+// TestApplyBlockOptimisticExecution tests that, with optimistic execution,
+// the blocks accepted by ProcessProposal are executed, and that ApplyBlock
+// executes the decided block only if it was not executed last. Only the
+// processing time of the decided block is recorded.
+func TestApplyBlockOptimisticExecution(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		optimistic bool
+		processed  []int // indexes of the blocks processed, block 0 is decided
+		executed   []int // indexes of the blocks executed
+	}{
+		{"disabled", false, []int{1, 0}, []int{0}},
+		{"decided block executed last", true, []int{1, 0}, []int{1, 0}},
+		{"other block executed last", true, []int{0, 1}, []int{0, 1, 0}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &finalizeBlockHashesApp{}
+			cc := proxy.NewLocalClientCreator(app)
+			proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+			err := proxyApp.Start()
+			require.NoError(t, err)
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			state, stateDB, _ := makeState(1, 1)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: false,
+			})
+			blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+			mp := &mpmocks.Mempool{}
+			mp.On("Lock").Return()
+			mp.On("Unlock").Return()
+			mp.On("FlushAppConn", mock.Anything).Return(nil)
+			mp.On("Update",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).Return(nil)
+			processingTime := &observationCounter{}
+			metrics := sm.NopMetrics()
+			metrics.BlockProcessingTime = processingTime
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+				mp, sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithOptimisticExecution(tc.optimistic),
+				sm.BlockExecutorWithMetrics(metrics))
+
+			proposer := state.Validators.GetProposer().Address
+			blocks := []*types.Block{
+				state.MakeBlock(1, test.MakeNTxs(1, 10), new(types.Commit), nil, proposer),
+				state.MakeBlock(1, test.MakeNTxs(2, 10), new(types.Commit), nil, proposer),
+			}
+			for _, i := range tc.processed {
+				accepted, err := blockExec.ProcessProposal(blocks[i], state)
+				require.NoError(t, err)
+				require.True(t, accepted)
+			}
+
+			bps, err := blocks[0].MakePartSet(testPartSize)
+			require.NoError(t, err)
+			blockID := types.BlockID{Hash: blocks[0].Hash(), PartSetHeader: bps.Header()}
+			state, err = blockExec.ApplyBlock(state, blockID, blocks[0])
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, state.LastBlockHeight)
+
+			expected := make([][]byte, 0, len(tc.executed))
+			for _, i := range tc.executed {
+				expected = append(expected, blocks[i].Hash())
+			}
+			app.mtx.Lock()
+			defer app.mtx.Unlock()
+			assert.Equal(t, expected, app.hashes)
+			assert.Equal(t, 1, processingTime.n)
+		})
+	}
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
@@ -1017,6 +1127,260 @@ func TestPrepareProposalErrorOnPrepareProposalError(t *testing.T) {
 	mp.AssertExpectations(t)
 }
 
+// TestCreateProposalBlockSpeculation tests that CreateProposalBlock returns the
+// block created by SpeculateProposalBlock from the same inputs, or from a last
+// commit with fewer signatures, and creates a new one if the inputs differ.
+func TestCreateProposalBlockSpeculation(t *testing.T) {
+	const height = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state, stateDB, privVals := makeState(4, height)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+
+	evpool := &mocks.EvidencePool{}
+	evpool.On("PendingEvidence", mock.Anything).Return([]types.Evidence{}, int64(0))
+
+	txs := test.MakeNTxs(height, 10)
+	mp := &mpmocks.Mempool{}
+	mp.On("ReapMaxBytesMaxGas", mock.Anything, mock.Anything).Return(txs)
+
+	app := &abcimocks.Application{}
+	app.On("PrepareProposal", mock.Anything, mock.Anything).Return(&abci.PrepareProposalResponse{
+		Txs: txs.ToSliceOfBytes(),
+	}, nil)
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.NoError(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mp,
+		evpool,
+		blockStore,
+	)
+	pa, _ := state.Validators.GetByIndex(0)
+	commit, err := makeValidCommit(height, types.BlockID{}, state.Validators, privVals)
+	require.NoError(t, err)
+
+	// The speculative block is used, even if more precommits were received
+	// since it was created. It keeps the last commit it was created with.
+	partialCommit := commit.Clone()
+	partialCommit.ExtendedSignatures[3] = types.NewExtendedCommitSigAbsent()
+	blockExec.SpeculateProposalBlock(height, state, partialCommit, pa)
+	block, err := blockExec.CreateProposalBlock(ctx, height, state, commit, pa)
+	require.NoError(t, err)
+	require.Equal(t, txs, block.Txs)
+	require.Equal(t, partialCommit.ToCommit().Hash(), block.LastCommit.Hash())
+	app.AssertNumberOfCalls(t, "PrepareProposal", 1)
+
+	// It is discarded if the last commit differs.
+	blockExec.SpeculateProposalBlock(height, state, commit, pa)
+	otherCommit, err := makeValidCommit(height, types.BlockID{}, state.Validators, privVals)
+	require.NoError(t, err)
+	otherCommit.ExtendedSignatures[0].Timestamp = commit.ExtendedSignatures[0].Timestamp.Add(time.Second)
+	block, err = blockExec.CreateProposalBlock(ctx, height, state, otherCommit, pa)
+	require.NoError(t, err)
+	require.Equal(t, otherCommit.ToCommit().Hash(), block.LastCommit.Hash())
+	app.AssertNumberOfCalls(t, "PrepareProposal", 3)
+
+	// Without a speculative block, it is created as usual.
+	_, err = blockExec.CreateProposalBlock(ctx, height, state, commit, pa)
+	require.NoError(t, err)
+	app.AssertNumberOfCalls(t, "PrepareProposal", 4)
+}
+
+// TestProcessProposalWaitsForSpeculation tests that the application is not
+// asked to process a proposal while a speculative proposal block is being
+// created.
+func TestProcessProposalWaitsForSpeculation(t *testing.T) {
+	const height = 2
+	state, stateDB, privVals := makeState(1, height)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+
+	evpool := &mocks.EvidencePool{}
+	evpool.On("PendingEvidence", mock.Anything).Return([]types.Evidence{}, int64(0))
+
+	mp := &mpmocks.Mempool{}
+	mp.On("ReapMaxBytesMaxGas", mock.Anything, mock.Anything).Return(types.Txs{})
+
+	var prepared, preparedBeforeProcess atomic.Bool
+	release := make(chan time.Time)
+	app := &abcimocks.Application{}
+	app.On("PrepareProposal", mock.Anything, mock.Anything).WaitUntil(release).Run(func(mock.Arguments) {
+		prepared.Store(true)
+	}).Return(&abci.PrepareProposalResponse{}, nil)
+	app.On("ProcessProposal", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		preparedBeforeProcess.Store(prepared.Load())
+	}).Return(&abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil)
+	// Calls to the application are not serialized by the client.
+	cc := proxy.NewUnsyncLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.NoError(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mp,
+		evpool,
+		blockStore,
+	)
+	pa, _ := state.Validators.GetByIndex(0)
+	commit, err := makeValidCommit(height, types.BlockID{}, state.Validators, privVals)
+	require.NoError(t, err)
+
+	blockExec.SpeculateProposalBlock(height, state, commit, pa)
+	block := makeBlock(state, height, commit.ToCommit())
+	processed := make(chan struct{})
+	go func() {
+		defer close(processed)
+		_, err := blockExec.ProcessProposal(block, state)
+		assert.NoError(t, err)
+	}()
+	select {
+	case <-processed:
+		t.Fatal("ProcessProposal returned while PrepareProposal was running")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	<-processed
+	require.True(t, preparedBeforeProcess.Load())
+}
+
+// overlapDetectingApp is an application recording whether it was called
+// while handling another call. Its calls take some time, for them to overlap
+// if they are concurrent.
+type overlapDetectingApp struct {
+	abci.BaseApplication
+
+	calls      atomic.Int32
+	overlapped atomic.Bool
+}
+
+func (app *overlapDetectingApp) enter() func() {
+	if app.calls.Add(1) > 1 {
+		app.overlapped.Store(true)
+	}
+	time.Sleep(20 * time.Millisecond)
+	return func() { app.calls.Add(-1) }
+}
+
+func (app *overlapDetectingApp) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+	defer app.enter()()
+	return app.BaseApplication.PrepareProposal(ctx, req)
+}
+
+func (app *overlapDetectingApp) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+	defer app.enter()()
+	return app.BaseApplication.ProcessProposal(ctx, req)
+}
+
+func (app *overlapDetectingApp) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+	defer app.enter()()
+	return app.BaseApplication.ExtendVote(ctx, req)
+}
+
+func (app *overlapDetectingApp) VerifyVoteExtension(ctx context.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+	defer app.enter()()
+	return app.BaseApplication.VerifyVoteExtension(ctx, req)
+}
+
+func (app *overlapDetectingApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	defer app.enter()()
+	return app.BaseApplication.FinalizeBlock(ctx, req)
+}
+
+// TestOptimisticExecutionNotConcurrent tests that the application is not
+// called while it optimistically executes a block or creates a speculative
+// proposal block, even if the client does not serialize the calls.
+func TestOptimisticExecutionNotConcurrent(t *testing.T) {
+	const height = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state, stateDB, privVals := makeState(1, height)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+
+	evpool := &mocks.EvidencePool{}
+	evpool.On("PendingEvidence", mock.Anything).Return([]types.Evidence{}, int64(0))
+
+	mp := &mpmocks.Mempool{}
+	mp.On("ReapMaxBytesMaxGas", mock.Anything, mock.Anything).Return(types.Txs{})
+
+	app := &overlapDetectingApp{}
+	cc := proxy.NewUnsyncLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.NoError(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mp,
+		evpool,
+		blockStore,
+		sm.BlockExecutorWithOptimisticExecution(true),
+	)
+	pa, _ := state.Validators.GetByIndex(0)
+	commit, err := makeValidCommit(height, types.BlockID{}, state.Validators, privVals)
+	require.NoError(t, err)
+	block := makeBlock(state, height, commit.ToCommit())
+	vote := &types.Vote{
+		Type:    types.PrecommitType,
+		Height:  height,
+		BlockID: types.BlockID{Hash: block.Hash()},
+	}
+
+	// Each call follows an accepted proposal, executed in the background.
+	calls := []func(){
+		func() {
+			_, err := blockExec.ProcessProposal(block, state)
+			require.NoError(t, err)
+		},
+		func() {
+			_, err := blockExec.ExtendVote(ctx, vote, block, state)
+			require.NoError(t, err)
+		},
+		func() {
+			require.NoError(t, blockExec.VerifyVoteExtension(ctx, vote))
+		},
+		func() {
+			_, err := blockExec.CreateProposalBlock(ctx, height, state, commit, pa)
+			require.NoError(t, err)
+		},
+		func() {
+			blockExec.SpeculateProposalBlock(height, state, commit, pa)
+			_, err := blockExec.CreateProposalBlock(ctx, height, state, commit, pa)
+			require.NoError(t, err)
+		},
+	}
+	for _, call := range calls {
+		accepted, err := blockExec.ProcessProposal(block, state)
+		require.NoError(t, err)
+		require.True(t, accepted)
+		call()
+	}
+	assert.False(t, app.overlapped.Load())
+}
+
 // TestCreateProposalBlockPanicOnAbsentVoteExtensions ensures that the CreateProposalBlock
 // call correctly panics when the vote extension data is missing from the extended commit
 // data that the method receives.
@@ -1073,7 +1437,7 @@ func TestCreateProposalAbsentVoteExtensions(t *testing.T) {
 			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
 				DiscardABCIResponses: false,
 			})
-			state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.extensionEnableHeight
+			state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testCase.extensionEnableHeight //nolint:staticcheck
 			mp := &mpmocks.Mempool{}
 			mp.On("Lock").Return()
 			mp.On("Unlock").Return()
@@ -18,7 +18,7 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "block_processing_time",
-			Help:      "Time spent processing FinalizeBlock",
+			Help:      "Time spent processing FinalizeBlock for the decided block, including the optimistic execution of the block if it is used.",
 
 			Buckets: stdprometheus.LinearBuckets(1, 10, 10),
 		}, labels).With(labelsAndValues...),
@@ -96,6 +96,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBuckets(0.0002, 10, 5),
 		}, append(labels, "method")).With(labelsAndValues...),
+		SpeculativeProposalBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "speculative_proposal_blocks",
+			Help:      "Number of proposal blocks created ahead of time, labeled by whether they were used or discarded.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		OptimisticExecutions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "optimistic_executions",
+			Help:      "Number of blocks executed before being decided, labeled by whether the execution was used or discarded.",
+		}, append(labels, "status")).With(labelsAndValues...),
 	}
 }
 
@@ -114,5 +126,7 @@ func NopMetrics() *Metrics {
 		TxIndexerBaseHeight:                    discard.NewGauge(),
 		BlockIndexerBaseHeight:                 discard.NewGauge(),
 		StoreAccessDurationSeconds:             discard.NewHistogram(),
+		SpeculativeProposalBlocks:              discard.NewCounter(),
+		OptimisticExecutions:                   discard.NewCounter(),
 	}
 }
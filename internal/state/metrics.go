@@ -14,7 +14,8 @@ const (
 
 // Metrics contains metrics exposed by this package.
 type Metrics struct {
-	// Time spent processing FinalizeBlock
+	// Time spent processing FinalizeBlock for the decided block, including the
+	// optimistic execution of the block if it is used.
 	BlockProcessingTime metrics.Histogram `metrics_bucketsizes:"1, 10, 10" metrics_buckettype:"lin"`
 
 	// ConsensusParamUpdates is the total number of times the application has
@@ -66,4 +67,16 @@ type Metrics struct {
 	// The duration of accesses to the state store labeled by which method
 	// was called on the store.
 	StoreAccessDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.0002, 10, 5" metrics_buckettype:"exp" metrics_labels:"method"`
+
+	// SpeculativeProposalBlocks is the number of proposal blocks created
+	// ahead of time, while the previous block was in timeout_commit, labeled
+	// by whether they were used or discarded.
+	// metrics:Number of proposal blocks created ahead of time, labeled by whether they were used or discarded.
+	SpeculativeProposalBlocks metrics.Counter `metrics_labels:"status"`
+
+	// OptimisticExecutions is the number of blocks executed before being
+	// decided, labeled by whether the response of the application was used
+	// or discarded.
+	// metrics:Number of blocks executed before being decided, labeled by whether the execution was used or discarded.
+	OptimisticExecutions metrics.Counter `metrics_labels:"status"`
 }
@@ -236,18 +236,23 @@ func (state State) MakeBlock(
 	lastCommit *types.Commit,
 	evidence []types.Evidence,
 	proposerAddress []byte,
+) *types.Block {
+	return state.makeBlock(height, txs, lastCommit, evidence, proposerAddress,
+		state.blockTime(height, lastCommit))
+}
+
+// makeBlock is like MakeBlock, with the time of the block set to timestamp.
+func (state State) makeBlock(
+	height int64,
+	txs []types.Tx,
+	lastCommit *types.Commit,
+	evidence []types.Evidence,
+	proposerAddress []byte,
+	timestamp time.Time,
 ) *types.Block {
 	// Build base block with block data.
 	block := types.MakeBlock(height, txs, lastCommit, evidence)
 
-	// Set time.
-	var timestamp time.Time
-	if height == state.InitialHeight {
-		timestamp = state.LastBlockTime // genesis time
-	} else {
-		timestamp = MedianTime(lastCommit, state.LastValidators)
-	}
-
 	// Fill rest of header with state data.
 	block.Header.Populate(
 		state.Version.Consensus, state.ChainID,
@@ -260,6 +265,40 @@ func (state State) MakeBlock(
 	return block
 }
 
+// MakePartSet returns the part set in which block is gossiped, which is
+// erasure coded if erasure coding is enabled at the height of the block.
+func (state State) MakePartSet(block *types.Block) (*types.PartSet, error) {
+	if block != nil && state.ConsensusParams.Feature.ErasureCodingEnabled(block.Height) {
+		return block.MakeErasurePartSet(types.BlockPartSizeBytes)
+	}
+	return block.MakePartSet(types.BlockPartSizeBytes)
+}
+
+// NewPartSetFromHeader returns an empty part set ready to be populated with
+// the parts of the block at height, which is erasure coded if erasure coding
+// is enabled at this height.
+func (state State) NewPartSetFromHeader(height int64, header types.PartSetHeader) *types.PartSet {
+	if state.ConsensusParams.Feature.ErasureCodingEnabled(height) {
+		return types.NewErasurePartSetFromHeader(header)
+	}
+	return types.NewPartSetFromHeader(header)
+}
+
+// blockTime returns the time of a block proposed at height. The first block
+// has the genesis time. Then, when proposer-based timestamps are enabled, the
+// time of a block is the local time of its proposer; otherwise, it is the
+// median time of lastCommit.
+func (state State) blockTime(height int64, lastCommit *types.Commit) time.Time {
+	switch {
+	case height == state.InitialHeight:
+		return state.LastBlockTime // genesis time
+	case state.ConsensusParams.Feature.PBTSEnabled(height):
+		return cmttime.Now()
+	default:
+		return MedianTime(lastCommit, state.LastValidators)
+	}
+}
+
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
 // corresponding validator set. The computed time is always between timestamps of
 // the votes sent by honest processes, i.e., a faulty processes can not arbitrarily increase or decrease the
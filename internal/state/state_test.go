@@ -8,6 +8,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1005,6 +1006,22 @@ func TestStateMakeBlock(t *testing.T) {
 	assert.Equal(t, proposerAddress, block.ProposerAddress)
 }
 
+func TestStateMakeBlockTime(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	// The first block has the genesis time.
+	block := makeBlock(state, state.InitialHeight, new(types.Commit))
+	assert.Equal(t, state.LastBlockTime, block.Time)
+
+	// With proposer-based timestamps, a block has the local time.
+	state.ConsensusParams.Feature.PBTSEnableHeight = 2
+	before := time.Now()
+	block = makeBlock(state, 2, new(types.Commit))
+	assert.False(t, block.Time.Before(before))
+	assert.False(t, block.Time.After(time.Now()))
+}
+
 // TestConsensusParamsChangesSaveLoad tests saving and loading consensus params
 // with changes.
 func TestConsensusParamsChangesSaveLoad(t *testing.T) {
@@ -12,9 +12,9 @@ func TxPreCheck(state State) mempl.PreCheckFunc {
 	if maxBytes == -1 {
 		maxBytes = int64(types.MaxBlockSizeBytes)
 	}
-	maxDataBytes := types.MaxDataBytesNoEvidence(
+	maxDataBytes := types.MaxDataBytesNoEvidenceForValidators(
 		maxBytes,
-		state.Validators.Size(),
+		state.Validators,
 	)
 	return mempl.PreCheckMaxBytes(maxDataBytes)
 }
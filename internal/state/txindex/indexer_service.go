@@ -23,6 +23,9 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+
+	// records when transactions are indexed, or nil
+	txTracer *types.TxTracer
 }
 
 // NewIndexerService returns a new service instance.
@@ -42,6 +45,12 @@ func NewIndexerService(
 	return is
 }
 
+// SetTxTracer sets the tracer recording when transactions are indexed. It
+// must be called before the service is started.
+func (is *IndexerService) SetTxTracer(tracer *types.TxTracer) {
+	is.txTracer = tracer
+}
+
 // OnStart implements service.Service by subscribing for all transactions
 // and indexing them by events.
 func (is *IndexerService) OnStart() error {
@@ -116,6 +125,13 @@ func (is *IndexerService) OnStart() error {
 					}
 				} else {
 					is.Logger.Debug("indexed transactions", "height", height, "num_txs", numTxs)
+					if is.txTracer != nil {
+						txKeys := make([]types.TxKey, 0, batch.Size())
+						for _, txResult := range batch.Ops {
+							txKeys = append(txKeys, types.Tx(txResult.Tx).Key())
+						}
+						is.txTracer.Indexed(txKeys)
+					}
 				}
 			}
 		}
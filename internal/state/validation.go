@@ -119,12 +119,16 @@ func validateBlock(state State, block *types.Block) error {
 				state.LastBlockTime,
 			)
 		}
-		medianTime := MedianTime(block.LastCommit, state.LastValidators)
-		if !block.Time.Equal(medianTime) {
-			return fmt.Errorf("invalid block time. Expected %v, got %v",
-				medianTime,
-				block.Time,
-			)
+		// With proposer-based timestamps, the time of the block is checked
+		// for timeliness by consensus instead.
+		if !state.ConsensusParams.Feature.PBTSEnabled(block.Height) {
+			medianTime := MedianTime(block.LastCommit, state.LastValidators)
+			if !block.Time.Equal(medianTime) {
+				return fmt.Errorf("invalid block time. Expected %v, got %v",
+					medianTime,
+					block.Time,
+				)
+			}
 		}
 
 	case block.Height == state.InitialHeight:
@@ -151,7 +151,14 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 	}
 	pbb := new(cmtproto.Block)
 	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	// The block is held by the data parts of an erasure-coded part set, which
+	// are followed by the parity parts.
+	total := int(blockMeta.BlockID.PartSetHeader.Total)
+	erasureCoded := bs.isErasureCoded(height)
+	if erasureCoded {
+		total = (total + 1) / 2
+	}
+	for i := 0; i < total; i++ {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
@@ -160,6 +167,12 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 		}
 		buf = append(buf, part.Bytes...)
 	}
+	if erasureCoded {
+		var err error
+		if buf, err = types.ErasureCodedData(buf); err != nil {
+			panic(fmt.Sprintf("Error reading block: %v", err))
+		}
+	}
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block"), start)()
 
 	err := proto.Unmarshal(buf, pbb)
@@ -227,6 +240,16 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	return part
 }
 
+// isErasureCoded returns true if the part set of the block at the given
+// height is erasure coded.
+func (bs *BlockStore) isErasureCoded(height int64) bool {
+	has, err := bs.db.Has(calcErasureCodedKey(height))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
 // LoadBlockMeta returns the BlockMeta for the given height.
 // If no block is found for the given height, it returns nil.
 func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
@@ -435,6 +458,9 @@ func (bs *BlockStore) PruneBlocks(height int64, state sm.State) (uint64, int64,
 				return 0, -1, err
 			}
 		}
+		if err := batch.Delete(calcErasureCodedKey(h)); err != nil {
+			return 0, -1, err
+		}
 		pruned++
 
 		// flush every 1000 blocks to avoid batches becoming too large
@@ -592,6 +618,11 @@ func (bs *BlockStore) saveBlockToBatch(
 		part := blockParts.GetPart(i)
 		bs.saveBlockPart(height, i, part, batch, saveBlockPartsToBatch)
 	}
+	if blockParts.IsErasureCoded() {
+		if err := batch.Set(calcErasureCodedKey(height), []byte{1}); err != nil {
+			return err
+		}
+	}
 
 	marshallTime := time.Now()
 	// Save block meta
@@ -719,6 +750,10 @@ func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
 
+func calcErasureCodedKey(height int64) []byte {
+	return []byte(fmt.Sprintf("PE:%v", height))
+}
+
 //-----------------------------------------------------------------------------
 
 var blockStoreKey = []byte("blockStore")
@@ -795,6 +830,9 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 				return err
 			}
 		}
+		if err := batch.Delete(calcErasureCodedKey(targetHeight)); err != nil {
+			return err
+		}
 	}
 	if err := batch.Delete(calcBlockCommitKey(targetHeight)); err != nil {
 		return err
@@ -463,6 +463,30 @@ func TestLoadBlockExtendedCommit(t *testing.T) {
 	}
 }
 
+func TestBlockStoreSaveLoadErasureCodedBlock(t *testing.T) {
+	state, bs, _, _, cleanup, _ := makeStateAndBlockStoreAndIndexers()
+	defer cleanup()
+
+	// save a block big enough to have several block parts
+	txs := []types.Tx{make([]byte, 2*types.BlockPartSizeBytes)}
+	block := state.MakeBlock(bs.Height()+1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	partSet, err := block.MakeErasurePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	require.True(t, partSet.IsErasureCoded())
+	bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(block.Height, cmttime.Now()))
+
+	loaded, meta := bs.LoadBlock(block.Height)
+	require.NotNil(t, loaded)
+	assert.Equal(t, block.Hash(), loaded.Hash())
+	assert.Equal(t, partSet.Header(), meta.BlockID.PartSetHeader)
+	for i := 0; i < int(partSet.Total()); i++ {
+		assert.Equal(t, partSet.GetPart(i), bs.LoadBlockPart(block.Height, i))
+	}
+
+	require.NoError(t, bs.DeleteLatestBlock())
+	assert.False(t, bs.isErasureCoded(block.Height))
+}
+
 func TestLoadBaseMeta(t *testing.T) {
 	config := test.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
@@ -9,6 +9,6 @@ import (
 func ConsensusParams() *types.ConsensusParams {
 	c := types.DefaultConsensusParams()
 	// enable vote extensions
-	c.ABCI.VoteExtensionsEnableHeight = 1
+	c.ABCI.VoteExtensionsEnableHeight = 1 //nolint:staticcheck
 	return c
 }
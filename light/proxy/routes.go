@@ -34,11 +34,14 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
+		"validator_liveness":   rpcserver.NewRPCFunc(makeValidatorLivenessFunc(c), ""),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
+		"mempool_sender_usage": rpcserver.NewRPCFunc(makeMempoolSenderUsageFunc(c), "sender"),
+		"tx_trace":             rpcserver.NewRPCFunc(makeTxTraceFunc(c), "hash"),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
@@ -207,6 +210,14 @@ func makeValidatorsFunc(c *lrpc.Client) rpcValidatorsFunc {
 	}
 }
 
+type rpcValidatorLivenessFunc func(ctx *rpctypes.Context) (*ctypes.ResultValidatorLiveness, error)
+
+func makeValidatorLivenessFunc(c *lrpc.Client) rpcValidatorLivenessFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultValidatorLiveness, error) {
+		return c.ValidatorLiveness(ctx.Context())
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
@@ -247,6 +258,22 @@ func makeNumUnconfirmedTxsFunc(c *lrpc.Client) rpcNumUnconfirmedTxsFunc {
 	}
 }
 
+type rpcMempoolSenderUsageFunc func(ctx *rpctypes.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error)
+
+func makeMempoolSenderUsageFunc(c *lrpc.Client) rpcMempoolSenderUsageFunc {
+	return func(ctx *rpctypes.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error) {
+		return c.MempoolSenderUsage(ctx.Context(), sender)
+	}
+}
+
+type rpcTxTraceFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxTrace, error)
+
+func makeTxTraceFunc(c *lrpc.Client) rpcTxTraceFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxTrace, error) {
+		return c.TxTrace(ctx.Context(), hash)
+	}
+}
+
 type rpcBroadcastTxCommitFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 
 func makeBroadcastTxCommitFunc(c *lrpc.Client) rpcBroadcastTxCommitFunc {
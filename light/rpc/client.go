@@ -210,6 +210,14 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirm
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) MempoolSenderUsage(ctx context.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error) {
+	return c.next.MempoolSenderUsage(ctx, sender)
+}
+
+func (c *Client) TxTrace(ctx context.Context, hash []byte) (*ctypes.ResultTxTrace, error) {
+	return c.next.TxTrace(ctx, hash)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -222,6 +230,10 @@ func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpCons
 	return c.next.DumpConsensusState(ctx)
 }
 
+func (c *Client) ValidatorLiveness(ctx context.Context) (*ctypes.ResultValidatorLiveness, error) {
+	return c.next.ValidatorLiveness(ctx)
+}
+
 func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.next.ConsensusState(ctx)
 }
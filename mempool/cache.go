@@ -26,6 +26,11 @@ type TxCache interface {
 	// Has reports whether tx is present in the cache. Checking for presence is
 	// not treated as an access of the value.
 	Has(tx types.Tx) bool
+
+	// HasKey reports whether the transaction with the given key is present in
+	// the cache. Checking for presence is not treated as an access of the
+	// value.
+	HasKey(txKey types.TxKey) bool
 }
 
 var _ TxCache = (*LRUTxCache)(nil)
@@ -103,10 +108,14 @@ func (c *LRUTxCache) Remove(tx types.Tx) {
 }
 
 func (c *LRUTxCache) Has(tx types.Tx) bool {
+	return c.HasKey(tx.Key())
+}
+
+func (c *LRUTxCache) HasKey(txKey types.TxKey) bool {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
-	_, ok := c.cacheMap[tx.Key()]
+	_, ok := c.cacheMap[txKey]
 	return ok
 }
 
@@ -115,7 +124,8 @@ type NopTxCache struct{}
 
 var _ TxCache = (*NopTxCache)(nil)
 
-func (NopTxCache) Reset()             {}
-func (NopTxCache) Push(types.Tx) bool { return true }
-func (NopTxCache) Remove(types.Tx)    {}
-func (NopTxCache) Has(types.Tx) bool  { return false }
+func (NopTxCache) Reset()                  {}
+func (NopTxCache) Push(types.Tx) bool      { return true }
+func (NopTxCache) Remove(types.Tx)         {}
+func (NopTxCache) Has(types.Tx) bool       { return false }
+func (NopTxCache) HasKey(types.TxKey) bool { return false }
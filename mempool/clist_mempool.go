@@ -3,10 +3,13 @@ package mempool
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
@@ -33,13 +36,16 @@ type CListMempool struct {
 
 	// Function set by the reactor to be called when a transaction is removed
 	// from the mempool.
-	removeTxOnReactorCb func(txKey types.TxKey)
+	removeTxOnReactorCb func(txKey types.TxKey, reason TxRemovalReason)
 
 	config *config.MempoolConfig
 
 	// Exclusive mutex for Update method to prevent concurrent execution of
 	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
 	updateMtx cmtsync.RWMutex
+	// True while Lock() is held.
+	locked atomic.Bool
+
 	preCheck  PreCheckFunc
 	postCheck PostCheckFunc
 
@@ -57,10 +63,44 @@ type CListMempool struct {
 	txs    *clist.CList
 	txsMap sync.Map
 
+	// Keys of the transactions for which the application set a sender, by
+	// sender and nonce. A new transaction with the same sender and nonce as
+	// one of them, and a higher priority, replaces it.
+	txSlotsMtx cmtsync.Mutex
+	txSlots    map[txSlot]types.TxKey
+
+	// Number and total size of the transactions of each sender.
+	sendersMtx cmtsync.Mutex
+	senders    map[string]*senderUsage
+
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Lanes of the mempool, or nil if lanes are disabled.
+	lanes *lanes
+
+	// Function called when a valid transaction does not fit in the mempool,
+	// even once the transaction it replaces, if not nil, is removed. It should
+	// try to make room for the transaction by removing other ones, and return
+	// false if it could not. If nil, transactions are rejected as soon as the
+	// mempool is full.
+	evictTxs func(memTx, replaced *mempoolTx) bool
+
+	// On-disk journal of the transactions in the mempool, or nil if disabled.
+	journal *txJournal
+
+	// Records the lifecycle of transactions, or nil if tracing is disabled.
+	txTracer *types.TxTracer
+
+	// Publishes an event each time a transaction is added to or removed from
+	// the mempool, or nil if events are disabled.
+	eventBus types.MempoolEventPublisher
+
+	// Events raised while Lock() is held, published on Unlock().
+	pendingTxEvents    []types.EventDataMempoolTx
+	pendingTxEventsMtx cmtsync.Mutex
+
 	logger  log.Logger
 	metrics *Metrics
 }
@@ -82,8 +122,11 @@ func NewCListMempool(
 		config:        cfg,
 		proxyAppConn:  proxyAppConn,
 		txs:           clist.New(),
+		txSlots:       make(map[txSlot]types.TxKey),
+		senders:       make(map[string]*senderUsage),
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		lanes:         newLanes(cfg.Lanes),
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
 	}
@@ -111,6 +154,15 @@ func (mem *CListMempool) getCElement(txKey types.TxKey) (*clist.CElement, bool)
 	return nil, false
 }
 
+// GetTxByKey returns the transaction with the given key, if it is in the
+// mempool.
+func (mem *CListMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	if e, ok := mem.getCElement(txKey); ok {
+		return e.Value.(*mempoolTx).tx, true
+	}
+	return nil, false
+}
+
 func (mem *CListMempool) InMempool(txKey types.TxKey) bool {
 	_, ok := mem.getCElement(txKey)
 	return ok
@@ -141,9 +193,18 @@ func (mem *CListMempool) removeAllTxs() {
 
 	mem.txsMap.Range(func(key, _ interface{}) bool {
 		mem.txsMap.Delete(key)
-		mem.invokeRemoveTxOnReactor(key.(types.TxKey))
+		mem.invokeRemoveTxOnReactor(key.(types.TxKey), TxRemovedFlushed)
+		mem.publishTxRemoved(key.(types.TxKey), TxRemovedFlushed)
 		return true
 	})
+
+	mem.txSlotsMtx.Lock()
+	mem.txSlots = make(map[txSlot]types.TxKey)
+	mem.txSlotsMtx.Unlock()
+
+	mem.sendersMtx.Lock()
+	mem.senders = make(map[string]*senderUsage)
+	mem.sendersMtx.Unlock()
 }
 
 // NOTE: not thread safe - should only be called once, on startup.
@@ -151,15 +212,15 @@ func (mem *CListMempool) EnableTxsAvailable() {
 	mem.txsAvailable = make(chan struct{}, 1)
 }
 
-func (mem *CListMempool) SetTxRemovedCallback(cb func(txKey types.TxKey)) {
+func (mem *CListMempool) SetTxRemovedCallback(cb func(txKey types.TxKey, reason TxRemovalReason)) {
 	mem.removeTxOnReactorCb = cb
 }
 
-func (mem *CListMempool) invokeRemoveTxOnReactor(txKey types.TxKey) {
+func (mem *CListMempool) invokeRemoveTxOnReactor(txKey types.TxKey, reason TxRemovalReason) {
 	// Note that the callback is nil in the unit tests, where there are no
 	// reactors.
 	if mem.removeTxOnReactorCb != nil {
-		mem.removeTxOnReactorCb(txKey)
+		mem.removeTxOnReactorCb(txKey, reason)
 	}
 }
 
@@ -187,14 +248,39 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithJournal keeps a journal of the transactions in the mempool in db, so that
+// they can be replayed with ReplayJournal after a restart.
+func WithJournal(db dbm.DB) CListMempoolOption {
+	return func(mem *CListMempool) {
+		mem.journal = newTxJournal(db, mem.config.JournalMaxAge, mem.config.JournalMaxBytes)
+	}
+}
+
+// WithTxTracer records when transactions are checked and gossiped in tracer.
+func WithTxTracer(tracer *types.TxTracer) CListMempoolOption {
+	return func(mem *CListMempool) { mem.txTracer = tracer }
+}
+
+// WithEventBus sets the event bus to which the mempool publishes an event
+// each time a transaction is added or removed. Without it, no events are
+// published.
+func WithEventBus(eventBus types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.eventBus = eventBus }
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
+	mem.locked.Store(true)
 }
 
+// Unlock also publishes the events raised while the lock was held.
+//
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Unlock() {
+	mem.locked.Store(false)
 	mem.updateMtx.Unlock()
+	mem.publishPendingTxEvents()
 }
 
 // Safe for concurrent use by multiple goroutines.
@@ -223,9 +309,18 @@ func (mem *CListMempool) Flush() {
 	defer mem.updateMtx.RUnlock()
 
 	mem.txsBytes.Store(0)
+	if mem.lanes != nil {
+		mem.lanes.reset()
+	}
 	mem.cache.Reset()
 
 	mem.removeAllTxs()
+
+	if mem.journal != nil {
+		if err := mem.journal.reset(); err != nil {
+			mem.logger.Error("failed to reset mempool journal", "err", err)
+		}
+	}
 }
 
 // TxsFront returns the first transaction in the ordered list for peer
@@ -253,10 +348,38 @@ func (mem *CListMempool) CheckTx(tx types.Tx) (*abcicli.ReqRes, error) {
 	// use defer to unlock mutex because application (*local client*) might panic
 	defer mem.updateMtx.RUnlock()
 
+	return mem.checkTx(tx)
+}
+
+// CheckTxs submits a batch of transactions to the application back to back,
+// without letting Update() run in between. The i-th returned request and error
+// correspond to the i-th transaction, as returned by CheckTx.
+//
+// It blocks if we're waiting on Update() or Reap().
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) CheckTxs(txs types.Txs) ([]*abcicli.ReqRes, []error) {
+	mem.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer mem.updateMtx.RUnlock()
+
+	reqRess := make([]*abcicli.ReqRes, len(txs))
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		reqRess[i], errs[i] = mem.checkTx(tx)
+	}
+	return reqRess, errs
+}
+
+// checkTx implements CheckTx. The caller must hold updateMtx.
+func (mem *CListMempool) checkTx(tx types.Tx) (*abcicli.ReqRes, error) {
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
-		return nil, err
+	// When eviction is enabled, we only know whether there is room for the
+	// transaction once the application has checked it.
+	if mem.evictTxs == nil {
+		if err := mem.isFull(txSize); err != nil {
+			return nil, err
+		}
 	}
 
 	if txSize > mem.config.MaxTxBytes {
@@ -324,8 +447,7 @@ func (mem *CListMempool) globalCb(req *abci.Request, res *abci.Response) {
 		}
 
 		// update metrics
-		mem.metrics.Size.Set(float64(mem.Size()))
-		mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+		mem.updateSizeMetrics()
 
 	default:
 		// ignore other messages
@@ -350,25 +472,68 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) bool {
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(tx.Key(), e)
 	mem.txsBytes.Add(int64(len(tx)))
+	if mem.lanes != nil {
+		mem.lanes.get(memTx.lane).addTx(memTx)
+	}
+	if memTx.sender != "" {
+		mem.txSlotsMtx.Lock()
+		mem.txSlots[memTx.slot()] = tx.Key()
+		mem.txSlotsMtx.Unlock()
+
+		mem.sendersMtx.Lock()
+		usage, ok := mem.senders[memTx.sender]
+		if !ok {
+			usage = &senderUsage{}
+			mem.senders[memTx.sender] = usage
+		}
+		usage.numTxs++
+		usage.txsBytes += int64(len(tx))
+		mem.sendersMtx.Unlock()
+	}
 	mem.metrics.TxSizeBytes.Observe(float64(len(tx)))
 
+	if mem.journal != nil {
+		if err := mem.journal.add(tx, time.Now()); err != nil {
+			mem.logger.Debug("transaction not journaled", "tx", tx.Hash(), "err", err)
+		}
+	}
+
 	mem.logger.Debug(
 		"added valid transaction",
 		"tx", tx.Hash(),
 		"height", mem.height.Load(),
 		"total", mem.Size(),
 	)
+
+	if mem.eventBus != nil {
+		mem.publishTxEvent(types.EventDataMempoolTx{
+			Hash:     tx.Hash(),
+			Tx:       tx,
+			Height:   memTx.Height(),
+			Priority: memTx.priority,
+			Lane:     memTx.lane,
+			Sender:   memTx.sender,
+			Nonce:    memTx.nonce,
+		})
+	}
 	return true
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+	return mem.removeTx(txKey, TxRemovedManually)
+}
+
+// removeTx removes a transaction from the mempool by its TxKey index, for the
+// given reason.
 // Called from:
-//   - Update (lock held) if tx was committed
+//   - Update (lock held) if tx was committed or has expired
 //   - resCbRecheck (lock not held) if tx was invalidated
-func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+//   - evictTxs (lock not held) if tx was evicted
+func (mem *CListMempool) removeTx(txKey types.TxKey, reason TxRemovalReason) error {
 	// The transaction should be removed from the reactor, even if it cannot be
 	// found in the mempool.
-	mem.invokeRemoveTxOnReactor(txKey)
+	mem.invokeRemoveTxOnReactor(txKey, reason)
 
 	elem, ok := mem.getCElement(txKey)
 	if !ok {
@@ -378,18 +543,88 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
 	mem.txsMap.Delete(txKey)
-	tx := elem.Value.(*mempoolTx).tx
+	memTx := elem.Value.(*mempoolTx)
+	tx := memTx.tx
 	mem.txsBytes.Add(int64(-len(tx)))
-	mem.logger.Debug("removed transaction", "tx", tx.Hash(), "height", mem.height.Load(), "total", mem.Size())
+	if mem.lanes != nil {
+		mem.lanes.get(memTx.lane).removeTx(memTx)
+	}
+	if memTx.sender != "" {
+		mem.txSlotsMtx.Lock()
+		if mem.txSlots[memTx.slot()] == txKey {
+			delete(mem.txSlots, memTx.slot())
+		}
+		mem.txSlotsMtx.Unlock()
+
+		mem.sendersMtx.Lock()
+		if usage, ok := mem.senders[memTx.sender]; ok {
+			usage.numTxs--
+			usage.txsBytes -= int64(len(tx))
+			if usage.numTxs <= 0 {
+				delete(mem.senders, memTx.sender)
+			}
+		}
+		mem.sendersMtx.Unlock()
+	}
+	if mem.journal != nil {
+		if err := mem.journal.remove(txKey); err != nil {
+			mem.logger.Error("failed to remove transaction from journal", "tx", tx.Hash(), "err", err)
+		}
+	}
+	mem.logger.Debug("removed transaction", "tx", tx.Hash(), "reason", reason, "height", mem.height.Load(), "total", mem.Size())
+	mem.publishTxRemoved(txKey, reason)
 	return nil
 }
 
+// publishTxRemoved publishes an event for the transaction with the given key,
+// removed from the mempool for the given reason.
+func (mem *CListMempool) publishTxRemoved(txKey types.TxKey, reason TxRemovalReason) {
+	if mem.eventBus == nil {
+		return
+	}
+	mem.publishTxEvent(types.EventDataMempoolTx{
+		Hash:    append([]byte(nil), txKey[:]...),
+		Removed: true,
+		Reason:  reason.String(),
+	})
+}
+
+// publishTxEvent publishes the given event. While Lock() is held, that is,
+// during Update, the event is queued instead and published on Unlock(), so
+// that publishing does not delay Update.
+func (mem *CListMempool) publishTxEvent(data types.EventDataMempoolTx) {
+	if mem.locked.Load() {
+		mem.pendingTxEventsMtx.Lock()
+		mem.pendingTxEvents = append(mem.pendingTxEvents, data)
+		mem.pendingTxEventsMtx.Unlock()
+		return
+	}
+	if err := mem.eventBus.PublishEventMempoolTx(data); err != nil {
+		mem.logger.Error("failed publishing mempool tx event", "tx", data.Hash, "err", err)
+	}
+}
+
+// publishPendingTxEvents publishes the events queued while Lock() was held.
+func (mem *CListMempool) publishPendingTxEvents() {
+	mem.pendingTxEventsMtx.Lock()
+	events := mem.pendingTxEvents
+	mem.pendingTxEvents = nil
+	mem.pendingTxEventsMtx.Unlock()
+
+	for _, data := range events {
+		if err := mem.eventBus.PublishEventMempoolTx(data); err != nil {
+			mem.logger.Error("failed publishing mempool tx event", "tx", data.Hash, "err", err)
+		}
+	}
+}
+
 func (mem *CListMempool) isFull(txSize int) error {
-	var (
-		memSize  = mem.Size()
-		txsBytes = mem.SizeBytes()
-	)
+	return mem.isFullWith(mem.Size(), mem.SizeBytes(), txSize)
+}
 
+// isFullWith returns an error if a transaction of txSize bytes does not fit in
+// a mempool holding memSize transactions of txsBytes bytes total.
+func (mem *CListMempool) isFullWith(memSize int, txsBytes int64, txSize int) error {
 	if memSize >= mem.config.Size || uint64(txSize)+uint64(txsBytes) > uint64(mem.config.MaxTxsBytes) {
 		return ErrMempoolIsFull{
 			NumTxs:      memSize,
@@ -402,11 +637,82 @@ func (mem *CListMempool) isFull(txSize int) error {
 	return nil
 }
 
+// isFullFor returns an error if memTx does not fit in the mempool or, if lanes
+// are enabled, in its lane, once replaced, if not nil, is removed.
+func (mem *CListMempool) isFullFor(memTx, replaced *mempoolTx) error {
+	var (
+		memSize  = mem.Size()
+		txsBytes = mem.SizeBytes()
+	)
+	if replaced != nil {
+		memSize--
+		txsBytes -= int64(len(replaced.tx))
+	}
+	if err := mem.isFullWith(memSize, txsBytes, len(memTx.tx)); err != nil {
+		return err
+	}
+	if mem.lanes != nil {
+		return mem.lanes.get(memTx.lane).isFull(len(memTx.tx), replaced)
+	}
+	return nil
+}
+
+// isSenderFull returns an error if the sender of memTx, if any, cannot have
+// more transactions in the mempool, once replaced, if not nil, is removed.
+func (mem *CListMempool) isSenderFull(memTx, replaced *mempoolTx) error {
+	if memTx.sender == "" || (mem.config.MaxTxsPerSender == 0 && mem.config.MaxTxsBytesPerSender == 0) {
+		return nil
+	}
+
+	numTxs, txsBytes := mem.SenderUsage(memTx.sender)
+	if replaced != nil && replaced.sender == memTx.sender {
+		numTxs--
+		txsBytes -= int64(len(replaced.tx))
+	}
+	if (mem.config.MaxTxsPerSender > 0 && numTxs >= mem.config.MaxTxsPerSender) ||
+		(mem.config.MaxTxsBytesPerSender > 0 && txsBytes+int64(len(memTx.tx)) > mem.config.MaxTxsBytesPerSender) {
+		return ErrSenderIsFull{
+			Sender:      memTx.sender,
+			NumTxs:      numTxs,
+			MaxTxs:      mem.config.MaxTxsPerSender,
+			TxsBytes:    txsBytes,
+			MaxTxsBytes: mem.config.MaxTxsBytesPerSender,
+		}
+	}
+	return nil
+}
+
+// SenderUsage returns the number and total size of the transactions in the
+// mempool that the application assigned to sender in CheckTx.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) SenderUsage(sender string) (int, int64) {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	if usage, ok := mem.senders[sender]; ok {
+		return usage.numTxs, usage.txsBytes
+	}
+	return 0, 0
+}
+
+func (mem *CListMempool) updateSizeMetrics() {
+	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+	if mem.lanes != nil {
+		mem.lanes.updateMetrics(mem.metrics)
+	}
+}
+
 // callback, which is called after the app checked the tx for the first time.
 //
 // The case where the app checks the tx for the second and subsequent times is
 // handled by the resCbRecheck callback.
 func (mem *CListMempool) resCbFirstTime(tx types.Tx, res *abci.CheckTxResponse) {
+	if mem.txTracer != nil {
+		mem.txTracer.Checked(tx.Key())
+	}
+
 	var postCheckErr error
 	if mem.postCheck != nil {
 		postCheckErr = mem.postCheck(tx, res)
@@ -421,27 +727,142 @@ func (mem *CListMempool) resCbFirstTime(tx types.Tx, res *abci.CheckTxResponse)
 			"err", postCheckErr,
 		)
 		mem.metrics.FailedTxs.Add(1)
+		if res.Code == abci.CodeTypeOK {
+			setMempoolError(res, CodePostCheck, ErrPostCheck{Err: postCheckErr})
+		}
 		return
 	}
 
-	// Check mempool isn't full again to reduce the chance of exceeding the
-	// limits.
-	if err := mem.isFull(len(tx)); err != nil {
-		mem.forceRemoveFromCache(tx) // mempool might have space later
-		mem.logger.Error(err.Error())
+	memTx := &mempoolTx{
+		height:    mem.height.Load(),
+		timestamp: time.Now(),
+		gasWanted: res.GasWanted,
+		priority:  res.Priority,
+		sender:    res.Sender,
+		nonce:     res.Nonce,
+		tx:        tx,
+	}
+	if mem.lanes != nil {
+		memTx.lane = mem.lanes.get(res.Lane).name
+	}
+
+	// A transaction with the same sender and nonce is only admitted if it can
+	// replace the one in the mempool.
+	replaced, err := mem.txToReplace(memTx)
+	if err != nil {
+		mem.forceRemoveFromCache(tx) // the other transaction might be removed later
+		mem.logger.Debug(err.Error(), "tx", tx.Hash())
+		mem.metrics.RejectedTxs.Add(1)
+		setMempoolError(res, CodeTxNotReplaced, err)
+		return
+	}
+
+	// Check the sender's limits first, since making room in the mempool (or
+	// lane) would not help. The transaction to replace, if any, is only
+	// removed once the new one is known to be admitted, so it is counted as
+	// removed in these checks.
+	if err := mem.isSenderFull(memTx, replaced); err != nil {
+		mem.forceRemoveFromCache(tx) // sender might have room later
+		mem.logger.Debug(err.Error())
+		mem.metrics.RejectedTxs.Add(1)
+		setMempoolError(res, CodeSenderIsFull, err)
 		return
 	}
 
+	// Check mempool (and lane) isn't full again to reduce the chance of
+	// exceeding the limits.
+	if err := mem.isFullFor(memTx, replaced); err != nil {
+		if mem.evictTxs == nil || !mem.evictTxs(memTx, replaced) {
+			mem.forceRemoveFromCache(tx) // mempool might have space later
+			mem.logger.Error(err.Error())
+			mem.metrics.RejectedTxs.Add(1)
+			code := CodeMempoolIsFull
+			if errors.As(err, &ErrLaneIsFull{}) {
+				code = CodeLaneIsFull
+			}
+			setMempoolError(res, code, err)
+			return
+		}
+	}
+
+	if replaced != nil {
+		mem.replaceTx(replaced, memTx)
+	}
+
 	// mem.logger.Debug("calling addTx, transaction is valid", "tx", tx.Hash(), "height", mem.height.Load())
-	if mem.addTx(&mempoolTx{
-		height:    mem.height.Load(),
-		gasWanted: res.GasWanted,
-		tx:        tx,
-	}) {
+	if mem.addTx(memTx) {
 		mem.notifyTxsAvailable()
 	}
 }
 
+// setMempoolError lets the client know why the mempool rejected a transaction
+// that the application accepted, by setting the code, codespace and log of
+// res.
+func setMempoolError(res *abci.CheckTxResponse, code uint32, err error) {
+	res.Code = code
+	res.Codespace = Codespace
+	res.Log = err.Error()
+}
+
+// txToReplace returns the transaction with the same sender and nonce as memTx
+// in the mempool, if there is one, which memTx replaces if admitted. It
+// returns an ErrTxNotReplaced if memTx must be rejected, because the
+// transaction with the same sender and nonce has a higher or equal priority.
+//
+// Called from:
+//   - resCbFirstTime (lock not held) if tx is valid
+func (mem *CListMempool) txToReplace(memTx *mempoolTx) (*mempoolTx, error) {
+	if memTx.sender == "" {
+		return nil, nil
+	}
+	mem.txSlotsMtx.Lock()
+	txKey, ok := mem.txSlots[memTx.slot()]
+	mem.txSlotsMtx.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	elem, ok := mem.getCElement(txKey)
+	if !ok {
+		return nil, nil
+	}
+
+	replaced := elem.Value.(*mempoolTx)
+	if memTx.priority <= replaced.priority {
+		return nil, ErrTxNotReplaced{
+			Sender:           memTx.sender,
+			Nonce:            memTx.nonce,
+			Priority:         memTx.priority,
+			ReplacedPriority: replaced.priority,
+		}
+	}
+	return replaced, nil
+}
+
+// replaceTx removes replaced from the mempool, to be replaced by memTx. The
+// replaced transaction is also removed from the cache, so it can be received
+// again if memTx is later removed.
+//
+// Called from:
+//   - resCbFirstTime (lock not held) once memTx is admitted
+func (mem *CListMempool) replaceTx(replaced, memTx *mempoolTx) {
+	if err := mem.removeTx(replaced.tx.Key(), TxRemovedReplaced); err != nil {
+		// The transaction was removed concurrently, e.g. by a recheck or an
+		// eviction.
+		return
+	}
+	mem.forceRemoveFromCache(replaced.tx)
+	mem.metrics.ReplacedTxs.Add(1)
+	mem.logger.Debug(
+		"replaced transaction",
+		"tx", replaced.tx.Hash(),
+		"priority", replaced.priority,
+		"new_tx", memTx.tx.Hash(),
+		"new_priority", memTx.priority,
+		"sender", memTx.sender,
+		"nonce", memTx.nonce,
+	)
+}
+
 // callback, which is called after the app rechecked the tx.
 //
 // The case where the app checks the tx for the first time is handled by the
@@ -485,7 +906,7 @@ func (mem *CListMempool) resCbRecheck(tx types.Tx, res *abci.CheckTxResponse) {
 	if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
 		// Tx became invalidated due to newly committed block.
 		mem.logger.Debug("tx is no longer valid", "tx", tx.Hash(), "res", res, "postCheckErr", postCheckErr)
-		if err := mem.RemoveTxByKey(memTx.tx.Key()); err != nil {
+		if err := mem.removeTx(memTx.tx.Key(), TxRemovedInvalid); err != nil {
 			mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 		}
 		mem.tryRemoveFromCache(tx)
@@ -531,6 +952,10 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	mem.updateMtx.RLock()
 	defer mem.updateMtx.RUnlock()
 
+	if mem.lanes != nil || mem.hasSenders() {
+		return reapMaxBytesMaxGas(orderByNonce(mem.interleaveLanes(mem.allTxs())), maxBytes, maxGas)
+	}
+
 	var (
 		totalGas    int64
 		runningSize int64
@@ -572,6 +997,10 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	mem.updateMtx.RLock()
 	defer mem.updateMtx.RUnlock()
 
+	if mem.lanes != nil || mem.hasSenders() {
+		return reapMaxTxs(orderByNonce(mem.interleaveLanes(mem.allTxs())), max)
+	}
+
 	if max < 0 {
 		max = mem.txs.Len()
 	}
@@ -584,6 +1013,117 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	return txs
 }
 
+// allTxs returns all transactions in the mempool, in the order in which they
+// were added.
+func (mem *CListMempool) allTxs() []*mempoolTx {
+	memTxs := make([]*mempoolTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTxs = append(memTxs, e.Value.(*mempoolTx))
+	}
+	return memTxs
+}
+
+// hasSenders returns true if the application assigned a sender to any of the
+// transactions in the mempool.
+func (mem *CListMempool) hasSenders() bool {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+	return len(mem.senders) > 0
+}
+
+// interleaveLanes orders memTxs by lane weight, if lanes are enabled.
+func (mem *CListMempool) interleaveLanes(memTxs []*mempoolTx) []*mempoolTx {
+	if mem.lanes == nil {
+		return memTxs
+	}
+	return mem.lanes.interleave(memTxs)
+}
+
+// reapMaxBytesMaxGas returns the first transactions of memTxs, up to maxBytes
+// bytes total with the condition that the total gasWanted must be less than
+// maxGas. If both maxes are negative, all transactions are returned.
+func reapMaxBytesMaxGas(memTxs []*mempoolTx, maxBytes, maxGas int64) types.Txs {
+	var (
+		totalGas    int64
+		runningSize int64
+	)
+
+	txs := make([]types.Tx, 0, len(memTxs))
+	for _, memTx := range memTxs {
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			return txs
+		}
+
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		// Since newTotalGas < masGas, which
+		// must be non-negative, it follows that this won't overflow.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return txs
+		}
+
+		runningSize += dataSize
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+// reapMaxTxs returns the first max transactions of memTxs. If max is
+// negative, all transactions are returned.
+func reapMaxTxs(memTxs []*mempoolTx, max int) types.Txs {
+	if max < 0 {
+		max = len(memTxs)
+	}
+
+	txs := make([]types.Tx, 0, cmtmath.MinInt(len(memTxs), max))
+	for _, memTx := range memTxs[:cap(txs)] {
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+// ReplayJournal checks the journaled transactions again with the application,
+// in the order they were first added to the mempool, and adds the valid ones
+// back to the mempool. Transactions that are not added back are deleted from
+// the journal. It does nothing if the journal is disabled.
+//
+// NOTE: not thread safe - should only be called once, on startup, after the
+// handshake with the application.
+func (mem *CListMempool) ReplayJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+
+	entries, err := mem.journal.load(time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to load mempool journal: %w", err)
+	}
+	for _, entry := range entries {
+		if _, err := mem.CheckTx(entry.tx); err != nil {
+			mem.logger.Debug("journaled transaction not replayed", "tx", entry.tx.Hash(), "err", err)
+		}
+	}
+	// Wait for the application to check all transactions.
+	if err := mem.FlushAppConn(); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !mem.InMempool(entry.tx.Key()) {
+			if err := mem.journal.remove(entry.tx.Key()); err != nil {
+				return fmt.Errorf("failed to remove transaction from journal: %w", err)
+			}
+		}
+	}
+	mem.logger.Info("replayed mempool journal", "journaled", len(entries), "added", mem.Size())
+	return nil
+}
+
 // Lock() must be help by the caller during execution.
 // TODO: this function always returns nil; remove the return value.
 func (mem *CListMempool) Update(
@@ -622,13 +1162,16 @@ func (mem *CListMempool) Update(
 		// Mempool after:
 		//   100
 		// https://github.com/tendermint/tendermint/issues/3322.
-		if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+		if err := mem.removeTx(tx.Key(), TxRemovedCommitted); err != nil {
 			mem.logger.Debug("Committed transaction not in local mempool (not an error)",
 				"key", "tx.Key()",
 				"error", err.Error())
 		}
 	}
 
+	// Remove expired txs, so that they are not rechecked.
+	mem.purgeExpiredTxs(height)
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -644,12 +1187,43 @@ func (mem *CListMempool) Update(
 	}
 
 	// Update metrics
-	mem.metrics.Size.Set(float64(mem.Size()))
-	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+	mem.updateSizeMetrics()
 
 	return nil
 }
 
+// purgeExpiredTxs removes the transactions that have been in the mempool for
+// more than TTLNumBlocks blocks or TTLDuration, if set. Expired transactions
+// are also removed from the cache, unless KeepInvalidTxsInCache is set.
+//
+// Lock() must be held by the caller during execution.
+func (mem *CListMempool) purgeExpiredTxs(height int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	var (
+		now     = time.Now()
+		expired []*mempoolTx
+	)
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if (mem.config.TTLNumBlocks > 0 && height-memTx.Height() > mem.config.TTLNumBlocks) ||
+			(mem.config.TTLDuration > 0 && now.Sub(memTx.timestamp) > mem.config.TTLDuration) {
+			expired = append(expired, memTx)
+		}
+	}
+
+	for _, memTx := range expired {
+		if err := mem.removeTx(memTx.tx.Key(), TxRemovedExpired); err != nil {
+			continue
+		}
+		mem.tryRemoveFromCache(memTx.tx)
+		mem.metrics.ExpiredTxs.Add(1)
+		mem.logger.Debug("removed expired transaction", "tx", memTx.tx.Hash(), "height", memTx.Height())
+	}
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		panic("recheckTxs is called, but the mempool is empty")
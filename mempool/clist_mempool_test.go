@@ -7,6 +7,7 @@ import (
 	mrand "math/rand"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -262,6 +263,296 @@ func TestMempoolUpdate(t *testing.T) {
 	}
 }
 
+type mempoolTxEvents []types.EventDataMempoolTx
+
+func (e *mempoolTxEvents) PublishEventMempoolTx(data types.EventDataMempoolTx) error {
+	*e = append(*e, data)
+	return nil
+}
+
+func TestMempoolEvents(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	events := &mempoolTxEvents{}
+	mp.eventBus = events
+
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2")}
+	callCheckTx(t, mp, txs)
+
+	// Committed transactions that were not in the mempool are not reported.
+	committed := types.Txs{txs[0], types.Tx("c=3")}
+	mp.Lock()
+	require.NoError(t, mp.Update(1, committed, abciResponses(len(committed), abci.CodeTypeOK), nil, nil))
+	// Events raised during Update are only published once the mempool is
+	// unlocked.
+	require.Len(t, *events, len(txs))
+	mp.Unlock()
+	mp.Flush()
+
+	require.Len(t, *events, 4)
+	for i, tx := range txs {
+		event := (*events)[i]
+		assert.False(t, event.Removed)
+		assert.Equal(t, tx, event.Tx)
+		assert.EqualValues(t, tx.Hash(), event.Hash)
+	}
+	assert.Equal(t, types.EventDataMempoolTx{Hash: txs[0].Hash(), Removed: true, Reason: "committed"}, (*events)[2])
+	assert.Equal(t, types.EventDataMempoolTx{Hash: txs[1].Hash(), Removed: true, Reason: "flushed"}, (*events)[3])
+}
+
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.TTLNumBlocks = 2
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	removed := make(map[types.TxKey]TxRemovalReason)
+	mp.SetTxRemovedCallback(func(txKey types.TxKey, reason TxRemovalReason) {
+		removed[txKey] = reason
+	})
+
+	update := func(height int64, txs types.Txs) {
+		mp.Lock()
+		defer mp.Unlock()
+		require.NoError(t, mp.Update(height, txs, abciResponses(len(txs), abci.CodeTypeOK), nil, nil))
+	}
+
+	// 1. Transactions expire after TTLNumBlocks blocks.
+	tx1 := types.Tx(kvstore.NewTxFromID(1))
+	callCheckTx(t, mp, types.Txs{tx1})
+	update(1, nil)
+	tx2 := types.Tx(kvstore.NewTxFromID(2))
+	callCheckTx(t, mp, types.Txs{tx2})
+	update(2, nil)
+	require.Equal(t, 2, mp.Size())
+
+	update(3, types.Txs{tx2})
+	require.Zero(t, mp.Size())
+	require.Equal(t, TxRemovedExpired, removed[tx1.Key()])
+	require.Equal(t, TxRemovedCommitted, removed[tx2.Key()])
+
+	// Expired transactions are removed from the cache, so they can be
+	// submitted again.
+	require.False(t, mp.cache.Has(tx1))
+
+	// 2. Transactions expire after TTLDuration.
+	cfg.Mempool.TTLNumBlocks = 0
+	cfg.Mempool.TTLDuration = 100 * time.Millisecond
+	tx3 := types.Tx(kvstore.NewTxFromID(3))
+	callCheckTx(t, mp, types.Txs{tx3})
+	update(4, nil)
+	require.Equal(t, 1, mp.Size())
+
+	time.Sleep(cfg.Mempool.TTLDuration)
+	update(5, nil)
+	require.Zero(t, mp.Size())
+	require.Equal(t, TxRemovedExpired, removed[tx3.Key()])
+}
+
+// senderApp is a priorityApp that assigns each transaction of the form
+// "sender/nonce/key=value" to the given sender and nonce.
+type senderApp struct {
+	priorityApp
+}
+
+func (app *senderApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res, err := app.priorityApp.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	if parts := strings.SplitN(string(req.Tx), "/", 3); len(parts) == 3 {
+		res.Sender = parts[0]
+		res.Nonce, _ = strconv.ParseUint(parts[1], 10, 64)
+	}
+	return res, nil
+}
+
+func TestMempoolReplaceTx(t *testing.T) {
+	app := &senderApp{priorityApp{Application: kvstore.NewInMemoryApplication()}}
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	defer cleanup()
+
+	removed := make(map[types.TxKey]TxRemovalReason)
+	mp.SetTxRemovedCallback(func(txKey types.TxKey, reason TxRemovalReason) {
+		removed[txKey] = reason
+	})
+
+	txs := types.Txs{
+		types.Tx("alice/1/a=1"),
+		types.Tx("alice/2/b=1"),
+		types.Tx("bob/1/c=1"),
+		types.Tx("d=1"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// A transaction with the same sender and nonce, and a higher priority,
+	// replaces the existing one, which is removed from the cache. The
+	// transactions of a sender are reaped in nonce order.
+	replacement := types.Tx("alice/1/e=2")
+	callCheckTx(t, mp, types.Txs{replacement})
+	require.Equal(t, types.Txs{replacement, txs[2], txs[3], txs[1]}, mp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{replacement, txs[2]}, mp.ReapMaxTxs(2))
+	require.Equal(t, types.Txs{replacement, txs[2], txs[3], txs[1]}, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, TxRemovedReplaced, removed[txs[0].Key()])
+	require.False(t, mp.cache.Has(txs[0]))
+
+	// A transaction with the same sender and nonce, and a lower or equal
+	// priority, is rejected. The client is told why, although the
+	// application accepted it.
+	rejected := types.Txs{types.Tx("alice/1/f=2"), types.Tx("alice/1/g=1")}
+	for _, tx := range rejected {
+		reqRes, err := mp.CheckTx(tx)
+		require.NoError(t, err)
+		res := reqRes.Response.GetCheckTx()
+		require.Equal(t, CodeTxNotReplaced, res.Code)
+		require.Equal(t, Codespace, res.Codespace)
+		require.Contains(t, res.Log, `tx with sender "alice" and nonce 1 cannot replace the tx in the mempool`)
+		require.False(t, mp.InMempool(tx.Key()))
+		require.False(t, mp.cache.Has(tx))
+	}
+	require.True(t, mp.InMempool(replacement.Key()))
+
+	// Once the transaction is removed, its slot is free again.
+	require.NoError(t, mp.RemoveTxByKey(replacement.Key()))
+	tx := types.Tx("alice/1/h=0")
+	callCheckTx(t, mp, types.Txs{tx})
+	require.True(t, mp.InMempool(tx.Key()))
+}
+
+func TestMempoolSenderLimits(t *testing.T) {
+	app := &senderApp{priorityApp{Application: kvstore.NewInMemoryApplication()}}
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsPerSender = 2
+	cfg.Mempool.MaxTxsBytesPerSender = 24
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(app), cfg)
+	defer cleanup()
+
+	// Each transaction is 11 bytes long.
+	txs := types.Txs{
+		types.Tx("alice/1/a=1"),
+		types.Tx("alice/2/b=1"),
+		types.Tx("alice/3/c=1"),
+		types.Tx("bob/1/dd=1"),
+		types.Tx("e=1"),
+		types.Tx("f=1"),
+		types.Tx("g=1"),
+	}
+	callCheckTx(t, mp, txs)
+
+	// Alice's third transaction is rejected; transactions without a sender
+	// are not limited.
+	require.Equal(t, types.Txs{txs[0], txs[1], txs[3], txs[4], txs[5], txs[6]}, mp.ReapMaxTxs(-1))
+	require.False(t, mp.cache.Has(txs[2]))
+	numTxs, txsBytes := mp.SenderUsage("alice")
+	require.Equal(t, 2, numTxs)
+	require.EqualValues(t, 22, txsBytes)
+
+	// A replacement does not count twice.
+	replacement := types.Tx("alice/2/h=2")
+	callCheckTx(t, mp, types.Txs{replacement})
+	require.True(t, mp.InMempool(replacement.Key()))
+	numTxs, txsBytes = mp.SenderUsage("alice")
+	require.Equal(t, 2, numTxs)
+	require.EqualValues(t, 22, txsBytes)
+
+	// Bob's second transaction does not fit in his byte limit. The client is
+	// told why, although the application accepted it.
+	tx := types.Tx("bob/2/iiiiiii=1")
+	reqRes, err := mp.CheckTx(tx)
+	require.NoError(t, err)
+	res := reqRes.Response.GetCheckTx()
+	require.Equal(t, CodeSenderIsFull, res.Code)
+	require.Equal(t, Codespace, res.Codespace)
+	require.Contains(t, res.Log, `sender "bob" has too many txs in the mempool`)
+	require.False(t, mp.InMempool(tx.Key()))
+	numTxs, txsBytes = mp.SenderUsage("bob")
+	require.Equal(t, 1, numTxs)
+	require.EqualValues(t, 10, txsBytes)
+
+	// Once a transaction is removed, the sender has room again.
+	require.NoError(t, mp.RemoveTxByKey(txs[0].Key()))
+	callCheckTx(t, mp, types.Txs{txs[2]})
+	require.True(t, mp.InMempool(txs[2].Key()))
+
+	numTxs, txsBytes = mp.SenderUsage("carol")
+	require.Zero(t, numTxs)
+	require.Zero(t, txsBytes)
+}
+
+func TestMempoolRejectedReplacementKeepsTx(t *testing.T) {
+	app := &senderApp{priorityApp{Application: kvstore.NewInMemoryApplication()}}
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsBytesPerSender = 24
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(app), cfg)
+	defer cleanup()
+
+	removed := make(map[types.TxKey]TxRemovalReason)
+	mp.SetTxRemovedCallback(func(txKey types.TxKey, reason TxRemovalReason) {
+		removed[txKey] = reason
+	})
+
+	tx := types.Tx("alice/1/a=1")
+	callCheckTx(t, mp, types.Txs{tx})
+	require.True(t, mp.InMempool(tx.Key()))
+
+	// The replacement has a higher priority, but does not fit in the sender's
+	// byte limit even once the transaction it replaces is removed. Both the
+	// replacement is rejected and the replaced transaction is kept.
+	replacement := types.Tx("alice/1/bbbbbbbbbbbbbbbbbbbb=2")
+	callCheckTx(t, mp, types.Txs{replacement})
+	require.False(t, mp.InMempool(replacement.Key()))
+	require.True(t, mp.InMempool(tx.Key()))
+	require.True(t, mp.cache.Has(tx))
+	require.NotContains(t, removed, tx.Key())
+	numTxs, txsBytes := mp.SenderUsage("alice")
+	require.Equal(t, 1, numTxs)
+	require.EqualValues(t, len(tx), txsBytes)
+
+	// A replacement that only fits once the transaction it replaces is
+	// removed is admitted.
+	replacement = types.Tx("alice/1/cccccccccc=2")
+	callCheckTx(t, mp, types.Txs{replacement})
+	require.True(t, mp.InMempool(replacement.Key()))
+	require.False(t, mp.InMempool(tx.Key()))
+	require.Equal(t, TxRemovedReplaced, removed[tx.Key()])
+}
+
+// Transactions that the application accepted, but that the mempool rejected,
+// have a CheckTx response with a mempool code.
+func TestMempoolRejectionCodes(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 1
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), cfg)
+	defer cleanup()
+
+	// The mempool is full once the application checked the transaction, e.g.
+	// because another transaction was added in the meantime.
+	callCheckTx(t, mp, types.Txs{kvstore.NewTxFromID(1)})
+	tx2 := types.Tx(kvstore.NewTxFromID(2))
+	res := &abci.CheckTxResponse{Code: abci.CodeTypeOK}
+	mp.resCbFirstTime(tx2, res)
+	require.Equal(t, CodeMempoolIsFull, res.Code)
+	require.Equal(t, Codespace, res.Codespace)
+	require.Contains(t, res.Log, "mempool is full")
+	require.False(t, mp.InMempool(tx2.Key()))
+
+	// The transaction fails the post-check.
+	mp.Flush()
+	mp.postCheck = PostCheckMaxGas(0)
+	reqRes, err := mp.CheckTx(tx2)
+	require.NoError(t, err)
+	res = reqRes.Response.GetCheckTx()
+	require.Equal(t, CodePostCheck, res.Code)
+	require.Equal(t, Codespace, res.Codespace)
+	require.Contains(t, res.Log, "tx post check: gas wanted 1 is greater than max gas 0")
+	require.False(t, mp.InMempool(tx2.Key()))
+}
+
 // Test dropping CheckTx requests when rechecking transactions. It mocks an asynchronous connection
 // to the app.
 func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
@@ -0,0 +1,99 @@
+package mempool
+
+import (
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Dump is the content of the mempool, as written by `cometbft mempool dump`.
+type Dump struct {
+	// Height of the last block the mempool was updated with.
+	Height int64 `json:"height"`
+	// Transactions in the order they were added to the mempool.
+	Txs []DumpedTx `json:"txs"`
+}
+
+// DumpedTx is a transaction in a mempool dump, along with its metadata.
+type DumpedTx struct {
+	Tx        types.Tx  `json:"tx"`
+	Height    int64     `json:"height"`    // height at which the tx was validated
+	Timestamp time.Time `json:"timestamp"` // time at which the tx was added to the mempool
+	GasWanted int64     `json:"gas_wanted"`
+	Priority  int64     `json:"priority"`
+	Lane      string    `json:"lane,omitempty"`
+	Sender    string    `json:"sender,omitempty"`
+	Nonce     uint64    `json:"nonce,omitempty"`
+	// Peers the transaction was received from. Empty if the transaction was
+	// submitted to this node.
+	Peers []p2p.ID `json:"peers,omitempty"`
+}
+
+// Dump returns all the transactions in the mempool, with their metadata and
+// the peers they were received from.
+func (memR *Reactor) Dump() *Dump {
+	dump := &Dump{Height: memR.mempool.height.Load()}
+	for e := memR.mempool.TxsFront(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		dump.Txs = append(dump.Txs, DumpedTx{
+			Tx:        memTx.tx,
+			Height:    memTx.Height(),
+			Timestamp: memTx.timestamp,
+			GasWanted: memTx.gasWanted,
+			Priority:  memTx.priority,
+			Lane:      memTx.lane,
+			Sender:    memTx.sender,
+			Nonce:     memTx.nonce,
+			Peers:     memR.txSenderIDs(memTx.tx.Key()),
+		})
+	}
+	return dump
+}
+
+// txSenderIDs returns the sorted IDs of the peers that sent the transaction
+// with the given key.
+func (memR *Reactor) txSenderIDs(txKey types.TxKey) []p2p.ID {
+	memR.txSendersMtx.Lock()
+	defer memR.txSendersMtx.Unlock()
+
+	var ids []p2p.ID
+	for id := range memR.txSenders[txKey] {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ImportDump replaces the content of the mempool journal in db with the
+// transactions of dump, so that they are checked again and added to the
+// mempool, in the same order, when the node starts with the journal enabled.
+// The times at which transactions were added to the mempool are shifted so
+// that the last one is now, so they are not dropped by the journal's maximum
+// age unless the dump spans a longer period.
+//
+// The import is lossy: the journal only records transactions and the times
+// they were added. The other metadata is assigned again by the application
+// when the transactions are checked on replay, and the peers are discarded.
+func ImportDump(db dbm.DB, dump *Dump) error {
+	j := newTxJournal(db, 0, 0)
+	if err := j.reset(); err != nil {
+		return err
+	}
+
+	var last time.Time
+	for _, dtx := range dump.Txs {
+		if dtx.Timestamp.After(last) {
+			last = dtx.Timestamp
+		}
+	}
+	shift := time.Since(last)
+	for _, dtx := range dump.Txs {
+		if err := j.add(dtx.Tx, dtx.Timestamp.Add(shift)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMempoolDumpImport(t *testing.T) {
+	mp, _ := newMempoolWithJournal(t, dbm.NewMemDB())
+	memR := NewReactor(mp.config, mp, false)
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")}
+	callCheckTx(t, mp, txs)
+	memR.addSender(txs[1].Key(), "peer2")
+	memR.addSender(txs[1].Key(), "peer1")
+
+	dump := memR.Dump()
+	require.Len(t, dump.Txs, len(txs))
+	for i, dtx := range dump.Txs {
+		require.Equal(t, txs[i], dtx.Tx)
+	}
+	require.Empty(t, dump.Txs[0].Peers)
+	require.Equal(t, []p2p.ID{"peer1", "peer2"}, dump.Txs[1].Peers)
+
+	// The imported transactions replace the content of the journal, and are
+	// replayed in order, keeping their relative ages.
+	db := dbm.NewMemDB()
+	require.NoError(t, newTxJournal(db, 0, 0).add(types.Tx("d=4"), time.Now()))
+	dump.Txs[0].Timestamp = dump.Txs[0].Timestamp.Add(-30 * time.Minute)
+	require.NoError(t, ImportDump(db, dump))
+
+	mp, _ = newMempoolWithJournal(t, db)
+	require.NoError(t, mp.ReplayJournal())
+	require.Equal(t, txs, mp.ReapMaxTxs(-1))
+}
@@ -5,6 +5,33 @@ import (
 	"fmt"
 )
 
+// Codespace is the codespace of the CheckTx responses of the transactions
+// that the application accepted, but that the mempool rejected.
+const Codespace = "mempool"
+
+// CodeSenderIsFull is the code of the CheckTx response of a transaction that
+// was rejected because its sender cannot have more transactions in the
+// mempool (see ErrSenderIsFull).
+const CodeSenderIsFull uint32 = 1
+
+// CodeTxNotReplaced is the code of the CheckTx response of a transaction that
+// was rejected because a transaction with the same sender and nonce, and a
+// higher or equal priority, is in the mempool (see ErrTxNotReplaced).
+const CodeTxNotReplaced uint32 = 2
+
+// CodeMempoolIsFull is the code of the CheckTx response of a transaction that
+// was rejected because the mempool was full once the application checked it
+// (see ErrMempoolIsFull).
+const CodeMempoolIsFull uint32 = 3
+
+// CodeLaneIsFull is the code of the CheckTx response of a transaction that was
+// rejected because its lane was full (see ErrLaneIsFull).
+const CodeLaneIsFull uint32 = 4
+
+// CodePostCheck is the code of the CheckTx response of a transaction that
+// failed the post-check of the mempool (see ErrPostCheck).
+const CodePostCheck uint32 = 5
+
 // ErrTxNotFound is returned to the client if tx is not found in mempool.
 var ErrTxNotFound = errors.New("transaction not found in mempool")
 
@@ -41,6 +68,68 @@ func (e ErrMempoolIsFull) Error() string {
 	)
 }
 
+// ErrLaneIsFull defines an error where a mempool lane cannot accept more
+// transactions.
+type ErrLaneIsFull struct {
+	Lane        string
+	NumTxs      int
+	MaxTxs      int
+	TxsBytes    int64
+	MaxTxsBytes int64
+}
+
+func (e ErrLaneIsFull) Error() string {
+	return fmt.Sprintf(
+		"mempool lane %q is full: number of txs %d (max: %d), total txs bytes %d (max: %d)",
+		e.Lane,
+		e.NumTxs,
+		e.MaxTxs,
+		e.TxsBytes,
+		e.MaxTxsBytes,
+	)
+}
+
+// ErrSenderIsFull defines an error where a sender cannot have more
+// transactions in the mempool.
+type ErrSenderIsFull struct {
+	Sender      string
+	NumTxs      int
+	MaxTxs      int
+	TxsBytes    int64
+	MaxTxsBytes int64
+}
+
+func (e ErrSenderIsFull) Error() string {
+	return fmt.Sprintf(
+		"sender %q has too many txs in the mempool: number of txs %d (max: %d), total txs bytes %d (max: %d)",
+		e.Sender,
+		e.NumTxs,
+		e.MaxTxs,
+		e.TxsBytes,
+		e.MaxTxsBytes,
+	)
+}
+
+// ErrTxNotReplaced defines an error where a transaction cannot replace the
+// transaction with the same sender and nonce in the mempool, because its
+// priority is not higher.
+type ErrTxNotReplaced struct {
+	Sender           string
+	Nonce            uint64
+	Priority         int64
+	ReplacedPriority int64
+}
+
+func (e ErrTxNotReplaced) Error() string {
+	return fmt.Sprintf(
+		"tx with sender %q and nonce %d cannot replace the tx in the mempool: priority %d (min: %d)",
+		e.Sender,
+		e.Nonce,
+		e.Priority,
+		e.ReplacedPriority+1,
+	)
+}
+
 // ErrPreCheck defines an error where a transaction fails a pre-check.
 type ErrPreCheck struct {
 	Err error
@@ -59,6 +148,19 @@ func IsPreCheckError(err error) bool {
 	return errors.As(err, &ErrPreCheck{})
 }
 
+// ErrPostCheck defines an error where a transaction fails a post-check.
+type ErrPostCheck struct {
+	Err error
+}
+
+func (e ErrPostCheck) Error() string {
+	return fmt.Sprintf("tx post check: %v", e.Err)
+}
+
+func (e ErrPostCheck) Unwrap() error {
+	return e.Err
+}
+
 type ErrCheckTxAsync struct {
 	Err error
 }
@@ -0,0 +1,114 @@
+package mempool
+
+import (
+	"math/rand"
+	"sort"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// fanoutAdaptationWindow is the number of transactions received from peers
+// over which the duplicate ratio is measured before adapting the fan-out.
+const fanoutAdaptationWindow = 100
+
+// fanoutController keeps track of the number of peers each transaction is
+// relayed to. If the fan-out is adaptive, it is decreased when the ratio of
+// duplicate transactions received from peers is above the target ratio, and
+// increased when it is below.
+type fanoutController struct {
+	mtx cmtsync.Mutex
+
+	max         int
+	adaptive    bool
+	targetRatio float64
+
+	current    int
+	received   int
+	duplicates int
+
+	metrics *Metrics
+}
+
+func newFanoutController(config *cfg.MempoolConfig, metrics *Metrics) *fanoutController {
+	c := &fanoutController{
+		max:         config.GossipFanout,
+		adaptive:    config.AdaptiveGossipFanout,
+		targetRatio: config.GossipTargetDuplicateRatio,
+		current:     config.GossipFanout,
+		metrics:     metrics,
+	}
+	if c.max > 0 {
+		c.metrics.GossipFanout.Set(float64(c.current))
+	}
+	return c
+}
+
+// fanout returns the number of peers each transaction should be relayed to,
+// or 0 if there is no limit.
+func (c *fanoutController) fanout() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.current
+}
+
+// recordReceived records a transaction received from a peer, and whether it
+// had already been received before. Once enough transactions have been
+// received, the fan-out is adapted if needed.
+func (c *fanoutController) recordReceived(duplicate bool) {
+	if !c.adaptive {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.received++
+	if duplicate {
+		c.duplicates++
+	}
+	if c.received < fanoutAdaptationWindow {
+		return
+	}
+
+	ratio := float64(c.duplicates) / float64(c.received)
+	switch {
+	case ratio > c.targetRatio && c.current > 1:
+		c.current--
+	case ratio < c.targetRatio && c.current < c.max:
+		c.current++
+	}
+	c.received, c.duplicates = 0, 0
+
+	c.metrics.DuplicateTxsRatio.Set(ratio)
+	c.metrics.GossipFanout.Set(float64(c.current))
+}
+
+// selectPeers returns up to n of the given peers, either at random or, if
+// selection is GossipPeerSelectionLatency, the ones with the lowest
+// round-trip time. Peers with an unknown round-trip time come last.
+func selectPeers(peers []p2p.Peer, n int, selection string) []p2p.Peer {
+	// Shuffle first, so that ties are broken at random.
+	rand.Shuffle(len(peers), func(i, j int) { peers[i], peers[j] = peers[j], peers[i] })
+
+	if selection == cfg.GossipPeerSelectionLatency {
+		rtts := make(map[p2p.ID]int64, len(peers))
+		for _, peer := range peers {
+			rtts[peer.ID()] = int64(peer.Status().RTT)
+		}
+		sort.SliceStable(peers, func(i, j int) bool {
+			rttI, rttJ := rtts[peers[i].ID()], rtts[peers[j].ID()]
+			if rttI == 0 || rttJ == 0 {
+				return rttJ == 0 && rttI != 0
+			}
+			return rttI < rttJ
+		})
+	}
+
+	if len(peers) > n {
+		peers = peers[:n]
+	}
+	return peers
+}
@@ -0,0 +1,88 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+)
+
+func TestFanoutControllerAdapts(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.GossipFanout = 3
+	config.AdaptiveGossipFanout = true
+	config.GossipTargetDuplicateRatio = 0.5
+	c := newFanoutController(config, NopMetrics())
+	require.Equal(t, 3, c.fanout())
+
+	receive := func(duplicates int) {
+		for i := 0; i < fanoutAdaptationWindow; i++ {
+			c.recordReceived(i < duplicates)
+		}
+	}
+
+	// Too many duplicates: decrease down to 1.
+	receive(80)
+	require.Equal(t, 2, c.fanout())
+	receive(80)
+	require.Equal(t, 1, c.fanout())
+	receive(80)
+	require.Equal(t, 1, c.fanout())
+
+	// On target: no change.
+	receive(50)
+	require.Equal(t, 1, c.fanout())
+
+	// Too few duplicates: increase up to the configured fan-out.
+	for i := 0; i < 5; i++ {
+		receive(10)
+	}
+	require.Equal(t, 3, c.fanout())
+}
+
+func TestFanoutControllerNotAdaptive(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.GossipFanout = 2
+	c := newFanoutController(config, NopMetrics())
+
+	for i := 0; i < 10*fanoutAdaptationWindow; i++ {
+		c.recordReceived(true)
+	}
+	require.Equal(t, 2, c.fanout())
+}
+
+func newPeerWithRTT(id p2p.ID, rtt time.Duration) p2p.Peer {
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(id)
+	peer.On("Status").Return(conn.ConnectionStatus{RTT: rtt})
+	return peer
+}
+
+func TestSelectPeers(t *testing.T) {
+	newPeers := func() []p2p.Peer {
+		return []p2p.Peer{
+			newPeerWithRTT("a", 30*time.Millisecond),
+			newPeerWithRTT("b", 0), // unknown
+			newPeerWithRTT("c", 10*time.Millisecond),
+			newPeerWithRTT("d", 20*time.Millisecond),
+		}
+	}
+	ids := func(peers []p2p.Peer) []p2p.ID {
+		res := make([]p2p.ID, len(peers))
+		for i, peer := range peers {
+			res[i] = peer.ID()
+		}
+		return res
+	}
+
+	require.Len(t, selectPeers(newPeers(), 2, cfg.GossipPeerSelectionRandom), 2)
+	require.Len(t, selectPeers(newPeers(), 10, cfg.GossipPeerSelectionRandom), 4)
+
+	require.Equal(t, []p2p.ID{"c", "d"}, ids(selectPeers(newPeers(), 2, cfg.GossipPeerSelectionLatency)))
+	require.Equal(t, []p2p.ID{"c", "d", "a", "b"}, ids(selectPeers(newPeers(), 4, cfg.GossipPeerSelectionLatency)))
+}
@@ -0,0 +1,200 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// prefixJournalTx is the prefix of the keys of journaled transactions. Keys
+// are followed by the transaction key; values are the time at which the
+// transaction was added to the mempool (in Unix nanoseconds, big endian),
+// followed by the transaction itself.
+const prefixJournalTx = byte(0x01)
+
+var errJournalFull = errors.New("mempool journal is full")
+
+// txJournal is an on-disk record of the transactions in the mempool, so that
+// they can be checked again and re-added to the mempool after a restart.
+type txJournal struct {
+	db       dbm.DB
+	maxAge   time.Duration // 0 means no limit
+	maxBytes int64         // 0 means no limit
+
+	mtx   cmtsync.Mutex
+	bytes int64 // total size of the journaled transactions
+}
+
+// journalEntry is a transaction read from the journal.
+type journalEntry struct {
+	tx        types.Tx
+	timestamp time.Time
+}
+
+func newTxJournal(db dbm.DB, maxAge time.Duration, maxBytes int64) *txJournal {
+	return &txJournal{
+		db:       db,
+		maxAge:   maxAge,
+		maxBytes: maxBytes,
+	}
+}
+
+func journalKey(txKey types.TxKey) []byte {
+	return append([]byte{prefixJournalTx}, txKey[:]...)
+}
+
+func encodeJournalEntry(tx types.Tx, timestamp time.Time) []byte {
+	bz := make([]byte, 8+len(tx))
+	binary.BigEndian.PutUint64(bz, uint64(timestamp.UnixNano()))
+	copy(bz[8:], tx)
+	return bz
+}
+
+func decodeJournalEntry(bz []byte) (journalEntry, error) {
+	if len(bz) < 8 {
+		return journalEntry{}, fmt.Errorf("invalid journal entry of %d bytes", len(bz))
+	}
+	return journalEntry{
+		tx:        types.Tx(bz[8:]),
+		timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(bz))),
+	}, nil
+}
+
+// load returns the journaled transactions, in the order they were added to
+// the mempool. Transactions older than maxAge are deleted from the journal,
+// as are the oldest transactions if the journal is larger than maxBytes.
+func (j *txJournal) load(now time.Time) ([]journalEntry, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	iter, err := dbm.IteratePrefix(j.db, []byte{prefixJournalTx})
+	if err != nil {
+		return nil, err
+	}
+	var entries []journalEntry
+	for ; iter.Valid(); iter.Next() {
+		entry, err := decodeJournalEntry(iter.Value())
+		if err != nil {
+			iter.Close()
+			return nil, err
+		}
+		// The iterator's value may be reused by the next iteration.
+		entry.tx = append(types.Tx(nil), entry.tx...)
+		entries = append(entries, entry)
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return nil, err
+	}
+	iter.Close()
+
+	// Sort by descending time, so that the newest transactions are kept if
+	// the journal is too large.
+	sort.SliceStable(entries, func(i, k int) bool {
+		return entries[i].timestamp.After(entries[k].timestamp)
+	})
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+
+	j.bytes = 0
+	kept := entries[:0]
+	for _, entry := range entries {
+		expired := j.maxAge > 0 && now.Sub(entry.timestamp) > j.maxAge
+		tooLarge := j.maxBytes > 0 && j.bytes+int64(len(entry.tx)) > j.maxBytes
+		if expired || tooLarge {
+			if err := batch.Delete(journalKey(entry.tx.Key())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		j.bytes += int64(len(entry.tx))
+		kept = append(kept, entry)
+	}
+	if err := batch.WriteSync(); err != nil {
+		return nil, err
+	}
+
+	for i, k := 0, len(kept)-1; i < k; i, k = i+1, k-1 {
+		kept[i], kept[k] = kept[k], kept[i]
+	}
+	return kept, nil
+}
+
+// add records tx, added to the mempool at the given time. It does nothing if
+// tx is already in the journal, and returns errJournalFull if there is no
+// room for it.
+func (j *txJournal) add(tx types.Tx, timestamp time.Time) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	key := journalKey(tx.Key())
+	if has, err := j.db.Has(key); err != nil || has {
+		return err
+	}
+	if j.maxBytes > 0 && j.bytes+int64(len(tx)) > j.maxBytes {
+		return errJournalFull
+	}
+	if err := j.db.Set(key, encodeJournalEntry(tx, timestamp)); err != nil {
+		return err
+	}
+	j.bytes += int64(len(tx))
+	return nil
+}
+
+// remove deletes the transaction with the given key from the journal, if it
+// is there.
+func (j *txJournal) remove(txKey types.TxKey) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	key := journalKey(txKey)
+	bz, err := j.db.Get(key)
+	if err != nil || bz == nil {
+		return err
+	}
+	if err := j.db.Delete(key); err != nil {
+		return err
+	}
+	j.bytes -= int64(len(bz) - 8)
+	return nil
+}
+
+// reset deletes all transactions from the journal.
+func (j *txJournal) reset() error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	iter, err := dbm.IteratePrefix(j.db, []byte{prefixJournalTx})
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return err
+	}
+	iter.Close()
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	j.bytes = 0
+	return nil
+}
@@ -0,0 +1,108 @@
+package mempool
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newMempoolWithJournal(t *testing.T, db dbm.DB) (*CListMempool, *kvstore.Application) {
+	t.Helper()
+
+	cfg := test.ResetTestRoot("mempool_test")
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	appConnMem, _ := cc.NewABCIMempoolClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+
+	mp := NewCListMempool(cfg.Mempool, appConnMem, 0, WithJournal(db))
+	mp.SetLogger(log.TestingLogger())
+
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	return mp, app
+}
+
+func TestMempoolJournalReplay(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	mp, app := newMempoolWithJournal(t, db)
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3"), types.Tx("d=4")}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// Committed transactions are removed from the journal.
+	doCommit(t, mp, app, txs[:1], 1)
+
+	// Invalid transactions are not replayed, and are removed from the
+	// journal.
+	invalid := types.Tx("invalid")
+	require.NoError(t, mp.journal.add(invalid, time.Now()))
+
+	// The remaining transactions are replayed in order after a restart.
+	mp, _ = newMempoolWithJournal(t, db)
+	mp.EnableTxsAvailable()
+	require.NoError(t, mp.ReplayJournal())
+	require.Equal(t, txs[1:], mp.ReapMaxTxs(-1))
+
+	// Consensus is notified of the replayed transactions.
+	select {
+	case <-mp.TxsAvailable():
+	default:
+		t.Fatal("expected TxsAvailable after replaying the journal")
+	}
+
+	entries, err := mp.journal.load(time.Now())
+	require.NoError(t, err)
+	require.Len(t, entries, len(txs)-1)
+
+	// Flushing the mempool resets the journal.
+	mp.Flush()
+	entries, err = mp.journal.load(time.Now())
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestTxJournalLimits(t *testing.T) {
+	db := dbm.NewMemDB()
+	now := time.Now()
+
+	j := newTxJournal(db, time.Hour, 10)
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=22"), types.Tx("c=3")}
+	require.NoError(t, j.add(txs[0], now.Add(-2*time.Hour)))
+	require.NoError(t, j.add(txs[1], now.Add(-20*time.Minute)))
+	require.NoError(t, j.add(txs[2], now.Add(-10*time.Minute)))
+
+	// Adding a transaction twice does nothing.
+	require.NoError(t, j.add(txs[2], now))
+
+	// There is no room left.
+	require.ErrorIs(t, j.add(types.Tx("d=4"), now), errJournalFull)
+
+	// The first transaction has expired, and only the newest transaction fits
+	// in the journal.
+	j = newTxJournal(db, time.Hour, 6)
+	entries, err := j.load(now)
+	require.NoError(t, err)
+	require.Equal(t, []journalEntry{{tx: txs[2], timestamp: time.Unix(0, now.Add(-10*time.Minute).UnixNano())}}, entries)
+
+	// Dropped transactions are deleted.
+	j = newTxJournal(db, 0, 0)
+	entries, err = j.load(now)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.NoError(t, j.remove(txs[2].Key()))
+	entries, err = j.load(now)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,144 @@
+package mempool
+
+import (
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+// lane keeps track of the transactions of a mempool lane. Transactions are
+// stored in the mempool's linked list regardless of their lane; a lane only
+// counts them and defines their share of the reaped transactions.
+type lane struct {
+	name        string
+	maxTxs      int   // 0 means no limit
+	maxTxsBytes int64 // 0 means no limit
+	weight      int
+
+	txs      atomic.Int64 // number of transactions in the lane
+	txsBytes atomic.Int64 // total size of the lane, in bytes
+}
+
+// lanes is the set of lanes of a mempool, in the order in which they are
+// visited when reaping transactions.
+type lanes struct {
+	order  []*lane
+	byName map[string]*lane
+}
+
+// newLanes returns the lanes defined in cfg, or nil if there are none. A
+// default lane with weight 1 and no limits is appended if cfg does not define
+// one.
+func newLanes(cfg []config.MempoolLaneConfig) *lanes {
+	if len(cfg) == 0 {
+		return nil
+	}
+
+	ls := &lanes{
+		order:  make([]*lane, 0, len(cfg)+1),
+		byName: make(map[string]*lane, len(cfg)+1),
+	}
+	for _, c := range cfg {
+		ls.add(&lane{
+			name:        c.Name,
+			maxTxs:      c.Size,
+			maxTxsBytes: c.MaxTxsBytes,
+			weight:      c.Weight,
+		})
+	}
+	if _, ok := ls.byName[config.DefaultMempoolLane]; !ok {
+		ls.add(&lane{name: config.DefaultMempoolLane, weight: 1})
+	}
+	return ls
+}
+
+func (ls *lanes) add(l *lane) {
+	ls.order = append(ls.order, l)
+	ls.byName[l.name] = l
+}
+
+// get returns the lane with the given name, or the default lane if there is
+// no such lane.
+func (ls *lanes) get(name string) *lane {
+	if l, ok := ls.byName[name]; ok {
+		return l
+	}
+	return ls.byName[config.DefaultMempoolLane]
+}
+
+// isFull returns an error if a transaction of txSize bytes does not fit in
+// the lane, once replaced, if not nil, is removed from it.
+func (l *lane) isFull(txSize int, replaced *mempoolTx) error {
+	var (
+		numTxs   = int(l.txs.Load())
+		txsBytes = l.txsBytes.Load()
+	)
+	if replaced != nil && replaced.lane == l.name {
+		numTxs--
+		txsBytes -= int64(len(replaced.tx))
+	}
+
+	if (l.maxTxs > 0 && numTxs >= l.maxTxs) ||
+		(l.maxTxsBytes > 0 && uint64(txSize)+uint64(txsBytes) > uint64(l.maxTxsBytes)) {
+		return ErrLaneIsFull{
+			Lane:        l.name,
+			NumTxs:      numTxs,
+			MaxTxs:      l.maxTxs,
+			TxsBytes:    txsBytes,
+			MaxTxsBytes: l.maxTxsBytes,
+		}
+	}
+
+	return nil
+}
+
+func (l *lane) addTx(memTx *mempoolTx) {
+	l.txs.Add(1)
+	l.txsBytes.Add(int64(len(memTx.tx)))
+}
+
+func (l *lane) removeTx(memTx *mempoolTx) {
+	l.txs.Add(-1)
+	l.txsBytes.Add(int64(-len(memTx.tx)))
+}
+
+func (ls *lanes) reset() {
+	for _, l := range ls.order {
+		l.txs.Store(0)
+		l.txsBytes.Store(0)
+	}
+}
+
+// updateMetrics reports the size of each lane.
+func (ls *lanes) updateMetrics(metrics *Metrics) {
+	for _, l := range ls.order {
+		metrics.LaneSize.With("lane", l.name).Set(float64(l.txs.Load()))
+		metrics.LaneSizeBytes.With("lane", l.name).Set(float64(l.txsBytes.Load()))
+	}
+}
+
+// interleave reorders memTxs in a weighted round-robin fashion: in each
+// round, up to weight transactions are taken from each lane, in the order in
+// which lanes are defined. The relative order of the transactions of a lane is
+// preserved.
+func (ls *lanes) interleave(memTxs []*mempoolTx) []*mempoolTx {
+	queues := make(map[*lane][]*mempoolTx, len(ls.order))
+	for _, memTx := range memTxs {
+		l := ls.get(memTx.lane)
+		queues[l] = append(queues[l], memTx)
+	}
+
+	res := make([]*mempoolTx, 0, len(memTxs))
+	for len(res) < len(memTxs) {
+		for _, l := range ls.order {
+			q := queues[l]
+			n := l.weight
+			if n > len(q) {
+				n = len(q)
+			}
+			res = append(res, q[:n]...)
+			queues[l] = q[n:]
+		}
+	}
+	return res
+}
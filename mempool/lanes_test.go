@@ -0,0 +1,139 @@
+package mempool
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// laneApp is a priorityApp that assigns each transaction of the form
+// "lane/key=value" to the given lane.
+type laneApp struct {
+	priorityApp
+}
+
+func newLaneApp() *laneApp {
+	return &laneApp{priorityApp{Application: kvstore.NewInMemoryApplication()}}
+}
+
+func (app *laneApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res, err := app.priorityApp.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	if i := strings.Index(string(req.Tx), "/"); i > 0 {
+		res.Lane = string(req.Tx[:i])
+	}
+	return res, nil
+}
+
+func TestMempoolLanesReap(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Lanes = []config.MempoolLaneConfig{
+		{Name: "oracle", Weight: 2},
+	}
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(newLaneApp()), cfg)
+	defer cleanup()
+
+	txs := types.Txs{
+		types.Tx("d1=0"),
+		types.Tx("d2=0"),
+		types.Tx("oracle/o1=0"),
+		types.Tx("d3=0"),
+		types.Tx("oracle/o2=0"),
+		types.Tx("unknown/d4=0"),
+		types.Tx("oracle/o3=0"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// Up to two oracle transactions for each transaction of the default lane.
+	expected := types.Txs{txs[2], txs[4], txs[0], txs[6], txs[1], txs[3], txs[5]}
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected[:4], mp.ReapMaxBytesMaxGas(-1, 4))
+	require.Equal(t, expected[:3], mp.ReapMaxTxs(3))
+}
+
+func TestMempoolLanesLimits(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Lanes = []config.MempoolLaneConfig{
+		{Name: "oracle", Size: 2, Weight: 1},
+		{Name: config.DefaultMempoolLane, MaxTxsBytes: 8, Weight: 1},
+	}
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(newLaneApp()), cfg)
+	defer cleanup()
+
+	oracle := mp.lanes.get("oracle")
+	defaultLane := mp.lanes.get(config.DefaultMempoolLane)
+
+	txs := types.Txs{
+		types.Tx("oracle/a=0"),
+		types.Tx("oracle/b=0"),
+		types.Tx("oracle/c=0"), // oracle lane is full
+		types.Tx("d1=0"),
+		types.Tx("d2=0"),
+		types.Tx("d3=0"), // default lane is full
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, 4, mp.Size())
+	require.EqualValues(t, 2, oracle.txs.Load())
+	require.EqualValues(t, 2, defaultLane.txs.Load())
+	require.EqualValues(t, 8, defaultLane.txsBytes.Load())
+
+	// Rejected transactions are removed from the cache, and the client is told
+	// why, although the application accepted them.
+	require.False(t, mp.cache.Has(txs[2]))
+	require.False(t, mp.cache.Has(txs[5]))
+	for _, tx := range []types.Tx{txs[2], txs[5]} {
+		reqRes, err := mp.CheckTx(tx)
+		require.NoError(t, err)
+		res := reqRes.Response.GetCheckTx()
+		require.Equal(t, CodeLaneIsFull, res.Code)
+		require.Equal(t, Codespace, res.Codespace)
+		require.Contains(t, res.Log, "is full")
+	}
+
+	require.NoError(t, mp.RemoveTxByKey(txs[0].Key()))
+	require.EqualValues(t, 1, oracle.txs.Load())
+	callCheckTx(t, mp, types.Txs{txs[2]})
+	require.EqualValues(t, 2, oracle.txs.Load())
+
+	mp.Flush()
+	require.EqualValues(t, 0, oracle.txs.Load())
+	require.EqualValues(t, 0, defaultLane.txsBytes.Load())
+}
+
+func TestPriorityMempoolLanesEviction(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Lanes = []config.MempoolLaneConfig{
+		{Name: "oracle", Size: 2, Weight: 1},
+	}
+	mp := newPriorityMempoolWithAppAndConfig(t, newLaneApp(), cfg)
+
+	txs := types.Txs{
+		types.Tx("d=0"),
+		types.Tx("oracle/a=1"),
+		types.Tx("oracle/b=2"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, 3, mp.Size())
+
+	// The oracle lane is full, so the lowest priority oracle transaction is
+	// evicted, even though the default lane has a lower priority one.
+	high := types.Tx("oracle/c=3")
+	callCheckTx(t, mp, types.Txs{high})
+	require.Equal(t, types.Txs{high, txs[0], txs[2]}, mp.ReapMaxTxs(-1))
+
+	// No oracle transaction has a lower priority.
+	callCheckTx(t, mp, types.Txs{types.Tx("oracle/e=2")})
+	require.Equal(t, 3, mp.Size())
+}
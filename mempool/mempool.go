@@ -12,6 +12,10 @@ import (
 const (
 	MempoolChannel = byte(0x30)
 
+	// MempoolAnnounceChannel is used by pull-based gossip to announce and
+	// request transactions by their keys.
+	MempoolAnnounceChannel = byte(0x31)
+
 	// PeerCatchupSleepIntervalMS defines how much time to sleep if a peer is behind.
 	PeerCatchupSleepIntervalMS = 100
 )
@@ -87,14 +91,65 @@ type Mempool interface {
 	EnableTxsAvailable()
 
 	// Set a callback function to be called when a transaction is removed from
-	// the mempool.
-	SetTxRemovedCallback(cb func(types.TxKey))
+	// the mempool, with the reason why it was removed.
+	SetTxRemovedCallback(cb func(types.TxKey, TxRemovalReason))
 
 	// Size returns the number of transactions in the mempool.
 	Size() int
 
 	// SizeBytes returns the total size of all txs in the mempool.
 	SizeBytes() int64
+
+	// SenderUsage returns the number and total size of the txs in the mempool
+	// that the application assigned to sender in CheckTx.
+	SenderUsage(sender string) (numTxs int, txsBytes int64)
+}
+
+// TxRemovalReason is the reason why a transaction was removed from the
+// mempool.
+type TxRemovalReason int
+
+const (
+	// TxRemovedManually means that the transaction was removed with
+	// RemoveTxByKey.
+	TxRemovedManually TxRemovalReason = iota
+	// TxRemovedCommitted means that the transaction was included in a block.
+	TxRemovedCommitted
+	// TxRemovedInvalid means that the transaction became invalid and was
+	// rejected by the application when rechecked.
+	TxRemovedInvalid
+	// TxRemovedEvicted means that the transaction was evicted to make room
+	// for a transaction with a higher priority.
+	TxRemovedEvicted
+	// TxRemovedExpired means that the transaction stayed in the mempool for
+	// longer than the configured TTL.
+	TxRemovedExpired
+	// TxRemovedFlushed means that the mempool was flushed.
+	TxRemovedFlushed
+	// TxRemovedReplaced means that the transaction was replaced by a
+	// transaction with the same sender and nonce, and a higher priority.
+	TxRemovedReplaced
+)
+
+func (r TxRemovalReason) String() string {
+	switch r {
+	case TxRemovedManually:
+		return "manually"
+	case TxRemovedCommitted:
+		return "committed"
+	case TxRemovedInvalid:
+		return "invalid"
+	case TxRemovedEvicted:
+		return "evicted"
+	case TxRemovedExpired:
+		return "expired"
+	case TxRemovedFlushed:
+		return "flushed"
+	case TxRemovedReplaced:
+		return "replaced"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(r))
+	}
 }
 
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
@@ -1,19 +1,78 @@
 package mempool
 
 import (
+	"sort"
 	"sync/atomic"
+	"time"
 
 	"github.com/cometbft/cometbft/types"
 )
 
 // mempoolTx is an entry in the mempool.
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx // validated by the application
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time at which this tx was added to the mempool
+	gasWanted int64     // amount of gas this tx states it will require
+	priority  int64     // priority assigned by the application in CheckTx
+	lane      string    // lane assigned by the application in CheckTx, if lanes are enabled
+	sender    string    // sender assigned by the application in CheckTx, if any
+	nonce     uint64    // nonce assigned by the application in CheckTx
+	tx        types.Tx  // validated by the application
+}
+
+// txSlot identifies the transactions that can replace each other in the
+// mempool.
+type txSlot struct {
+	sender string
+	nonce  uint64
+}
+
+func (memTx *mempoolTx) slot() txSlot {
+	return txSlot{sender: memTx.sender, nonce: memTx.nonce}
+}
+
+// senderUsage is the number and total size of the transactions of a sender in
+// the mempool.
+type senderUsage struct {
+	numTxs   int
+	txsBytes int64
 }
 
 // Height returns the height for this transaction.
 func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
+
+// orderByNonce reorders the transactions of each sender in memTxs by
+// ascending nonce, so that a block never includes a transaction of a sender
+// before one with a lower nonce, e.g. the replacement of a transaction. The
+// transactions of a sender take the positions that the sender's transactions
+// have in memTxs, and transactions without a sender keep their position.
+func orderByNonce(memTxs []*mempoolTx) []*mempoolTx {
+	bySender := make(map[string][]*mempoolTx)
+	for _, memTx := range memTxs {
+		if memTx.sender != "" {
+			bySender[memTx.sender] = append(bySender[memTx.sender], memTx)
+		}
+	}
+	if len(bySender) == 0 {
+		return memTxs
+	}
+	for _, txs := range bySender {
+		sort.SliceStable(txs, func(i, j int) bool {
+			return txs[i].nonce < txs[j].nonce
+		})
+	}
+
+	res := make([]*mempoolTx, len(memTxs))
+	for i, memTx := range memTxs {
+		if memTx.sender == "" {
+			res[i] = memTx
+			continue
+		}
+		txs := bySender[memTx.sender]
+		res[i] = txs[0]
+		bySender[memTx.sender] = txs[1:]
+	}
+	return res
+}
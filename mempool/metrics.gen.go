@@ -26,6 +26,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "size_bytes",
 			Help:      "Total size of the mempool in bytes.",
 		}, labels).With(labelsAndValues...),
+		LaneSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_size",
+			Help:      "Number of uncommitted transactions in each mempool lane. Only reported when lanes are enabled.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		LaneSizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_size_bytes",
+			Help:      "Total size of each mempool lane in bytes. Only reported when lanes are enabled.",
+		}, append(labels, "lane")).With(labelsAndValues...),
 		TxSizeBytes: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -46,6 +58,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rejected_txs",
 			Help:      "Number of rejected transactions.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of evicted transactions.",
+		}, labels).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of expired transactions.",
+		}, labels).With(labelsAndValues...),
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of replaced transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -64,6 +94,52 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "active_outbound_connections",
 			Help:      "Number of connections being actively used for gossiping transactions (experimental feature).",
 		}, labels).With(labelsAndValues...),
+		DuplicateTxsRatio: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "duplicate_txs_ratio",
+			Help:      "Ratio of duplicate transactions received from peers, measured over the last adaptation window. Only reported if the gossip fan-out is adaptive.",
+		}, labels).With(labelsAndValues...),
+		GossipFanout: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_fanout",
+			Help:      "Number of peers each transaction is relayed to. Only reported if the gossip fan-out is limited.",
+		}, labels).With(labelsAndValues...),
+		CheckTxQueueSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_tx_queue_seconds",
+			Help:      "Time between the reception of a transaction from a peer and its submission to the application, including the time spent waiting for a batch or for the number of in-flight CheckTx requests to go down.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 10, 11),
+		}, labels).With(labelsAndValues...),
+		CheckTxLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_tx_latency_seconds",
+			Help:      "Time taken by the application to respond to CheckTx requests for transactions received from peers.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 10, 11),
+		}, labels).With(labelsAndValues...),
+		InFlightCheckTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "in_flight_check_txs",
+			Help:      "Number of in-flight CheckTx requests for transactions received from peers.",
+		}, labels).With(labelsAndValues...),
+		DroppedPeerTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "dropped_peer_txs",
+			Help:      "Number of transactions received from peers dropped because the CheckTx queue was full.",
+		}, labels).With(labelsAndValues...),
+		DroppedTxTraceEvents: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "dropped_tx_trace_events",
+			Help:      "Number of TxTrace events dropped because the event bus could not keep up.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -71,11 +147,23 @@ func NopMetrics() *Metrics {
 	return &Metrics{
 		Size:                      discard.NewGauge(),
 		SizeBytes:                 discard.NewGauge(),
+		LaneSize:                  discard.NewGauge(),
+		LaneSizeBytes:             discard.NewGauge(),
 		TxSizeBytes:               discard.NewHistogram(),
 		FailedTxs:                 discard.NewCounter(),
 		RejectedTxs:               discard.NewCounter(),
+		EvictedTxs:                discard.NewCounter(),
+		ExpiredTxs:                discard.NewCounter(),
+		ReplacedTxs:               discard.NewCounter(),
 		RecheckTimes:              discard.NewCounter(),
 		AlreadyReceivedTxs:        discard.NewCounter(),
 		ActiveOutboundConnections: discard.NewGauge(),
+		DuplicateTxsRatio:         discard.NewGauge(),
+		GossipFanout:              discard.NewGauge(),
+		CheckTxQueueSeconds:       discard.NewHistogram(),
+		CheckTxLatencySeconds:     discard.NewHistogram(),
+		InFlightCheckTxs:          discard.NewGauge(),
+		DroppedPeerTxs:            discard.NewCounter(),
+		DroppedTxTraceEvents:      discard.NewCounter(),
 	}
 }
@@ -2,6 +2,10 @@ package mempool
 
 import (
 	"github.com/go-kit/kit/metrics"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+	"github.com/cometbft/cometbft/p2p"
 )
 
 const (
@@ -21,6 +25,14 @@ type Metrics struct {
 	// Total size of the mempool in bytes.
 	SizeBytes metrics.Gauge
 
+	// Number of uncommitted transactions in each mempool lane. Only reported
+	// when lanes are enabled.
+	LaneSize metrics.Gauge `metrics_labels:"lane"`
+
+	// Total size of each mempool lane in bytes. Only reported when lanes are
+	// enabled.
+	LaneSizeBytes metrics.Gauge `metrics_labels:"lane"`
+
 	// Histogram of transaction sizes in bytes.
 	TxSizeBytes metrics.Histogram `metrics_bucketsizes:"1,3,7" metrics_buckettype:"exp"`
 
@@ -30,10 +42,27 @@ type Metrics struct {
 	// RejectedTxs defines the number of rejected transactions. These are
 	// transactions that passed CheckTx but failed to make it into the mempool
 	// due to resource limits, e.g. mempool is full and no lower priority
-	// transactions exist in the mempool.
+	// transactions exist in the mempool, or because a transaction with the
+	// same sender and nonce, and a higher or equal priority, is in the
+	// mempool.
 	// metrics:Number of rejected transactions.
 	RejectedTxs metrics.Counter
 
+	// Number of valid transactions evicted from the mempool to make room for
+	// transactions with a higher priority. Only used by the priority mempool.
+	// metrics:Number of evicted transactions.
+	EvictedTxs metrics.Counter
+
+	// Number of transactions removed from the mempool because they stayed in
+	// it for longer than the configured TTL.
+	// metrics:Number of expired transactions.
+	ExpiredTxs metrics.Counter
+
+	// Number of transactions replaced by a transaction with the same sender
+	// and nonce, and a higher priority.
+	// metrics:Number of replaced transactions.
+	ReplacedTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
@@ -44,4 +73,127 @@ type Metrics struct {
 	// Number of connections being actively used for gossiping transactions
 	// (experimental feature).
 	ActiveOutboundConnections metrics.Gauge
+
+	// Number of transactions received from each connected peer that had
+	// already been received before. Not generated by metricsgen, as the
+	// series of a peer is deleted when it disconnects; only reported with
+	// PrometheusMetricsWithPeers.
+	PeerDuplicateTxs *PeerCounter
+
+	// Ratio of duplicate transactions received from peers, measured over the
+	// last adaptation window. Only reported if the gossip fan-out is adaptive.
+	DuplicateTxsRatio metrics.Gauge
+
+	// Number of peers each transaction is relayed to. Only reported if the
+	// gossip fan-out is limited.
+	GossipFanout metrics.Gauge
+
+	// Time between the reception of a transaction from a peer and its
+	// submission to the application, including the time spent waiting for a
+	// batch or for the number of in-flight CheckTx requests to go down.
+	CheckTxQueueSeconds metrics.Histogram `metrics_bucketsizes:"0.0001, 10, 11" metrics_buckettype:"exprange"`
+
+	// Time taken by the application to respond to CheckTx requests for
+	// transactions received from peers.
+	CheckTxLatencySeconds metrics.Histogram `metrics_bucketsizes:"0.0001, 10, 11" metrics_buckettype:"exprange"`
+
+	// Number of transactions received from peers that are being checked by
+	// the application.
+	// metrics:Number of in-flight CheckTx requests for transactions received from peers.
+	InFlightCheckTxs metrics.Gauge
+
+	// Number of transactions received from peers that were dropped because
+	// too many transactions were already waiting to be checked.
+	// metrics:Number of transactions received from peers dropped because the CheckTx queue was full.
+	DroppedPeerTxs metrics.Counter
+
+	// Number of TxTrace events dropped because the event bus could not keep
+	// up with them. Only reported if tx tracing is enabled.
+	// metrics:Number of TxTrace events dropped because the event bus could not keep up.
+	DroppedTxTraceEvents metrics.Counter
+}
+
+// PrometheusMetricsWithPeers is like PrometheusMetrics, but also reports the
+// metrics with a series for each connected peer.
+func PrometheusMetricsWithPeers(namespace string, labelsAndValues ...string) *Metrics {
+	m := PrometheusMetrics(namespace, labelsAndValues...)
+	m.PeerDuplicateTxs = NewPeerCounter(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "peer_duplicate_txs",
+		Help:      "Number of duplicate transactions received from each connected peer.",
+	}, labelsAndValues...)
+	stdprometheus.MustRegister(m.PeerDuplicateTxs.cv)
+	return m
+}
+
+// PeerCounter is a counter with a series for each connected peer, labelled
+// with its ID. The series of a peer is deleted when it is removed, so that
+// their number is bounded by the number of peers. A nil PeerCounter discards
+// the values added to it.
+type PeerCounter struct {
+	cv              *stdprometheus.CounterVec
+	labelsAndValues []string
+
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]struct{}
+}
+
+// NewPeerCounter returns a PeerCounter with the given options, whose series
+// have the given labels and a peer_id label. It must be registered by the
+// caller.
+func NewPeerCounter(opts stdprometheus.CounterOpts, labelsAndValues ...string) *PeerCounter {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &PeerCounter{
+		cv:              stdprometheus.NewCounterVec(opts, append(labels, "peer_id")),
+		labelsAndValues: labelsAndValues,
+		peers:           make(map[p2p.ID]struct{}),
+	}
+}
+
+// AddPeer starts counting the values of the peer with the given ID.
+func (c *PeerCounter) AddPeer(id p2p.ID) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.peers[id] = struct{}{}
+}
+
+// RemovePeer deletes the series of the peer with the given ID. The values
+// added for it afterwards are discarded.
+func (c *PeerCounter) RemovePeer(id p2p.ID) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.peers, id)
+	c.cv.Delete(c.labels(id))
+}
+
+// Add adds delta to the series of the peer with the given ID, if it was added
+// and not removed.
+func (c *PeerCounter) Add(id p2p.ID, delta float64) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.peers[id]; ok {
+		c.cv.With(c.labels(id)).Add(delta)
+	}
+}
+
+func (c *PeerCounter) labels(id p2p.ID) stdprometheus.Labels {
+	labels := make(stdprometheus.Labels, len(c.labelsAndValues)/2+1)
+	for i := 0; i+1 < len(c.labelsAndValues); i += 2 {
+		labels[c.labelsAndValues[i]] = c.labelsAndValues[i+1]
+	}
+	labels["peer_id"] = string(id)
+	return labels
 }
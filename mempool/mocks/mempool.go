@@ -139,8 +139,36 @@ func (_m *Mempool) RemoveTxByKey(txKey types.TxKey) error {
 	return r0
 }
 
+// SenderUsage provides a mock function with given fields: sender
+func (_m *Mempool) SenderUsage(sender string) (int, int64) {
+	ret := _m.Called(sender)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SenderUsage")
+	}
+
+	var r0 int
+	var r1 int64
+	if rf, ok := ret.Get(0).(func(string) (int, int64)); ok {
+		return rf(sender)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(sender)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) int64); ok {
+		r1 = rf(sender)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	return r0, r1
+}
+
 // SetTxRemovedCallback provides a mock function with given fields: cb
-func (_m *Mempool) SetTxRemovedCallback(cb func(types.TxKey)) {
+func (_m *Mempool) SetTxRemovedCallback(cb func(types.TxKey, mempool.TxRemovalReason)) {
 	_m.Called(cb)
 }
 
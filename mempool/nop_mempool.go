@@ -67,11 +67,14 @@ func (*NopMempool) TxsAvailable() <-chan struct{} {
 func (*NopMempool) EnableTxsAvailable() {}
 
 // SetTxRemovedCallback does nothing.
-func (*NopMempool) SetTxRemovedCallback(func(txKey types.TxKey)) {}
+func (*NopMempool) SetTxRemovedCallback(func(types.TxKey, TxRemovalReason)) {}
 
 // Size always returns 0.
 func (*NopMempool) Size() int { return 0 }
 
+// SenderUsage always returns 0, 0.
+func (*NopMempool) SenderUsage(string) (int, int64) { return 0, 0 }
+
 // SizeBytes always returns 0.
 func (*NopMempool) SizeBytes() int64 { return 0 }
 
@@ -0,0 +1,181 @@
+package mempool
+
+import (
+	"sort"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// PriorityMempool is a mempool that orders transactions by the priority
+// assigned to them by the application in CheckTxResponse.Priority.
+//
+// It is built on top of CListMempool and shares its cache, rechecking logic
+// and linked list, so transactions are still gossiped by the Reactor in the
+// order they were received. It differs from CListMempool in two ways:
+//   - When the mempool is full, a new valid transaction is admitted only if
+//     evicting transactions with a strictly lower priority frees enough room
+//     for it. The lowest-priority transactions are evicted first.
+//   - Transactions are reaped by descending priority. Transactions with the
+//     same priority are reaped in the order they were received. The
+//     transactions of a sender are still reaped by ascending nonce.
+type PriorityMempool struct {
+	*CListMempool
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		CListMempool: NewCListMempool(cfg, proxyAppConn, height, options...),
+	}
+	mp.evictTxs = mp.evictLowerPriorityTxs
+	return mp
+}
+
+// sortedTxs returns all transactions in the mempool, sorted by descending
+// priority. Transactions with the same priority keep the order in which they
+// were added to the mempool.
+func (mem *PriorityMempool) sortedTxs() []*mempoolTx {
+	memTxs := mem.allTxs()
+	sort.SliceStable(memTxs, func(i, j int) bool {
+		return memTxs[i].priority > memTxs[j].priority
+	})
+	return memTxs
+}
+
+// ReapMaxBytesMaxGas reaps transactions by descending priority, up to maxBytes
+// bytes total with the condition that the total gasWanted must be less than
+// maxGas. If lanes are enabled, the transactions of each lane are sorted by
+// priority and lanes are then interleaved by weight.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return reapMaxBytesMaxGas(orderByNonce(mem.interleaveLanes(mem.sortedTxs())), maxBytes, maxGas)
+}
+
+// ReapMaxTxs reaps up to max transactions by descending priority.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return reapMaxTxs(orderByNonce(mem.interleaveLanes(mem.sortedTxs())), max)
+}
+
+// evictLowerPriorityTxs tries to make room for memTx by removing transactions
+// with a strictly lower priority, lowest first. Among transactions with the
+// same priority, the most recently added ones are evicted first. If lanes are
+// enabled and the lane of memTx is full, transactions from that lane are
+// evicted first. Nothing is removed if the mempool (or lane) would still be
+// full after evicting all eligible transactions.
+//
+// Evicted transactions are also removed from the cache, so they can be
+// received again once there is room for them. The transaction that memTx
+// replaces, if not nil, is not evicted but counted as removed.
+//
+// Called from:
+//   - resCbFirstTime (lock not held) if the mempool is full
+func (mem *PriorityMempool) evictLowerPriorityTxs(memTx, replaced *mempoolTx) bool {
+	var candidates []*mempoolTx
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if tx := e.Value.(*mempoolTx); tx.priority < memTx.priority && tx != replaced {
+			candidates = append(candidates, tx)
+		}
+	}
+	// Sort by ascending priority; reverse the arrival order first, so that,
+	// after the stable sort, newer transactions come first within a priority.
+	for i, j := 0, len(candidates)-1; i < j; i, j = i+1, j-1 {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].priority < candidates[j].priority
+	})
+
+	var (
+		numTxs   = mem.Size()
+		txsBytes = mem.SizeBytes()
+		txSize   = int64(len(memTx.tx))
+		toEvict  []*mempoolTx
+	)
+	fits := func() bool {
+		return numTxs < mem.config.Size && txsBytes+txSize <= mem.config.MaxTxsBytes
+	}
+	evict := func(tx *mempoolTx) {
+		numTxs--
+		txsBytes -= int64(len(tx.tx))
+		toEvict = append(toEvict, tx)
+	}
+	if replaced != nil {
+		numTxs--
+		txsBytes -= int64(len(replaced.tx))
+	}
+
+	// If the lane of the transaction is full, evict transactions from that
+	// lane first, since evicting from other lanes won't help.
+	if mem.lanes != nil {
+		l := mem.lanes.get(memTx.lane)
+		laneTxs, laneTxsBytes := int(l.txs.Load()), l.txsBytes.Load()
+		if replaced != nil && replaced.lane == memTx.lane {
+			laneTxs--
+			laneTxsBytes -= int64(len(replaced.tx))
+		}
+		laneFits := func() bool {
+			return (l.maxTxs == 0 || laneTxs < l.maxTxs) &&
+				(l.maxTxsBytes == 0 || laneTxsBytes+txSize <= l.maxTxsBytes)
+		}
+		others := candidates[:0]
+		for _, tx := range candidates {
+			if !laneFits() && tx.lane == memTx.lane {
+				evict(tx)
+				laneTxs--
+				laneTxsBytes -= int64(len(tx.tx))
+				continue
+			}
+			others = append(others, tx)
+		}
+		if !laneFits() {
+			return false
+		}
+		candidates = others
+	}
+
+	for _, tx := range candidates {
+		if fits() {
+			break
+		}
+		evict(tx)
+	}
+	if !fits() {
+		return false
+	}
+
+	for _, evicted := range toEvict {
+		if err := mem.removeTx(evicted.tx.Key(), TxRemovedEvicted); err != nil {
+			// The transaction was removed concurrently, e.g. by a recheck.
+			continue
+		}
+		mem.forceRemoveFromCache(evicted.tx)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.logger.Debug(
+			"evicted transaction",
+			"tx", evicted.tx.Hash(),
+			"priority", evicted.priority,
+			"new_tx", memTx.tx.Hash(),
+			"new_priority", memTx.priority,
+		)
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// priorityApp is a kvstore application that assigns to each transaction of
+// the form "key=value" a priority equal to value.
+type priorityApp struct {
+	*kvstore.Application
+}
+
+func (app *priorityApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res, err := app.Application.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	parts := strings.SplitN(string(req.Tx), "=", 2)
+	if len(parts) == 2 {
+		res.Priority, _ = strconv.ParseInt(parts[1], 10, 64)
+	}
+	return res, nil
+}
+
+func newPriorityMempoolWithConfig(t *testing.T, cfg *config.Config) *PriorityMempool {
+	t.Helper()
+
+	return newPriorityMempoolWithAppAndConfig(t, &priorityApp{Application: kvstore.NewInMemoryApplication()}, cfg)
+}
+
+func newPriorityMempoolWithAppAndConfig(t *testing.T, app abci.Application, cfg *config.Config) *PriorityMempool {
+	t.Helper()
+
+	cc := proxy.NewLocalClientCreator(app)
+	appConnMem, _ := cc.NewABCIMempoolClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	return mp
+}
+
+func priorityTx(key string, priority int64) types.Tx {
+	return types.Tx(fmt.Sprintf("%s=%d", key, priority))
+}
+
+func TestPriorityMempoolReap(t *testing.T) {
+	mp := newPriorityMempoolWithConfig(t, test.ResetTestRoot("mempool_test"))
+
+	txs := types.Txs{
+		priorityTx("a", 1),
+		priorityTx("b", 5),
+		priorityTx("c", 3),
+		priorityTx("d", 5),
+		priorityTx("e", 0),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// Transactions with the same priority are reaped in arrival order.
+	expected := types.Txs{txs[1], txs[3], txs[2], txs[0], txs[4]}
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected[:3], mp.ReapMaxBytesMaxGas(-1, 3))
+
+	// Reaping does not reorder gossip.
+	var gossiped types.Txs
+	for e := mp.TxsFront(); e != nil; e = e.Next() {
+		gossiped = append(gossiped, e.Value.(*mempoolTx).tx)
+	}
+	require.Equal(t, txs, gossiped)
+}
+
+func TestPriorityMempoolReapByNonce(t *testing.T) {
+	app := &senderApp{priorityApp{Application: kvstore.NewInMemoryApplication()}}
+	mp := newPriorityMempoolWithAppAndConfig(t, app, test.ResetTestRoot("mempool_test"))
+
+	txs := types.Txs{
+		types.Tx("alice/1/a=1"),
+		types.Tx("b=3"),
+		types.Tx("alice/2/c=5"),
+		types.Tx("alice/3/d=4"),
+	}
+	callCheckTx(t, mp, txs)
+
+	// Alice's transactions take the positions they would have by priority,
+	// but in nonce order.
+	expected := types.Txs{txs[0], txs[2], txs[1], txs[3]}
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+}
+
+func TestPriorityMempoolEvictsLowerPriorityTxs(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 3
+	mp := newPriorityMempoolWithConfig(t, cfg)
+
+	txs := types.Txs{
+		priorityTx("a", 2),
+		priorityTx("b", 1),
+		priorityTx("c", 1),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, 3, mp.Size())
+
+	// The most recent transaction with the lowest priority is evicted.
+	high := priorityTx("d", 3)
+	callCheckTx(t, mp, types.Txs{high})
+	require.Equal(t, 3, mp.Size())
+	require.Equal(t, types.Txs{high, txs[0], txs[1]}, mp.ReapMaxTxs(-1))
+
+	// The evicted transaction is removed from the cache, so it can be
+	// received again once there is room for it.
+	require.False(t, mp.cache.Has(txs[2]))
+	require.True(t, mp.cache.Has(high))
+
+	// A transaction with a priority not higher than all others is rejected.
+	low := priorityTx("e", 1)
+	callCheckTx(t, mp, types.Txs{low})
+	require.Equal(t, 3, mp.Size())
+	require.False(t, mp.cache.Has(low))
+	require.Equal(t, types.Txs{high, txs[0], txs[1]}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEvictsByTxsBytes(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsBytes = 9
+	mp := newPriorityMempoolWithConfig(t, cfg)
+
+	// Each transaction is 3 bytes long.
+	txs := types.Txs{
+		priorityTx("a", 1),
+		priorityTx("b", 2),
+		priorityTx("c", 3),
+	}
+	callCheckTx(t, mp, txs)
+	require.EqualValues(t, 9, mp.SizeBytes())
+
+	// Two transactions must be evicted to make room for this one, but only
+	// one has a lower priority, so nothing is evicted.
+	big := types.Tx("dddd=2")
+	callCheckTx(t, mp, types.Txs{big})
+	require.Equal(t, 3, mp.Size())
+	require.EqualValues(t, 9, mp.SizeBytes())
+
+	// Both transactions with a lower priority are evicted.
+	big = types.Tx("dddd=3")
+	callCheckTx(t, mp, types.Txs{big})
+	require.Equal(t, types.Txs{txs[2], big}, mp.ReapMaxTxs(-1))
+	require.EqualValues(t, 9, mp.SizeBytes())
+}
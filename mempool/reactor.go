@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -8,6 +9,7 @@ import (
 
 	"golang.org/x/sync/semaphore"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v1"
 	cfg "github.com/cometbft/cometbft/config"
@@ -18,6 +20,24 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+const (
+	// maxTxKeysPerMsg is the maximum number of transaction keys in a HaveTxs
+	// or WantTxs message.
+	maxTxKeysPerMsg = 1000
+
+	// txRequestTimeout is how long to wait for a transaction requested to a
+	// peer before requesting it again to another peer that announces it.
+	txRequestTimeout = 2 * time.Second
+
+	// haveTxsBatchInterval is how long the key of a transaction may wait to
+	// be announced to a peer along with the keys of the next transactions.
+	haveTxsBatchInterval = 50 * time.Millisecond
+
+	// checkTxBackPressureInterval is how often the CheckTx queue is checked
+	// while transactions are not requested to peers because it is backlogged.
+	checkTxBackPressureInterval = 10 * time.Millisecond
+)
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
@@ -54,6 +74,53 @@ type Reactor struct {
 
 	// record all peers, so we know whether a TX comes from a peer
 	peers *p2p.PeerSet
+
+	// `requestedTxs` maps the keys of the transactions requested to peers with
+	// WantTxs to the time of the request. It prevents requesting the same
+	// transaction to several peers at the same time. Only used with pull-based
+	// gossip.
+	requestedTxs    map[types.TxKey]time.Time
+	requestedTxsMtx cmtsync.Mutex
+
+	// `txRequests` maps the IDs of the peers using pull-based gossip to the
+	// keys of the transactions they requested with WantTxs. The transactions
+	// are sent by sendRequestedTxsRoutine, so that Receive never blocks on
+	// sending them.
+	txRequests map[p2p.ID]*txRequestQueue
+
+	// `announcedTxs` maps the IDs of the peers using pull-based gossip to the
+	// keys of the transactions they announced with HaveTxs. The transactions
+	// are requested by requestTxsRoutine, which holds the requests while the
+	// CheckTx queue is backlogged.
+	announcedTxs  map[p2p.ID]*txRequestQueue
+	txRequestsMtx cmtsync.Mutex
+
+	// Number of peers each transaction is relayed to.
+	fanout *fanoutController
+
+	// `txTargets` maps every transaction to the set of peers selected to
+	// receive it, when the gossip fan-out is limited. Targets are stored until
+	// the transaction is removed from the mempool.
+	txTargets    map[types.TxKey]map[p2p.ID]struct{}
+	txTargetsMtx cmtsync.Mutex
+
+	// Transactions received from peers waiting to be checked by
+	// checkTxRoutine. Only used if CheckTxBatchSize or MaxInFlightCheckTxs is
+	// set. It holds as many transactions as the mempool.
+	checkTxQueue chan receivedTx
+
+	// Bounds the number of transactions received from peers that are being
+	// checked by the application. A slot is taken before calling CheckTx, and
+	// released once the application has responded. nil if
+	// MaxInFlightCheckTxs is not set.
+	checkTxSlots chan struct{}
+}
+
+// receivedTx is a transaction received from a peer, waiting to be checked.
+type receivedTx struct {
+	tx         types.Tx
+	src        p2p.Peer
+	receivedAt time.Time
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
@@ -67,13 +134,27 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool, waitSync bool)
 		txSendersUncheckedRemoveThreshold: make(map[types.TxKey]int32),
 		txSendersUncheckedRemoveCount:     make(map[types.TxKey]int32),
 		peers:                             p2p.NewPeerSet(), // initialize an empty peerSet
+		requestedTxs:                      make(map[types.TxKey]time.Time),
+		txRequests:                        make(map[p2p.ID]*txRequestQueue),
+		announcedTxs:                      make(map[p2p.ID]*txRequestQueue),
+		fanout:                            newFanoutController(config, mempool.metrics),
+		txTargets:                         make(map[types.TxKey]map[p2p.ID]struct{}),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
+	if config.CheckTxBatchSize > 0 || config.MaxInFlightCheckTxs > 0 {
+		memR.checkTxQueue = make(chan receivedTx, config.Size)
+	}
+	if config.MaxInFlightCheckTxs > 0 {
+		memR.checkTxSlots = make(chan struct{}, config.MaxInFlightCheckTxs)
+	}
 	if waitSync {
 		memR.waitSync.Store(true)
 		memR.waitSyncCh = make(chan struct{})
 	}
-	memR.mempool.SetTxRemovedCallback(func(txKey types.TxKey) { memR.removeSenders(txKey) })
+	memR.mempool.SetTxRemovedCallback(func(txKey types.TxKey, _ TxRemovalReason) {
+		memR.removeSenders(txKey)
+		memR.removeTxTargets(txKey)
+	})
 	memR.activePersistentPeersSemaphore = semaphore.NewWeighted(int64(memR.config.ExperimentalMaxGossipConnectionsToPersistentPeers))
 	memR.activeNonPersistentPeersSemaphore = semaphore.NewWeighted(int64(memR.config.ExperimentalMaxGossipConnectionsToNonPersistentPeers))
 
@@ -94,6 +175,9 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	if memR.checkTxQueue != nil {
+		go memR.checkTxRoutine()
+	}
 	return nil
 }
 
@@ -107,7 +191,7 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 		},
 	}
 
-	return []*p2p.ChannelDescriptor{
+	chs := []*p2p.ChannelDescriptor{
 		{
 			ID:                  MempoolChannel,
 			Priority:            5,
@@ -115,11 +199,33 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &protomem.Message{},
 		},
 	}
+
+	if memR.config.PullGossip {
+		largestKeys := make([][]byte, maxTxKeysPerMsg)
+		for i := range largestKeys {
+			largestKeys[i] = make([]byte, sha256.Size)
+		}
+		keysMsg := protomem.Message{
+			Sum: &protomem.Message_HaveTxs{
+				HaveTxs: &protomem.HaveTxs{TxKeys: largestKeys},
+			},
+		}
+		chs = append(chs, &p2p.ChannelDescriptor{
+			ID:                  MempoolAnnounceChannel,
+			Priority:            5,
+			RecvMessageCapacity: keysMsg.Size(),
+			MessageType:         &protomem.Message{},
+		})
+	}
+
+	return chs
 }
 
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all txs are forwarded to the given peer.
 func (memR *Reactor) AddPeer(peer p2p.Peer) {
+	memR.mempool.metrics.PeerDuplicateTxs.AddPeer(peer.ID())
+
 	if memR.config.Broadcast {
 		go func() {
 			memR.mempool.metrics.ActiveOutboundConnections.Add(1)
@@ -133,6 +239,26 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 			memR.Logger.Error("")
 		}
 	}
+
+	if memR.usesPullGossip(peer) {
+		requested, announced := newTxRequestQueue(), newTxRequestQueue()
+		memR.txRequestsMtx.Lock()
+		memR.txRequests[peer.ID()] = requested
+		memR.announcedTxs[peer.ID()] = announced
+		memR.txRequestsMtx.Unlock()
+		go memR.sendRequestedTxsRoutine(peer, requested)
+		go memR.requestTxsRoutine(peer, announced)
+	}
+}
+
+// RemovePeer implements Reactor.
+func (memR *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	memR.mempool.metrics.PeerDuplicateTxs.RemovePeer(peer.ID())
+
+	memR.txRequestsMtx.Lock()
+	delete(memR.txRequests, peer.ID())
+	delete(memR.announcedTxs, peer.ID())
+	memR.txRequestsMtx.Unlock()
 }
 
 // Receive implements Reactor.
@@ -155,30 +281,60 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 		for _, txBytes := range protoTxs {
 			tx := types.Tx(txBytes)
 
+			memR.removeRequestedTx(tx.Key())
+			if memR.mempool.txTracer != nil {
+				memR.mempool.txTracer.Received(tx.Key(), types.TxSourcePeer)
+			}
 			memR.addSenderUnchecked(tx.Key(), e.Src.ID())                                     // record the sender of current TX
 			memR.setTxSendersUncheckedRemoveThreshold(tx.Key(), memR.broadcastRoutins.Load()) // set the threshold for current transaction as the number of broadcast routines, which also means the number of peers
 
-			// check if the src is our peer
-			reqRes, err := memR.mempool.CheckTx(tx)
-			switch {
-			case errors.Is(err, ErrTxInCache):
-				memR.Logger.Debug("Tx already exists in cache", "tx", "tx.Hash()")
-			case err != nil:
-				memR.Logger.Info("Could not check tx", "tx", "tx.Hash()", "err", err)
-			default:
-				// Record the sender only when the transaction is valid and, as
-				// a consequence, added to the mempool. Senders are stored until
-				// the transaction is removed from the mempool. Note that it's
-				// possible a tx is still in the cache but no longer in the
-				// mempool. For example, after committing a block, txs are
-				// removed from mempool but not the cache.
-				reqRes.SetCallback(func(res *abci.Response) {
-					if res.GetCheckTx().Code == abci.CodeTypeOK {
-						memR.addSender(tx.Key(), e.Src.ID())
-					}
-				})
+			rtx := receivedTx{tx: tx, src: e.Src, receivedAt: time.Now()}
+			if !memR.enqueueTx(rtx) {
+				memR.Logger.Debug("Dropped tx: too many txs waiting to be checked", "src", e.Src, "tx", tx.Hash())
+				memR.mempool.metrics.DroppedPeerTxs.Add(1)
 			}
 		}
+	case *protomem.HaveTxs:
+		if memR.WaitSync() {
+			memR.Logger.Debug("Ignored message received while syncing", "msg", msg)
+			return
+		}
+
+		txKeys, err := parseTxKeys(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+
+		memR.txRequestsMtx.Lock()
+		q := memR.announcedTxs[e.Src.ID()]
+		memR.txRequestsMtx.Unlock()
+		if q == nil {
+			memR.Logger.Debug("Ignored tx announcement from peer not using pull-based gossip", "src", e.Src)
+			return
+		}
+		if dropped := q.push(txKeys, memR.config.Size); dropped > 0 {
+			memR.Logger.Debug("Dropped tx announcements: too many announcements pending", "src", e.Src, "dropped", dropped)
+		}
+
+	case *protomem.WantTxs:
+		txKeys, err := parseTxKeys(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+
+		memR.txRequestsMtx.Lock()
+		q := memR.txRequests[e.Src.ID()]
+		memR.txRequestsMtx.Unlock()
+		if q == nil {
+			memR.Logger.Debug("Ignored tx request from peer not using pull-based gossip", "src", e.Src)
+			return
+		}
+		if dropped := q.push(txKeys, memR.config.Size); dropped > 0 {
+			memR.Logger.Debug("Dropped tx requests: too many requests pending", "src", e.Src, "dropped", dropped)
+		}
+
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
 		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
@@ -188,6 +344,139 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 	// broadcasting happens from go routines per peer
 }
 
+// enqueueTx hands a transaction received from a peer over to checkTxRoutine,
+// or submits it right away if there is no queue. It never blocks, so that the
+// peer's receive routine, which reads the messages of all the channels, among
+// which consensus, is not stalled: it returns false if the transaction is
+// dropped because the queue is full.
+func (memR *Reactor) enqueueTx(rtx receivedTx) bool {
+	if memR.checkTxQueue == nil {
+		memR.submitCheckTxs([]receivedTx{rtx})
+		return true
+	}
+
+	select {
+	case memR.checkTxQueue <- rtx:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkTxRoutine checks the transactions received from peers in batches of up
+// to CheckTxBatchSize transactions: it waits for a transaction to be queued,
+// and submits it to the application along with the transactions queued in the
+// meantime. If MaxInFlightCheckTxs is lower than CheckTxBatchSize, a batch is
+// submitted in chunks of at most MaxInFlightCheckTxs transactions.
+func (memR *Reactor) checkTxRoutine() {
+	batch := make([]receivedTx, 0, max(memR.config.CheckTxBatchSize, 1))
+	for {
+		select {
+		case rtx := <-memR.checkTxQueue:
+			batch = append(batch[:0], rtx)
+		case <-memR.Quit():
+			return
+		}
+
+	fill:
+		for len(batch) < cap(batch) {
+			select {
+			case rtx := <-memR.checkTxQueue:
+				batch = append(batch, rtx)
+			default:
+				break fill
+			}
+		}
+
+		chunkSize := len(batch)
+		if memR.checkTxSlots != nil && chunkSize > cap(memR.checkTxSlots) {
+			chunkSize = cap(memR.checkTxSlots)
+		}
+		for start := 0; start < len(batch); start += chunkSize {
+			end := start + chunkSize
+			if end > len(batch) {
+				end = len(batch)
+			}
+			for i := start; i < end; i++ {
+				if !memR.acquireCheckTxSlot() {
+					return
+				}
+			}
+			memR.submitCheckTxs(batch[start:end])
+		}
+	}
+}
+
+// acquireCheckTxSlot blocks until fewer than MaxInFlightCheckTxs transactions
+// received from peers are being checked. It returns false if the reactor is
+// stopped in the meantime.
+func (memR *Reactor) acquireCheckTxSlot() bool {
+	if memR.checkTxSlots == nil {
+		return true
+	}
+	select {
+	case memR.checkTxSlots <- struct{}{}:
+		return true
+	case <-memR.Quit():
+		return false
+	}
+}
+
+// submitCheckTxs submits transactions received from peers to the mempool in a
+// single batch. A CheckTx slot must have been acquired for each of them.
+func (memR *Reactor) submitCheckTxs(rtxs []receivedTx) {
+	txs := make(types.Txs, len(rtxs))
+	for i, rtx := range rtxs {
+		txs[i] = rtx.tx
+		memR.mempool.metrics.InFlightCheckTxs.Add(1)
+		memR.mempool.metrics.CheckTxQueueSeconds.Observe(time.Since(rtx.receivedAt).Seconds())
+	}
+
+	start := time.Now()
+	reqRess, errs := memR.mempool.CheckTxs(txs)
+	for i, rtx := range rtxs {
+		memR.handleCheckTxResult(rtx, reqRess[i], errs[i], start)
+	}
+}
+
+// handleCheckTxResult processes the result of submitting a transaction
+// received from a peer to the mempool at the given time.
+func (memR *Reactor) handleCheckTxResult(rtx receivedTx, reqRes *abcicli.ReqRes, err error, start time.Time) {
+	memR.fanout.recordReceived(errors.Is(err, ErrTxInCache))
+	switch {
+	case errors.Is(err, ErrTxInCache):
+		memR.releaseCheckTxSlot()
+		memR.Logger.Debug("Tx already exists in cache", "tx", "tx.Hash()")
+		memR.mempool.metrics.PeerDuplicateTxs.Add(rtx.src.ID(), 1)
+	case err != nil:
+		memR.releaseCheckTxSlot()
+		memR.Logger.Info("Could not check tx", "tx", "tx.Hash()", "err", err)
+	default:
+		// Record the sender only when the transaction is valid and, as
+		// a consequence, added to the mempool. Senders are stored until
+		// the transaction is removed from the mempool. Note that it's
+		// possible a tx is still in the cache but no longer in the
+		// mempool. For example, after committing a block, txs are
+		// removed from mempool but not the cache.
+		reqRes.SetCallback(func(res *abci.Response) {
+			memR.releaseCheckTxSlot()
+			memR.mempool.metrics.CheckTxLatencySeconds.Observe(time.Since(start).Seconds())
+			if res.GetCheckTx().Code == abci.CodeTypeOK {
+				memR.addSender(rtx.tx.Key(), rtx.src.ID())
+			}
+		})
+	}
+}
+
+// releaseCheckTxSlot is called once a transaction received from a peer has
+// been checked, or could not be submitted to the application.
+func (memR *Reactor) releaseCheckTxSlot() {
+	memR.mempool.metrics.InFlightCheckTxs.Add(-1)
+	if memR.checkTxSlots != nil {
+		<-memR.checkTxSlots
+	}
+}
+
 func (memR *Reactor) EnableInOutTxs() {
 	memR.Logger.Info("enabling inbound and outbound transactions")
 	if !memR.waitSync.CompareAndSwap(true, false) {
@@ -204,12 +493,215 @@ func (memR *Reactor) WaitSync() bool {
 	return memR.waitSync.Load()
 }
 
+// usesPullGossip returns true if transactions are gossiped to peer by
+// announcing their keys, that is, if both this node and peer have enabled
+// pull-based gossip.
+func (memR *Reactor) usesPullGossip(peer p2p.Peer) bool {
+	if !memR.config.PullGossip {
+		return false
+	}
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(MempoolAnnounceChannel)
+}
+
+// parseTxKeys converts the transaction keys of a HaveTxs or WantTxs message,
+// and returns an error if the message is malformed.
+func parseTxKeys(rawKeys [][]byte) ([]types.TxKey, error) {
+	if len(rawKeys) == 0 {
+		return nil, errors.New("received empty tx keys")
+	}
+	if len(rawKeys) > maxTxKeysPerMsg {
+		return nil, fmt.Errorf("received %d tx keys, max is %d", len(rawKeys), maxTxKeysPerMsg)
+	}
+
+	txKeys := make([]types.TxKey, len(rawKeys))
+	for i, rawKey := range rawKeys {
+		if len(rawKey) != sha256.Size {
+			return nil, fmt.Errorf("invalid tx key length: %d", len(rawKey))
+		}
+		copy(txKeys[i][:], rawKey)
+	}
+	return txKeys, nil
+}
+
+// wantedTxKeys returns the keys of the transactions that this node has not
+// seen yet, and that have not been requested recently to another peer. The
+// returned keys are marked as requested.
+func (memR *Reactor) wantedTxKeys(txKeys []types.TxKey) [][]byte {
+	memR.requestedTxsMtx.Lock()
+	defer memR.requestedTxsMtx.Unlock()
+
+	now := time.Now()
+	if len(memR.requestedTxs) > memR.config.Size {
+		// Forget the transactions that were never received.
+		for txKey, requestedAt := range memR.requestedTxs {
+			if now.Sub(requestedAt) > txRequestTimeout {
+				delete(memR.requestedTxs, txKey)
+			}
+		}
+	}
+
+	var wanted [][]byte
+	for _, txKey := range txKeys {
+		if memR.mempool.InMempool(txKey) || memR.mempool.cache.HasKey(txKey) {
+			continue
+		}
+		if requestedAt, ok := memR.requestedTxs[txKey]; ok && now.Sub(requestedAt) <= txRequestTimeout {
+			continue
+		}
+		memR.requestedTxs[txKey] = now
+		k := txKey
+		wanted = append(wanted, k[:])
+	}
+	return wanted
+}
+
+func (memR *Reactor) removeRequestedTx(txKey types.TxKey) {
+	memR.requestedTxsMtx.Lock()
+	defer memR.requestedTxsMtx.Unlock()
+
+	delete(memR.requestedTxs, txKey)
+}
+
+// txRequestQueue holds the keys of the transactions requested by a peer, or
+// announced by it, in order.
+type txRequestQueue struct {
+	mtx  cmtsync.Mutex
+	keys []types.TxKey
+
+	ready chan struct{} // signaled when keys are pushed
+}
+
+func newTxRequestQueue() *txRequestQueue {
+	return &txRequestQueue{ready: make(chan struct{}, 1)}
+}
+
+// push appends txKeys to the queue, without exceeding maxSize keys. It returns
+// the number of keys that did not fit in the queue.
+func (q *txRequestQueue) push(txKeys []types.TxKey, maxSize int) int {
+	q.mtx.Lock()
+	n := min(len(txKeys), max(maxSize-len(q.keys), 0))
+	q.keys = append(q.keys, txKeys[:n]...)
+	q.mtx.Unlock()
+
+	if n > 0 {
+		select {
+		case q.ready <- struct{}{}:
+		default:
+		}
+	}
+	return len(txKeys) - n
+}
+
+// popAll removes and returns all the keys in the queue.
+func (q *txRequestQueue) popAll() []types.TxKey {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	keys := q.keys
+	q.keys = nil
+	return keys
+}
+
+// sendRequestedTxsRoutine sends to peer the transactions it requested with
+// WantTxs, as long as they are in the mempool. It runs apart from Receive
+// because sending may block until the peer reads from the connection, which
+// it may not do while it is itself blocked on sending to this node.
+func (memR *Reactor) sendRequestedTxsRoutine(peer p2p.Peer, q *txRequestQueue) {
+	for {
+		select {
+		case <-q.ready:
+		case <-peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+
+		// Send transactions one by one, as the mempool channel only accepts
+		// messages with one transaction of the maximum size.
+		for _, txKey := range q.popAll() {
+			tx, ok := memR.mempool.GetTxByKey(txKey)
+			if !ok {
+				continue
+			}
+			if !peer.Send(p2p.Envelope{
+				ChannelID: MempoolChannel,
+				Message:   &protomem.Txs{Txs: [][]byte{tx}},
+			}) {
+				// The peer will request the transaction again to another peer
+				// announcing it.
+				memR.Logger.Debug("Failed to send requested tx", "peer", peer.ID(), "tx", txKey)
+				if !peer.IsRunning() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// requestTxsRoutine requests to peer with WantTxs the transactions it
+// announced that this node wants. It runs apart from Receive, so that Receive
+// never blocks on sending the requests. It applies back-pressure to the peer:
+// no transactions are requested while the CheckTx queue is backlogged, so that
+// the peer stops sending transactions until the application catches up.
+func (memR *Reactor) requestTxsRoutine(peer p2p.Peer, q *txRequestQueue) {
+	for {
+		select {
+		case <-q.ready:
+		case <-peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+
+		for memR.checkTxQueueBacklogged() {
+			select {
+			case <-time.After(checkTxBackPressureInterval):
+			case <-peer.Quit():
+				return
+			case <-memR.Quit():
+				return
+			}
+		}
+
+		txKeys := q.popAll()
+		for start := 0; start < len(txKeys); start += maxTxKeysPerMsg {
+			wanted := memR.wantedTxKeys(txKeys[start:min(start+maxTxKeysPerMsg, len(txKeys))])
+			if len(wanted) == 0 {
+				continue
+			}
+			if !peer.Send(p2p.Envelope{
+				ChannelID: MempoolAnnounceChannel,
+				Message:   &protomem.WantTxs{TxKeys: wanted},
+			}) {
+				// The transactions are requested again when they are next
+				// announced.
+				for _, txKey := range wanted {
+					memR.removeRequestedTx(types.TxKey(txKey))
+				}
+				if !peer.IsRunning() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// checkTxQueueBacklogged returns true if the transactions received from peers
+// wait to be checked in a queue that is at least half full.
+func (memR *Reactor) checkTxQueueBacklogged() bool {
+	n := len(memR.checkTxQueue)
+	return n > 0 && 2*n >= cap(memR.checkTxQueue)
+}
+
 // PeerState describes the state of a peer.
 type PeerState interface {
 	GetHeight() int64
 }
 
-// Send new mempool txs to peer.
+// Send new mempool txs to peer. If the peer uses pull-based gossip, only the
+// keys of the transactions are sent, and the peer requests the transactions it
+// doesn't have.
 func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	var next *clist.CElement
 
@@ -223,12 +715,24 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 	}
 
+	pull := memR.usesPullGossip(peer)
+	// With pull-based gossip, the keys of the transactions are announced in
+	// batches of up to maxTxKeysPerMsg keys, at most haveTxsBatchInterval
+	// after the first key of the batch.
+	haveTxs := &haveTxsBatch{}
+
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
 		if !memR.IsRunning() || !peer.IsRunning() {
 			return
 		}
 
+		select {
+		case <-haveTxs.flushCh:
+			memR.sendHaveTxs(peer, haveTxs)
+		default:
+		}
+
 		// This happens because the CElement we were looking at got garbage
 		// collected (removed). That is, .NextWait() returned nil. Go ahead and
 		// start from the beginning.
@@ -238,6 +742,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 				if next = memR.mempool.TxsFront(); next == nil {
 					continue
 				}
+			case <-haveTxs.flushCh:
+				memR.sendHaveTxs(peer, haveTxs)
+				continue
 			case <-peer.Quit():
 				return
 			case <-memR.Quit():
@@ -297,35 +804,139 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// NOTE: Transaction batching was disabled due to
 		// https://github.com/tendermint/tendermint/issues/5796
 
-		if !memR.isSender(memTx.tx.Key(), peer.ID()) && !isFromPeer { // do not send tx received from a peer
+		// Transactions removed from the mempool since, e.g. replaced ones, are
+		// not sent.
+		if !next.Removed() &&
+			!memR.isSender(memTx.tx.Key(), peer.ID()) && !isFromPeer && // do not send tx received from a peer
+			memR.isGossipTarget(memTx.tx.Key(), peer.ID()) {
 
 			memR.Logger.Info("sending tx to peer", "peer", peer.ID(),
 				"tx", memTx.tx.Hash()[:8], "height", memTx.Height(),
 				"txs", memR.mempool.Size(), "peerHeight", peerState.GetHeight(),
-				"peerPersistent", peer.IsPersistent())
+				"peerPersistent", peer.IsPersistent(), "pull", pull)
 
-			success := peer.Send(p2p.Envelope{
-				ChannelID: MempoolChannel,
-				Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
-			})
-			if !success {
-				time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
-				continue
+			if pull {
+				if len(haveTxs.keys) >= maxTxKeysPerMsg && !memR.sendHaveTxs(peer, haveTxs) {
+					time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
+					continue
+				}
+				haveTxs.add(memTx.tx.Key())
+			} else {
+				success := peer.Send(p2p.Envelope{
+					ChannelID: MempoolChannel,
+					Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
+				})
+				if !success {
+					time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
+					continue
+				}
+				if memR.mempool.txTracer != nil {
+					memR.mempool.txTracer.Gossiped(memTx.tx.Key())
+				}
 			}
 		}
 
-		select {
-		case <-next.NextWaitChan():
-			// see the start of the for loop for nil check
-			next = next.Next()
-		case <-peer.Quit():
-			return
-		case <-memR.Quit():
-			return
+	wait:
+		for {
+			select {
+			case <-next.NextWaitChan():
+				// see the start of the for loop for nil check
+				next = next.Next()
+				break wait
+			case <-haveTxs.flushCh:
+				memR.sendHaveTxs(peer, haveTxs)
+			case <-peer.Quit():
+				return
+			case <-memR.Quit():
+				return
+			}
 		}
 	}
 }
 
+// haveTxsBatch holds the keys of the transactions waiting to be announced to a
+// peer in a single HaveTxs message.
+type haveTxsBatch struct {
+	keys    []types.TxKey
+	flushCh <-chan time.Time // fires when the batch must be sent; nil if empty
+}
+
+func (b *haveTxsBatch) add(txKey types.TxKey) {
+	if len(b.keys) == 0 {
+		b.flushCh = time.After(haveTxsBatchInterval)
+	}
+	b.keys = append(b.keys, txKey)
+}
+
+// sendHaveTxs announces the keys of the batch to peer, and empties the batch.
+// If the message cannot be sent, the batch is kept and sent again later.
+func (memR *Reactor) sendHaveTxs(peer p2p.Peer, b *haveTxsBatch) bool {
+	if len(b.keys) == 0 {
+		return true
+	}
+
+	rawKeys := make([][]byte, len(b.keys))
+	for i := range b.keys {
+		rawKeys[i] = b.keys[i][:]
+	}
+	if !peer.Send(p2p.Envelope{
+		ChannelID: MempoolAnnounceChannel,
+		Message:   &protomem.HaveTxs{TxKeys: rawKeys},
+	}) {
+		b.flushCh = time.After(PeerCatchupSleepIntervalMS * time.Millisecond)
+		return false
+	}
+
+	if memR.mempool.txTracer != nil {
+		for _, txKey := range b.keys {
+			memR.mempool.txTracer.Gossiped(txKey)
+		}
+	}
+	b.keys = nil
+	b.flushCh = nil
+	return true
+}
+
+// isGossipTarget returns true if the transaction should be relayed to the
+// given peer. If the gossip fan-out is limited, the peers a transaction is
+// relayed to are selected among the peers that did not send it, the first time
+// this is called for the transaction.
+func (memR *Reactor) isGossipTarget(txKey types.TxKey, peerID p2p.ID) bool {
+	fanout := memR.fanout.fanout()
+	if fanout == 0 {
+		return true
+	}
+
+	memR.txTargetsMtx.Lock()
+	defer memR.txTargetsMtx.Unlock()
+
+	targets, ok := memR.txTargets[txKey]
+	if !ok {
+		var candidates []p2p.Peer
+		for _, peer := range memR.Switch.Peers().Copy() {
+			if !memR.isSender(txKey, peer.ID()) {
+				candidates = append(candidates, peer)
+			}
+		}
+		selected := selectPeers(candidates, fanout, memR.config.GossipPeerSelection)
+		targets = make(map[p2p.ID]struct{}, len(selected))
+		for _, peer := range selected {
+			targets[peer.ID()] = struct{}{}
+		}
+		memR.txTargets[txKey] = targets
+	}
+
+	_, ok = targets[peerID]
+	return ok
+}
+
+func (memR *Reactor) removeTxTargets(txKey types.TxKey) {
+	memR.txTargetsMtx.Lock()
+	defer memR.txTargetsMtx.Unlock()
+
+	delete(memR.txTargets, txKey)
+}
+
 func (memR *Reactor) isSender(txKey types.TxKey, peerID p2p.ID) bool {
 	memR.txSendersMtx.Lock()
 	defer memR.txSendersMtx.Unlock()
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"sync"
@@ -9,7 +10,10 @@ import (
 
 	"github.com/fortytw2/leaktest"
 	"github.com/go-kit/log/term"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	tmock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
@@ -18,6 +22,8 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -265,6 +271,423 @@ func TestReactorTxSendersLocal(t *testing.T) {
 	require.True(t, reactor.isSender(types.Tx(tx2).Key(), "peer1"))
 }
 
+// Send a bunch of txs to the first reactor's mempool and wait for them all to
+// be received in the others, which announce and request them by their keys.
+func TestReactorPullGossip(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PullGossip = true
+	const N = 3
+	reactors, _ := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			require.True(t, r.usesPullGossip(peer))
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := checkTxs(t, reactors[0].mempool, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+// The transactions announced by a peer are not requested while the CheckTx
+// queue is backlogged, and are requested again when announced after a failed
+// request.
+func TestReactorPullGossipBackPressure(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	config := cfg.TestMempoolConfig()
+	config.PullGossip = true
+	config.Broadcast = false
+	config.CheckTxBatchSize = 1
+	config.Size = 4
+	// The reactor is not started, so that the CheckTx queue is not drained.
+	reactor := NewReactor(config, mp, false)
+	reactor.SetLogger(log.NewNopLogger())
+	for i := 0; i < config.Size/2; i++ {
+		reactor.checkTxQueue <- receivedTx{}
+	}
+
+	txKey := types.Tx(kvstore.NewTx("key", "value")).Key()
+	quit := make(chan struct{})
+	defer close(quit)
+	requests := make(chan struct{}, 10)
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("peer"))
+	peer.On("NodeInfo").Return(p2p.DefaultNodeInfo{Channels: []byte{MempoolChannel, MempoolAnnounceChannel}})
+	peer.On("IsRunning").Return(true)
+	peer.On("Quit").Return((<-chan struct{})(quit))
+	wantTxs := tmock.MatchedBy(func(e p2p.Envelope) bool {
+		msg, ok := e.Message.(*memproto.WantTxs)
+		return ok && len(msg.TxKeys) == 1 && bytes.Equal(msg.TxKeys[0], txKey[:])
+	})
+	peer.On("Send", wantTxs).Run(func(tmock.Arguments) { requests <- struct{}{} }).Return(false).Once()
+	peer.On("Send", wantTxs).Run(func(tmock.Arguments) { requests <- struct{}{} }).Return(true).Once()
+	require.True(t, reactor.usesPullGossip(peer))
+	reactor.AddPeer(peer)
+
+	haveTxs := p2p.Envelope{
+		Src:       peer,
+		ChannelID: MempoolAnnounceChannel,
+		Message:   &memproto.HaveTxs{TxKeys: [][]byte{txKey[:]}},
+	}
+	reactor.Receive(haveTxs)
+	select {
+	case <-requests:
+		t.Fatal("tx requested while the CheckTx queue is backlogged")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The request fails once the queue is drained.
+	for len(reactor.checkTxQueue) > 0 {
+		<-reactor.checkTxQueue
+	}
+	select {
+	case <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx not requested once the CheckTx queue is drained")
+	}
+
+	// The transaction is requested again when next announced, and only once.
+	reactor.Receive(haveTxs)
+	select {
+	case <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx not requested again")
+	}
+	reactor.Receive(haveTxs)
+	select {
+	case <-requests:
+		t.Fatal("tx requested twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// The duplicate transactions received from a peer are counted until it is
+// removed.
+func TestReactorPeerDuplicateTxs(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	duplicates := NewPeerCounter(prometheus.CounterOpts{Name: "peer_duplicate_txs"})
+	mp.metrics.PeerDuplicateTxs = duplicates
+
+	config := cfg.TestMempoolConfig()
+	config.Broadcast = false
+	reactor := NewReactor(config, mp, false)
+	reactor.SetLogger(log.NewNopLogger())
+	require.NoError(t, reactor.Start())
+	defer func() {
+		require.NoError(t, reactor.Stop())
+	}()
+
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("peer"))
+	peer.On("NodeInfo").Return(p2p.DefaultNodeInfo{Channels: []byte{MempoolChannel}})
+	reactor.AddPeer(peer)
+
+	tx := kvstore.NewTx("key", "value")
+	for i := 0; i < 3; i++ {
+		reactor.Receive(p2p.Envelope{
+			Src:       peer,
+			ChannelID: MempoolChannel,
+			Message:   &memproto.Txs{Txs: [][]byte{tx}},
+		})
+	}
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(duplicates.cv.WithLabelValues("peer")) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The series of the peer is deleted when it is removed.
+	reactor.RemovePeer(peer, nil)
+	assert.Zero(t, testutil.CollectAndCount(duplicates.cv))
+	duplicates.Add(peer.ID(), 1)
+	assert.Zero(t, testutil.CollectAndCount(duplicates.cv))
+}
+
+// The keys of the transactions are announced to a peer using pull-based
+// gossip in batches.
+func TestReactorPullGossipBatchesHaveTxs(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	config := cfg.TestMempoolConfig()
+	config.PullGossip = true
+	reactor := NewReactor(config, mp, false)
+	reactor.SetLogger(log.NewNopLogger())
+	require.NoError(t, reactor.Start())
+	defer func() {
+		require.NoError(t, reactor.Stop())
+	}()
+
+	const numTxs = maxTxKeysPerMsg + 10
+	txs := newUniqueTxs(numTxs)
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx)
+		require.NoError(t, err)
+	}
+
+	var (
+		mtx       sync.Mutex
+		announced [][][]byte
+	)
+	quit := make(chan struct{})
+	defer close(quit)
+	peer := &p2pmocks.Peer{}
+	peer.On("ID").Return(p2p.ID("peer"))
+	peer.On("NodeInfo").Return(p2p.DefaultNodeInfo{Channels: []byte{MempoolChannel, MempoolAnnounceChannel}})
+	peer.On("IsRunning").Return(true)
+	peer.On("IsPersistent").Return(false)
+	peer.On("Quit").Return((<-chan struct{})(quit))
+	peer.On("Get", types.PeerStateKey).Return(peerState{1})
+	peer.On("Send", tmock.MatchedBy(func(e p2p.Envelope) bool {
+		return e.ChannelID == MempoolAnnounceChannel
+	})).Run(func(args tmock.Arguments) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		announced = append(announced, args.Get(0).(p2p.Envelope).Message.(*memproto.HaveTxs).TxKeys)
+	}).Return(true)
+	require.True(t, reactor.usesPullGossip(peer))
+
+	go reactor.broadcastTxRoutine(peer)
+
+	announcedKeys := func() [][]byte {
+		mtx.Lock()
+		defer mtx.Unlock()
+		var keys [][]byte
+		for _, msgKeys := range announced {
+			keys = append(keys, msgKeys...)
+		}
+		return keys
+	}
+	require.Eventually(t, func() bool { return len(announcedKeys()) == numTxs }, 5*time.Second, 10*time.Millisecond)
+
+	// All the keys are announced once, in order, in fewer messages than
+	// transactions, none of them holding too many keys.
+	keys := announcedKeys()
+	for i, tx := range txs {
+		txKey := tx.Key()
+		require.Equal(t, txKey[:], keys[i])
+	}
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Less(t, len(announced), numTxs)
+	for _, msgKeys := range announced {
+		require.LessOrEqual(t, len(msgKeys), maxTxKeysPerMsg)
+	}
+}
+
+// Requests beyond the maximum size of a peer's queue are dropped.
+func TestTxRequestQueue(t *testing.T) {
+	q := newTxRequestQueue()
+	txKeys := []types.TxKey{{1}, {2}, {3}}
+
+	require.Equal(t, 0, q.push(txKeys[:2], 3))
+	require.Equal(t, 2, q.push(txKeys, 3))
+	require.Len(t, q.ready, 1)
+	require.Equal(t, []types.TxKey{{1}, {2}, {1}}, q.popAll())
+
+	require.Empty(t, q.popAll())
+	require.Equal(t, 0, q.push(txKeys, 3))
+	require.Equal(t, txKeys, q.popAll())
+}
+
+// Test that transactions are pushed to and from peers that have not enabled
+// pull-based gossip.
+func TestReactorPullGossipMixedPeers(t *testing.T) {
+	config := cfg.TestConfig()
+	pullConfig := *config.Mempool
+	pullConfig.PullGossip = true
+	const N = 3
+	reactors, _ := makeAndConnectReactorsWithConfigs(config.P2P, []*cfg.MempoolConfig{
+		&pullConfig, config.Mempool, &pullConfig,
+	})
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for i, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peerIndex := 0
+			for j := 0; j < N; j++ {
+				if reactors[j].Switch.NodeInfo().ID() == peer.ID() {
+					peerIndex = j
+				}
+			}
+			require.Equal(t, i != 1 && peerIndex != 1, r.usesPullGossip(peer))
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := checkTxs(t, reactors[1].mempool, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+// Test that each transaction is relayed to only one peer when the fan-out is 1.
+func TestReactorGossipFanout(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.GossipFanout = 1
+	const N = 4
+	reactors, _ := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	const numTxs = 100
+	txs := checkTxs(t, reactors[0].mempool, numTxs)
+
+	received := func() int {
+		total := 0
+		for _, r := range reactors[1:] {
+			total += r.mempool.Size()
+		}
+		return total
+	}
+	require.Eventually(t, func() bool { return received() >= len(txs) }, timeout, 100*time.Millisecond)
+	time.Sleep(500 * time.Millisecond)
+	require.Equal(t, len(txs), received())
+}
+
+func TestReactorBatchedCheckTx(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.CheckTxBatchSize = 10
+	config.Mempool.MaxInFlightCheckTxs = 3
+	const N = 2
+	reactors, _ := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	// Transactions are checked in the order they are received.
+	txs := checkTxs(t, reactors[0].mempool, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInOrder)
+
+	// All slots are released once the transactions are checked.
+	require.Empty(t, reactors[1].checkTxSlots)
+}
+
+// Receiving transactions never blocks: transactions are dropped when the
+// queue of transactions waiting to be checked is full.
+func TestReactorDropsTxsWhenCheckTxQueueIsFull(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	config := cfg.TestMempoolConfig()
+	config.Size = 2
+	config.MaxInFlightCheckTxs = 1
+	reactor := NewReactor(config, mp, false)
+	reactor.SetLogger(log.TestingLogger())
+
+	// The reactor is not started yet, so queued transactions are not checked.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reactor.Receive(p2p.Envelope{
+			ChannelID: MempoolChannel,
+			Src:       mock.NewPeer(nil),
+			Message:   &memproto.Txs{Txs: newUniqueTxs(5).ToSliceOfBytes()},
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Receive blocked on a full queue")
+	}
+	require.Len(t, reactor.checkTxQueue, 2)
+
+	// The queued transactions are checked once the reactor starts.
+	require.NoError(t, reactor.Start())
+	defer func() {
+		require.NoError(t, reactor.Stop())
+	}()
+	require.Eventually(t, func() bool { return mp.Size() == 2 }, time.Second, 10*time.Millisecond)
+	require.Empty(t, reactor.checkTxQueue)
+}
+
+func TestReactorWantedTxKeys(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.PullGossip = true
+	reactors, _ := makeAndConnectReactors(config, 1)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	reactor := reactors[0]
+
+	txs := newUniqueTxs(3)
+	_, err := reactor.mempool.CheckTx(txs[0])
+	require.NoError(t, err)
+
+	txKeys := []types.TxKey{txs[0].Key(), txs[1].Key(), txs[2].Key()}
+	txKey1, txKey2 := txs[1].Key(), txs[2].Key()
+
+	// The transaction in the mempool is not requested.
+	require.Equal(t, [][]byte{txKey1[:], txKey2[:]}, reactor.wantedTxKeys(txKeys))
+
+	// Requested transactions are not requested again until they time out.
+	require.Empty(t, reactor.wantedTxKeys(txKeys))
+
+	// Received transactions are not requested again either, as they are in
+	// the cache.
+	reactor.removeRequestedTx(txKey1)
+	_, err = reactor.mempool.CheckTx(txs[1])
+	require.NoError(t, err)
+	require.Empty(t, reactor.wantedTxKeys(txKeys))
+}
+
+func TestParseTxKeys(t *testing.T) {
+	txKey := types.Tx("tx").Key()
+
+	txKeys, err := parseTxKeys([][]byte{txKey[:]})
+	require.NoError(t, err)
+	require.Equal(t, []types.TxKey{txKey}, txKeys)
+
+	_, err = parseTxKeys(nil)
+	require.Error(t, err)
+	_, err = parseTxKeys([][]byte{txKey[:10]})
+	require.Error(t, err)
+	_, err = parseTxKeys(make([][]byte, maxTxKeysPerMsg+1))
+	require.Error(t, err)
+}
+
 // Test that:
 // - If a transaction came from a peer AND if the transaction is added to the
 // mempool, it must have a non-empty list of senders in the reactor.
@@ -554,6 +977,16 @@ func mempoolLogger() log.Logger {
 
 // connect N mempool reactors through N switches.
 func makeAndConnectReactors(config *cfg.Config, n int) ([]*Reactor, []*p2p.Switch) {
+	mempoolConfigs := make([]*cfg.MempoolConfig, n)
+	for i := range mempoolConfigs {
+		mempoolConfigs[i] = config.Mempool
+	}
+	return makeAndConnectReactorsWithConfigs(config.P2P, mempoolConfigs)
+}
+
+// connect mempool reactors, each with its own config, through switches.
+func makeAndConnectReactorsWithConfigs(p2pConfig *cfg.P2PConfig, mempoolConfigs []*cfg.MempoolConfig) ([]*Reactor, []*p2p.Switch) {
+	n := len(mempoolConfigs)
 	reactors := make([]*Reactor, n)
 	logger := mempoolLogger()
 	for i := 0; i < n; i++ {
@@ -562,11 +995,11 @@ func makeAndConnectReactors(config *cfg.Config, n int) ([]*Reactor, []*p2p.Switc
 		mempool, cleanup := newMempoolWithApp(cc)
 		defer cleanup()
 
-		reactors[i] = NewReactor(config.Mempool, mempool, false) // so we dont start the consensus states
+		reactors[i] = NewReactor(mempoolConfigs[i], mempool, false) // so we dont start the consensus states
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
 
-	switches := p2p.MakeConnectedSwitches(config.P2P, n, func(i int, s *p2p.Switch) *p2p.Switch {
+	switches := p2p.MakeConnectedSwitches(p2pConfig, n, func(i int, s *p2p.Switch) *p2p.Switch {
 		s.AddReactor("MEMPOOL", reactors[i])
 		return s
 	}, p2p.Connect2Switches)
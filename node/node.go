@@ -16,6 +16,7 @@ import (
 
 	_ "net/http/pprof" //nolint: gosec
 
+	dbm "github.com/cometbft/cometbft-db"
 	cfg "github.com/cometbft/cometbft/config"
 	bc "github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
@@ -62,18 +63,22 @@ type Node struct {
 	isListening bool
 
 	// services
-	eventBus          *types.EventBus // pub/sub for services
+	eventBus          *types.EventBus        // pub/sub for services
+	txTracer          *types.TxTracer        // nil if tx tracing is disabled
+	livenessTracker   *types.LivenessTracker // nil if liveness tracking is disabled
 	stateStore        sm.Store
 	blockStore        *store.BlockStore // store the blockchain to disk
 	pruner            *sm.Pruner
 	bcReactor         p2p.Reactor        // for block-syncing
 	mempoolReactor    waitSyncP2PReactor // for gossipping transactions
 	mempool           mempl.Mempool
+	mempoolDB         dbm.DB                  // mempool journal, or nil if disabled
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
 	stateSyncGenesis  sm.State                // provides the genesis state for state sync
 	consensusState    *cs.State               // latest consensus state
+	csRecorder        *cs.Recorder            // recording of the consensus inputs, or nil if disabled
 	consensusReactor  *cs.Reactor             // for participating in the consensus
 	pexReactor        *pex.Reactor            // for exchanging peer addresses
 	evidencePool      *evidence.Pool          // tracking evidence
@@ -322,8 +327,10 @@ func NewNode(ctx context.Context,
 		return nil, err
 	}
 
+	txTracer := createTxTracer(config, eventBus, memplMetrics)
+
 	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(config,
-		genDoc.ChainID, dbProvider, eventBus, logger)
+		genDoc.ChainID, dbProvider, eventBus, txTracer, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -374,7 +381,17 @@ func NewNode(ctx context.Context,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, waitSync, memplMetrics, logger)
+	var mempoolDB dbm.DB
+	if config.Mempool.Journal && config.Mempool.Type != cfg.MempoolTypeNop {
+		mempoolDB, err = dbProvider(&cfg.DBContext{ID: "mempool", Config: config})
+		if err != nil {
+			return nil, err
+		}
+	}
+	mempool, mempoolReactor, err := createMempoolAndMempoolReactor(config, proxyApp, state, mempoolDB, eventBus, txTracer, waitSync, memplMetrics, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
 	if err != nil {
@@ -394,16 +411,30 @@ func NewNode(ctx context.Context,
 		return nil, fmt.Errorf("failed to create pruner: %w", err)
 	}
 
+	// record the inputs of consensus, including the responses of the app
+	consensusConn := proxyApp.Consensus()
+	var csRecorder *cs.Recorder
+	if config.Consensus.RecordEnabled() {
+		csRecorder, err = cs.NewRecorder(config.Consensus.RecordFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to open consensus recording: %w", err)
+		}
+		csRecorder.SetLogger(consensusLogger)
+		consensusConn = csRecorder.AppConnConsensus(consensusConn)
+	}
+
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
 		logger.With("module", "state"),
-		proxyApp.Consensus(),
+		consensusConn,
 		mempool,
 		evidencePool,
 		blockStore,
 		sm.BlockExecutorWithPruner(pruner),
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithTxTracer(txTracer),
+		sm.BlockExecutorWithOptimisticExecution(config.Consensus.OptimisticExecution),
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -419,9 +450,10 @@ func NewNode(ctx context.Context,
 		return nil, fmt.Errorf("could not create blocksync reactor: %w", err)
 	}
 
+	livenessTracker := createLivenessTracker(config)
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, waitSync, eventBus, consensusLogger, offlineStateSyncHeight,
+		privValidator, csMetrics, waitSync, eventBus, txTracer, livenessTracker, csRecorder, consensusLogger, offlineStateSyncHeight,
 	)
 
 	err = stateStore.SetOfflineStateSyncHeight(0)
@@ -505,7 +537,9 @@ func NewNode(ctx context.Context,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolDB:        mempoolDB,
 		consensusState:   consensusState,
+		csRecorder:       csRecorder,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
 		stateSync:        stateSync,
@@ -517,6 +551,8 @@ func NewNode(ctx context.Context,
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
+		txTracer:         txTracer,
+		livenessTracker:  livenessTracker,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -529,6 +565,12 @@ func NewNode(ctx context.Context,
 
 // OnStart starts the Node. It implements service.Service.
 func (n *Node) OnStart() error {
+	// Block sync and state sync only start the consensus state once the node
+	// has caught up, so check the guard here to fail before syncing.
+	if err := n.consensusState.CheckDoubleSignGuard(); err != nil {
+		return err
+	}
+
 	now := cmttime.Now()
 	genTime := n.genesisDoc.GenesisTime
 	if genTime.After(now) {
@@ -665,6 +707,18 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing statestore", "err", err)
 		}
 	}
+	if n.mempoolDB != nil {
+		n.Logger.Info("Closing mempool journal")
+		if err := n.mempoolDB.Close(); err != nil {
+			n.Logger.Error("problem closing mempool journal", "err", err)
+		}
+	}
+	if n.csRecorder != nil {
+		n.Logger.Info("Closing consensus recording")
+		if err := n.csRecorder.Close(); err != nil {
+			n.Logger.Error("problem closing consensus recording", "err", err)
+		}
+	}
 	if n.evidencePool != nil {
 		n.Logger.Info("Closing evidencestore")
 		if err := n.EvidencePool().Close(); err != nil {
@@ -698,6 +752,8 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		MempoolReactor:   n.mempoolReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
+		TxTracer:         n.txTracer,
+		LivenessTracker:  n.livenessTracker,
 
 		Logger: n.Logger.With("module", "rpc"),
 
@@ -816,6 +872,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			opts = append(opts, grpcserver.WithMempoolService(n.eventBus, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -1001,6 +1060,10 @@ func makeNodeInfo(
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.Mempool.PullGossip {
+		nodeInfo.Channels = append(nodeInfo.Channels, mempl.MempoolAnnounceChannel)
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
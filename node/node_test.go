@@ -132,6 +132,31 @@ func TestNodeDelayedStart(t *testing.T) {
 	assert.True(t, true, startTime.After(n.GenesisDoc().GenesisTime))
 }
 
+func TestNodeDoubleSignGuard(t *testing.T) {
+	config := test.ResetTestRoot("node_double_sign_guard_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// Add another validator, so that the node block syncs before consensus.
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	require.NoError(t, err)
+	genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{
+		PubKey: ed25519.GenPrivKey().PubKey(),
+		Power:  10,
+	})
+	require.NoError(t, genDoc.SaveAs(config.GenesisFile()))
+
+	// The private validator signed above the height of the node, as after
+	// wiping the data of the node but its private validator state.
+	pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	pv.LastSignState.Height = 10
+	pv.LastSignState.Save()
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+}
+
 func TestNodeSetAppVersion(t *testing.T) {
 	config := test.ResetTestRoot("node_app_version_test")
 	defer os.RemoveAll(config.RootDir)
@@ -107,7 +107,7 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				mempl.PrometheusMetricsWithPeers(config.Namespace, "chain_id", chainID),
 				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				store.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID),
@@ -156,11 +156,33 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
+// createTxTracer returns a tracer publishing to eventBus, or nil if tx tracing
+// is disabled.
+func createTxTracer(config *cfg.Config, eventBus *types.EventBus, memplMetrics *mempl.Metrics) *types.TxTracer {
+	if config.Instrumentation.TxTraces == 0 {
+		return nil
+	}
+	txTracer := types.NewTxTracer(config.Instrumentation.TxTraces)
+	txTracer.SetEventBus(eventBus)
+	txTracer.SetDroppedEventsCounter(memplMetrics.DroppedTxTraceEvents)
+	return txTracer
+}
+
+// createLivenessTracker returns a tracker of the liveness of the validators,
+// or nil if liveness tracking is disabled.
+func createLivenessTracker(config *cfg.Config) *types.LivenessTracker {
+	if config.Consensus.LivenessWindow == 0 {
+		return nil
+	}
+	return types.NewLivenessTracker(int(config.Consensus.LivenessWindow))
+}
+
 func createAndStartIndexerService(
 	config *cfg.Config,
 	chainID string,
 	dbProvider cfg.DBProvider,
 	eventBus *types.EventBus,
+	txTracer *types.TxTracer,
 	logger log.Logger,
 ) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
 	var (
@@ -176,6 +198,7 @@ func createAndStartIndexerService(
 	blockIndexer.SetLogger(logger.With("module", "txindex"))
 	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
 	indexerService.SetLogger(logger.With("module", "txindex"))
+	indexerService.SetTxTracer(txTracer)
 
 	if err := indexerService.Start(); err != nil {
 		return nil, nil, nil, err
@@ -238,15 +261,36 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 	return bytes.Equal(pubKey.Address(), addr)
 }
 
-// createMempoolAndMempoolReactor creates a mempool and a mempool reactor based on the config.
+// createMempoolAndMempoolReactor creates a mempool and a mempool reactor based
+// on the config. If the mempool journal is enabled, it is stored in mempoolDB
+// and replayed into the new mempool.
 func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	mempoolDB dbm.DB,
+	eventBus *types.EventBus,
+	txTracer *types.TxTracer,
 	waitSync bool,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
-) (mempl.Mempool, waitSyncP2PReactor) {
+) (mempl.Mempool, waitSyncP2PReactor, error) {
+	options := []mempl.CListMempoolOption{
+		mempl.WithMetrics(memplMetrics),
+		mempl.WithPreCheck(sm.TxPreCheck(state)),
+		mempl.WithPostCheck(sm.TxPostCheck(state)),
+	}
+	// Mempool tx events are only consumed by the gRPC mempool service.
+	if config.GRPC.MempoolService.Enabled {
+		options = append(options, mempl.WithEventBus(eventBus))
+	}
+	if mempoolDB != nil {
+		options = append(options, mempl.WithJournal(mempoolDB))
+	}
+	if txTracer != nil {
+		options = append(options, mempl.WithTxTracer(txTracer))
+	}
+
 	switch config.Mempool.Type {
 	// allow empty string for backward compatibility
 	case cfg.MempoolTypeFlood, "":
@@ -255,9 +299,7 @@ func createMempoolAndMempoolReactor(
 			config.Mempool,
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
-			mempl.WithMetrics(memplMetrics),
-			mempl.WithPreCheck(sm.TxPreCheck(state)),
-			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			options...,
 		)
 		mp.SetLogger(logger)
 		reactor := mempl.NewReactor(
@@ -269,12 +311,42 @@ func createMempoolAndMempoolReactor(
 			mp.EnableTxsAvailable()
 		}
 		reactor.SetLogger(logger)
+		// Replay the journal once TxsAvailable is enabled, so that consensus
+		// is notified of the replayed transactions.
+		if err := mp.ReplayJournal(); err != nil {
+			return nil, nil, err
+		}
+
+		return mp, reactor, nil
+	case cfg.MempoolTypePriority:
+		logger = logger.With("module", "mempool")
+		mp := mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			options...,
+		)
+		mp.SetLogger(logger)
+		reactor := mempl.NewReactor(
+			config.Mempool,
+			mp.CListMempool,
+			waitSync,
+		)
+		if config.Consensus.WaitForTxs() {
+			mp.EnableTxsAvailable()
+		}
+		reactor.SetLogger(logger)
+		// Replay the journal once TxsAvailable is enabled, so that consensus
+		// is notified of the replayed transactions.
+		if err := mp.ReplayJournal(); err != nil {
+			return nil, nil, err
+		}
 
-		return mp, reactor
+		return mp, reactor, nil
 	case cfg.MempoolTypeNop:
 		// Strictly speaking, there's no need to have a `mempl.NopMempoolReactor`, but
 		// adding it leads to a cleaner code.
-		return &mempl.NopMempool{}, mempl.NewNopMempoolReactor()
+		return &mempl.NopMempool{}, mempl.NewNopMempoolReactor(), nil
 	default:
 		panic(fmt.Sprintf("unknown mempool type: %q", config.Mempool.Type))
 	}
@@ -329,9 +401,21 @@ func createConsensusReactor(config *cfg.Config,
 	csMetrics *cs.Metrics,
 	waitSync bool,
 	eventBus *types.EventBus,
+	txTracer *types.TxTracer,
+	livenessTracker *types.LivenessTracker,
+	recorder *cs.Recorder,
 	consensusLogger log.Logger,
 	offlineStateSyncHeight int64,
 ) (*cs.Reactor, *cs.State) {
+	stateOpts := []cs.StateOption{
+		cs.StateMetrics(csMetrics),
+		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		cs.StateTxTracer(txTracer),
+		cs.StateLivenessTracker(livenessTracker),
+	}
+	if recorder != nil {
+		stateOpts = append(stateOpts, cs.StateRecorder(recorder))
+	}
 	consensusState := cs.NewState(
 		config.Consensus,
 		state.Copy(),
@@ -339,14 +423,17 @@ func createConsensusReactor(config *cfg.Config,
 		blockStore,
 		mempool,
 		evidencePool,
-		cs.StateMetrics(csMetrics),
-		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		stateOpts...,
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	reactorOpts := []cs.ReactorOption{cs.ReactorMetrics(csMetrics)}
+	if txFetcher, ok := mempool.(cs.TxFetcher); ok {
+		reactorOpts = append(reactorOpts, cs.ReactorTxFetcher(txFetcher))
+	}
+	consensusReactor := cs.NewReactor(consensusState, waitSync, reactorOpts...)
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -114,6 +114,11 @@ type MConnection struct {
 	pongTimer     *time.Timer
 	pongTimeoutCh chan bool // true - timeout, false - peer sent pong
 
+	// time the last ping was sent; only accessed by sendRoutine
+	pingSent time.Time
+	// round-trip time measured with the last ping, in nanoseconds
+	rtt atomic.Int64
+
 	chStatsTimer *time.Ticker // update channel stats periodically
 
 	created time.Time // time of creation
@@ -453,6 +458,7 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(_n)
+			c.pingSent = time.Now()
 			c.Logger.Debug("Starting pong timer", "dur", c.config.PongTimeout)
 			c.pongTimer = time.AfterFunc(c.config.PongTimeout, func() {
 				select {
@@ -466,6 +472,9 @@ FOR_LOOP:
 				c.Logger.Debug("Pong timeout")
 				err = errors.New("pong timeout")
 			} else {
+				if c.pongTimer != nil { // ignore unsolicited pongs
+					c.rtt.Store(int64(time.Since(c.pingSent)))
+				}
 				c.stopPongTimer()
 			}
 		case <-c.pong:
@@ -696,6 +705,9 @@ type ConnectionStatus struct {
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
 	Channels    []ChannelStatus
+	// Round-trip time measured with the last ping. 0 if no pong has been
+	// received yet.
+	RTT time.Duration
 }
 
 type ChannelStatus struct {
@@ -711,6 +723,7 @@ func (c *MConnection) Status() ConnectionStatus {
 	status.Duration = time.Since(c.created)
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
+	status.RTT = time.Duration(c.rtt.Load())
 	status.Channels = make([]ChannelStatus, len(c.channels))
 	for i, channel := range c.channels {
 		channel := channel
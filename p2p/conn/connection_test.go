@@ -361,6 +361,7 @@ func TestMConnectionPingPongs(t *testing.T) {
 		t.Fatalf("Expected no error, but got %v", err)
 	case <-time.After(2 * pongTimerExpired):
 		assert.True(t, mconn.IsRunning())
+		assert.Positive(t, mconn.Status().RTT)
 	}
 }
 
@@ -11,7 +11,9 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	cmtos "github.com/cometbft/cometbft/internal/os"
 	"github.com/cometbft/cometbft/internal/protoio"
 	"github.com/cometbft/cometbft/internal/tempfile"
@@ -188,6 +190,24 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
 }
 
+// GenFilePVWithKeyType generates a new validator with a randomly generated
+// private key of the given type (ed25519, secp256k1 or bls12_381), and sets
+// the filePaths, but does not call Save().
+func GenFilePVWithKeyType(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
+	var privKey crypto.PrivKey
+	switch keyType {
+	case ed25519.KeyType:
+		privKey = ed25519.GenPrivKey()
+	case secp256k1.KeyType:
+		privKey = secp256k1.GenPrivKey()
+	case bls12381.KeyType:
+		privKey = bls12381.GenPrivKey()
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+	return NewFilePV(privKey, keyFilePath, stateFilePath), nil
+}
+
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
@@ -282,6 +302,13 @@ func (pv *FilePV) SignProposal(chainID string, proposal *cmtproto.Proposal) erro
 	return nil
 }
 
+// LastSignedHRS returns the height, round and step of the last vote or
+// proposal signed. The step is 1 for a proposal, 2 for a prevote and 3 for a
+// precommit.
+func (pv *FilePV) LastSignedHRS() (height int64, round int32, step int8) {
+	return pv.LastSignState.Height, pv.LastSignState.Round, pv.LastSignState.Step
+}
+
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
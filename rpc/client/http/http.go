@@ -311,6 +311,24 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUn
 	return result, nil
 }
 
+func (c *baseRPCClient) MempoolSenderUsage(ctx context.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error) {
+	result := new(ctypes.ResultMempoolSenderUsage)
+	_, err := c.caller.Call(ctx, "mempool_sender_usage", map[string]interface{}{"sender": sender}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) TxTrace(ctx context.Context, hash []byte) (*ctypes.ResultTxTrace, error) {
+	result := new(ctypes.ResultTxTrace)
+	_, err := c.caller.Call(ctx, "tx_trace", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	result := new(ctypes.ResultCheckTx)
 	_, err := c.caller.Call(ctx, "check_tx", map[string]interface{}{"tx": tx}, result)
@@ -338,6 +356,15 @@ func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultD
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorLiveness(ctx context.Context) (*ctypes.ResultValidatorLiveness, error) {
+	result := new(ctypes.ResultValidatorLiveness)
+	_, err := c.caller.Call(ctx, "validator_liveness", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	result := new(ctypes.ResultConsensusState)
 	_, err := c.caller.Call(ctx, "consensus_state", map[string]interface{}{}, result)
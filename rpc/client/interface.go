@@ -111,6 +111,7 @@ type NetworkClient interface {
 	NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
+	ValidatorLiveness(ctx context.Context) (*ctypes.ResultValidatorLiveness, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
 }
@@ -136,6 +137,8 @@ type EventsClient interface {
 type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error)
+	MempoolSenderUsage(ctx context.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error)
+	TxTrace(ctx context.Context, hash []byte) (*ctypes.ResultTxTrace, error)
 	CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
 }
 
@@ -106,6 +106,14 @@ func (c *Local) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs
 	return c.env.NumUnconfirmedTxs(c.ctx)
 }
 
+func (c *Local) MempoolSenderUsage(_ context.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error) {
+	return c.env.MempoolSenderUsage(c.ctx, sender)
+}
+
+func (c *Local) TxTrace(_ context.Context, hash []byte) (*ctypes.ResultTxTrace, error) {
+	return c.env.TxTrace(c.ctx, hash)
+}
+
 func (c *Local) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.env.CheckTx(c.ctx, tx)
 }
@@ -118,6 +126,10 @@ func (c *Local) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensus
 	return c.env.DumpConsensusState(c.ctx)
 }
 
+func (c *Local) ValidatorLiveness(context.Context) (*ctypes.ResultValidatorLiveness, error) {
+	return c.env.ValidatorLiveness(c.ctx)
+}
+
 func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.env.GetConsensusState(c.ctx)
 }
@@ -132,6 +132,10 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ValidatorLiveness(_ context.Context) (*ctypes.ResultValidatorLiveness, error) {
+	return c.env.ValidatorLiveness(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
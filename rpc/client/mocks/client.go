@@ -542,6 +542,29 @@ func (_m *Client) IsRunning() bool {
 	return r0
 }
 
+// MempoolSenderUsage provides a mock function with given fields: ctx, sender
+func (_m *Client) MempoolSenderUsage(ctx context.Context, sender string) (*coretypes.ResultMempoolSenderUsage, error) {
+	ret := _m.Called(ctx, sender)
+
+	var r0 *coretypes.ResultMempoolSenderUsage
+	if rf, ok := ret.Get(0).(func(context.Context, string) *coretypes.ResultMempoolSenderUsage); ok {
+		r0 = rf(ctx, sender)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultMempoolSenderUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sender)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetInfo provides a mock function with given fields: _a0
 func (_m *Client) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error) {
 	ret := _m.Called(_a0)
@@ -797,6 +820,29 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
+// TxTrace provides a mock function with given fields: ctx, hash
+func (_m *Client) TxTrace(ctx context.Context, hash []byte) (*coretypes.ResultTxTrace, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultTxTrace
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultTxTrace); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxTrace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnconfirmedTxs provides a mock function with given fields: ctx, limit
 func (_m *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(ctx, limit)
@@ -848,6 +894,29 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// ValidatorLiveness provides a mock function with given fields: _a0
+func (_m *Client) ValidatorLiveness(_a0 context.Context) (*coretypes.ResultValidatorLiveness, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultValidatorLiveness
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultValidatorLiveness); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorLiveness)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
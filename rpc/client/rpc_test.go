@@ -399,6 +399,23 @@ func TestUnconfirmedTxs(t *testing.T) {
 	mempool.Flush()
 }
 
+func TestMempoolSenderUsage(t *testing.T) {
+	for i, c := range GetClients() {
+		mc, ok := c.(client.MempoolClient)
+		require.True(t, ok, "%d", i)
+
+		// The kvstore application does not set the sender of transactions.
+		res, err := mc.MempoolSenderUsage(context.Background(), "alice")
+		require.NoError(t, err, "%d: %+v", i, err)
+		assert.Equal(t, "alice", res.Sender)
+		assert.Zero(t, res.Count)
+		assert.Zero(t, res.TotalBytes)
+
+		_, err = mc.MempoolSenderUsage(context.Background(), "")
+		require.Error(t, err, "%d", i)
+	}
+}
+
 func TestNumUnconfirmedTxs(t *testing.T) {
 	_, _, tx := MakeTxKV()
 
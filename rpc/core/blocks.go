@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	cmtquery "github.com/cometbft/cometbft/internal/pubsub/query"
 	blockidxnull "github.com/cometbft/cometbft/internal/state/indexer/block/null"
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -162,15 +163,38 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 	// If the next block has not been committed yet,
 	// use a non-canonical commit
 	if height == env.BlockStore.Height() {
-		commit := env.BlockStore.LoadSeenCommit(height)
+		commit, err := aggregateCommit(env.BlockStore.LoadSeenCommit(height))
+		if err != nil {
+			return nil, err
+		}
 		return ctypes.NewResultCommit(&header, commit, false), nil
 	}
 
 	// Return the canonical commit (comes from the block at height+1)
-	commit := env.BlockStore.LoadBlockCommit(height)
+	commit, err := aggregateCommit(env.BlockStore.LoadBlockCommit(height))
+	if err != nil {
+		return nil, err
+	}
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
+// aggregateCommit aggregates the signatures of the commit if all of them are
+// BLS12-381 signatures, so that light clients and relayers get a smaller
+// commit which they verify with a single pairing product. Blocks keep the
+// individual signatures, which are needed to rebuild the votes of the commit.
+func aggregateCommit(commit *types.Commit) (*types.Commit, error) {
+	if commit == nil || commit.IsAggregated() {
+		return commit, nil
+	}
+	for _, commitSig := range commit.Signatures {
+		// no other key type has signatures of this size
+		if commitSig.BlockIDFlag != types.BlockIDFlagAbsent && len(commitSig.Signature) != bls12381.SignatureSize {
+			return commit, nil
+		}
+	}
+	return commit.Aggregate()
+}
+
 // BlockResults gets ABCIResults at a given height.
 // If no height is provided, it will fetch results for the latest block.
 //
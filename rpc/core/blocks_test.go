@@ -2,17 +2,24 @@ package core
 
 import (
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sm "github.com/cometbft/cometbft/internal/state"
 	"github.com/cometbft/cometbft/internal/state/mocks"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestBlockchainInfo(t *testing.T) {
@@ -113,3 +120,65 @@ func TestBlockResults(t *testing.T) {
 		}
 	}
 }
+
+func TestCommitAggregated(t *testing.T) {
+	const (
+		chainID = "test_chain_id"
+		height  = int64(10)
+	)
+	blockID := types.BlockID{
+		Hash:          tmhash.Sum([]byte("block")),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("part_set"))},
+	}
+
+	testCases := []struct {
+		name          string
+		privKey       func() crypto.PrivKey
+		expAggregated bool
+	}{
+		{"ed25519", func() crypto.PrivKey { return ed25519.GenPrivKey() }, false},
+		{"bls12381", func() crypto.PrivKey { return bls12381.GenPrivKey() }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vals := make([]*types.Validator, 4)
+			privVals := make([]types.PrivValidator, 4)
+			for i := range privVals {
+				privVal := types.NewMockPVWithParams(tc.privKey(), false, false)
+				vals[i] = privVal.ExtractIntoValidator(10)
+				privVals[i] = privVal
+			}
+			sort.Sort(types.PrivValidatorsByAddress(privVals))
+			valSet := types.NewValidatorSet(vals)
+
+			voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, valSet)
+			// the last validator is absent
+			extCommit, err := types.MakeExtCommit(blockID, height, 0, voteSet, privVals[:3], time.Now(), false)
+			require.NoError(t, err)
+			commit := extCommit.ToCommit()
+
+			mockstore := &mocks.BlockStore{}
+			mockstore.On("Height").Return(height + 1)
+			mockstore.On("Base").Return(int64(1))
+			mockstore.On("LoadBlockMeta", height).Return(&types.BlockMeta{
+				BlockID: blockID,
+				Header:  types.Header{ChainID: chainID, Height: height},
+			})
+			mockstore.On("LoadBlockCommit", height).Return(commit)
+			env := &Environment{BlockStore: mockstore}
+
+			h := height
+			res, err := env.Commit(&rpctypes.Context{}, &h)
+			require.NoError(t, err)
+			resCommit := res.SignedHeader.Commit
+			assert.Equal(t, tc.expAggregated, resCommit.IsAggregated())
+			if tc.expAggregated {
+				assert.Less(t, resCommit.ToProto().Size(), commit.ToProto().Size())
+			}
+
+			require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, height, resCommit))
+			require.NoError(t, valSet.VerifyCommit(chainID, blockID, height, resCommit))
+		})
+	}
+}
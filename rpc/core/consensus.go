@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 
 	cm "github.com/cometbft/cometbft/internal/consensus"
@@ -53,6 +54,22 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorLiveness returns how the validators signed the commits of the last
+// heights: how many they did not sign or signed for nil, and how late their
+// signatures were.
+// More: https://docs.cometbft.com/main/rpc/#/Info/validator_liveness
+func (env *Environment) ValidatorLiveness(*rpctypes.Context) (*ctypes.ResultValidatorLiveness, error) {
+	if env.LivenessTracker == nil {
+		return nil, errors.New("validator liveness tracking is disabled")
+	}
+	fromHeight, toHeight, liveness := env.LivenessTracker.Liveness()
+	return &ctypes.ResultValidatorLiveness{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Validators: liveness,
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/main/rpc/#/Info/dump_consensus_state
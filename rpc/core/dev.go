@@ -1,6 +1,9 @@
 package core
 
 import (
+	"errors"
+
+	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
@@ -10,3 +13,21 @@ func (env *Environment) UnsafeFlushMempool(*rpctypes.Context) (*ctypes.ResultUns
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// mempoolDumper is implemented by mempool reactors able to dump the content
+// of the mempool.
+type mempoolDumper interface {
+	Dump() *mempl.Dump
+}
+
+// UnsafeDumpMempool returns all transactions in the mempool, with their
+// metadata and the peers they were received from. It is used by
+// `cometbft mempool dump`.
+func (env *Environment) UnsafeDumpMempool(*rpctypes.Context) (*ctypes.ResultUnsafeDumpMempool, error) {
+	dumper, ok := env.MempoolReactor.(mempoolDumper)
+	if !ok {
+		return nil, errors.New("the mempool does not support dumps")
+	}
+	dump := dumper.Dump()
+	return &ctypes.ResultUnsafeDumpMempool{Height: dump.Height, Txs: dump.Txs}, nil
+}
@@ -23,6 +23,7 @@ Available endpoints:
 /net_info
 /num_unconfirmed_txs
 /status
+/unsafe_dump_mempool
 /unsafe_flush_mempool
 /unsubscribe_all?
 
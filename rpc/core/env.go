@@ -87,6 +87,9 @@ type Environment struct {
 	BlockIndexer indexer.BlockIndexer
 	EventBus     *types.EventBus // thread safe
 	Mempool      mempl.Mempool
+	TxTracer     *types.TxTracer // nil if tracing is disabled
+
+	LivenessTracker *types.LivenessTracker // nil if liveness tracking is disabled
 
 	Logger log.Logger
 
@@ -24,6 +24,7 @@ func (env *Environment) BroadcastTxAsync(_ *rpctypes.Context, tx types.Tx) (*cty
 	if env.MempoolReactor.WaitSync() {
 		return nil, ErrEndpointClosedCatchingUp
 	}
+	env.traceReceivedTx(tx)
 	_, err := env.Mempool.CheckTx(tx)
 	if err != nil {
 		return nil, err
@@ -40,6 +41,7 @@ func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ct
 	}
 
 	resCh := make(chan *abci.CheckTxResponse, 1)
+	env.traceReceivedTx(tx)
 	reqRes, err := env.Mempool.CheckTx(tx)
 	if err != nil {
 		return nil, err
@@ -97,6 +99,7 @@ func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*
 
 	// Broadcast tx and wait for CheckTx result
 	checkTxResCh := make(chan *abci.CheckTxResponse, 1)
+	env.traceReceivedTx(tx)
 	reqRes, err := env.Mempool.CheckTx(tx)
 	if err != nil {
 		env.Logger.Error("Error on broadcastTxCommit", "err", err)
@@ -182,6 +185,21 @@ func (env *Environment) NumUnconfirmedTxs(*rpctypes.Context) (*ctypes.ResultUnco
 	}, nil
 }
 
+// MempoolSenderUsage gets the number and total size of the unconfirmed
+// transactions of a sender, as set by the application in CheckTx.
+// More: https://docs.cometbft.com/main/rpc/#/Info/mempool_sender_usage
+func (env *Environment) MempoolSenderUsage(_ *rpctypes.Context, sender string) (*ctypes.ResultMempoolSenderUsage, error) {
+	if sender == "" {
+		return nil, errors.New("sender can't be empty")
+	}
+	numTxs, txsBytes := env.Mempool.SenderUsage(sender)
+	return &ctypes.ResultMempoolSenderUsage{
+		Sender:     sender,
+		Count:      numTxs,
+		TotalBytes: txsBytes,
+	}, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.cometbft.com/main/rpc/#/Tx/check_tx
@@ -192,3 +210,10 @@ func (env *Environment) CheckTx(_ *rpctypes.Context, tx types.Tx) (*ctypes.Resul
 	}
 	return &ctypes.ResultCheckTx{CheckTxResponse: *res}, nil
 }
+
+// traceReceivedTx records tx as received via RPC, if tx tracing is enabled.
+func (env *Environment) traceReceivedTx(tx types.Tx) {
+	if env.TxTracer != nil {
+		env.TxTracer.Received(tx.Key(), types.TxSourceRPC)
+	}
+}
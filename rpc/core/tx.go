@@ -48,6 +48,25 @@ func (env *Environment) Tx(_ *rpctypes.Context, hash []byte, prove bool) (*ctype
 	}, nil
 }
 
+// TxTrace returns when the transaction with the given hash reached each stage
+// of its lifecycle on this node, from its arrival (via RPC or from a peer) to
+// its indexing. Only the most recent transactions are traced.
+// More: https://docs.cometbft.com/main/rpc/#/Info/tx_trace
+func (env *Environment) TxTrace(_ *rpctypes.Context, hash []byte) (*ctypes.ResultTxTrace, error) {
+	if env.TxTracer == nil {
+		return nil, errors.New("transaction tracing is disabled")
+	}
+	if len(hash) != types.TxKeySize {
+		return nil, fmt.Errorf("invalid tx hash length: expected %d bytes, got %d", types.TxKeySize, len(hash))
+	}
+
+	trace, ok := env.TxTracer.Trace(types.TxKey(hash))
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not traced", hash)
+	}
+	return &ctypes.ResultTxTrace{Hash: hash, Trace: trace}, nil
+}
+
 // TxSearch allows you to query for multiple transactions results. It returns a
 // list of transactions (maximum ?per_page entries) and the total count.
 // More: https://docs.cometbft.com/main/rpc/#/Info/tx_search
@@ -8,6 +8,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
+	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/types"
 )
@@ -147,6 +148,14 @@ type ResultValidators struct {
 	Total int `json:"total"`
 }
 
+// Result of querying for the liveness of the validators over the commits of
+// heights FromHeight to ToHeight.
+type ResultValidatorLiveness struct {
+	FromHeight int64                     `json:"from_height"`
+	ToHeight   int64                     `json:"to_height"`
+	Validators []types.ValidatorLiveness `json:"validators"`
+}
+
 // ConsensusParams for given height.
 type ResultConsensusParams struct {
 	BlockHeight     int64                 `json:"block_height"`
@@ -204,6 +213,12 @@ type ResultTx struct {
 	Proof    types.TxProof     `json:"proof,omitempty"`
 }
 
+// Result of querying for the trace of a tx.
+type ResultTxTrace struct {
+	Hash  bytes.HexBytes `json:"hash"`
+	Trace types.TxTrace  `json:"trace"`
+}
+
 // Result of searching for txs.
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
@@ -224,6 +239,13 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Mempool usage of a sender.
+type ResultMempoolSenderUsage struct {
+	Sender     string `json:"sender"`
+	Count      int    `json:"n_txs"`
+	TotalBytes int64  `json:"total_bytes"`
+}
+
 // Info abci msg.
 type ResultABCIInfo struct {
 	Response abci.InfoResponse `json:"response"`
@@ -239,6 +261,12 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Content of the mempool.
+type ResultUnsafeDumpMempool struct {
+	Height int64            `json:"height"`
+	Txs    []mempl.DumpedTx `json:"txs"`
+}
+
 // empty results.
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// MempoolTxEvent reports that a transaction was added to or removed from the
+// mempool. It is sent to the client via the channel returned by
+// SubscribeMempool.
+type MempoolTxEvent struct {
+	Hash []byte
+
+	// Set when the transaction was added to the mempool: the transaction, the
+	// height at which it was checked, and the values assigned to it by the
+	// application in CheckTx.
+	Tx       types.Tx
+	Height   int64
+	Priority int64
+	Lane     string
+	Sender   string
+	Nonce    uint64
+
+	// Set when the transaction was removed from the mempool, with the reason
+	// why it was removed (e.g. "committed", "evicted").
+	Removed bool
+	Reason  string
+
+	// Set if the stream was terminated. No other events are sent afterwards.
+	Error error
+}
+
+type subscribeMempoolConfig struct {
+	chSize uint
+}
+
+type SubscribeMempoolOption func(*subscribeMempoolConfig)
+
+// SubscribeMempoolChannelSize allows control over the channel size. If not
+// used or the channel size is set to 0, an unbuffered channel will be created.
+func SubscribeMempoolChannelSize(sz uint) SubscribeMempoolOption {
+	return func(opts *subscribeMempoolConfig) {
+		opts.chSize = sz
+	}
+}
+
+// MempoolServiceClient provides information about the transactions in the
+// mempool.
+type MempoolServiceClient interface {
+	// SubscribeMempool sends the transactions added to and removed from the
+	// mempool to the resulting output channel. Unlike GetLatestHeight, no
+	// event is skipped if the channel is full: if the client falls too far
+	// behind, the server terminates the stream instead.
+	SubscribeMempool(ctx context.Context, opts ...SubscribeMempoolOption) (<-chan MempoolTxEvent, error)
+}
+
+type mempoolServiceClient struct {
+	client mempoolsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: mempoolsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// SubscribeMempool implements MempoolServiceClient SubscribeMempool.
+func (c *mempoolServiceClient) SubscribeMempool(ctx context.Context, opts ...SubscribeMempoolOption) (<-chan MempoolTxEvent, error) {
+	subscribeClient, err := c.client.Subscribe(ctx, &mempoolsvc.SubscribeRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting a stream of mempool events: %w", err)
+	}
+
+	cfg := &subscribeMempoolConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	eventCh := make(chan MempoolTxEvent, cfg.chSize)
+
+	go func(client mempoolsvc.MempoolService_SubscribeClient) {
+		defer close(eventCh)
+		for {
+			response, err := client.Recv()
+			event := MempoolTxEvent{}
+			if err != nil {
+				event.Error = fmt.Errorf("error receiving a mempool event from a stream: %w", err)
+			} else {
+				event = mempoolTxEventFromProto(response)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case eventCh <- event:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}(subscribeClient)
+
+	return eventCh, nil
+}
+
+func mempoolTxEventFromProto(response *mempoolsvc.SubscribeResponse) MempoolTxEvent {
+	if removed := response.GetRemoved(); removed != nil {
+		return MempoolTxEvent{
+			Hash:    removed.Hash,
+			Removed: true,
+			Reason:  strings.ToLower(strings.TrimPrefix(removed.Reason.String(), "TX_REMOVAL_REASON_")),
+		}
+	}
+	added := response.GetAdded()
+	return MempoolTxEvent{
+		Hash:     added.GetHash(),
+		Tx:       added.GetTx(),
+		Height:   added.GetHeight(),
+		Priority: added.GetPriority(),
+		Lane:     added.GetLane(),
+		Sender:   added.GetSender(),
+		Nonce:    added.GetNonce(),
+	}
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// SubscribeMempool implements MempoolServiceClient SubscribeMempool - disabled client.
+func (*disabledMempoolServiceClient) SubscribeMempool(context.Context, ...SubscribeMempoolOption) (<-chan MempoolTxEvent, error) {
+	panic("mempool service client is disabled")
+}
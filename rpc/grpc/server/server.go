@@ -9,12 +9,14 @@ import (
 
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
+	pbmempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
 	sm "github.com/cometbft/cometbft/internal/state"
 	"github.com/cometbft/cometbft/internal/store"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/mempoolservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
 	"github.com/cometbft/cometbft/types"
 )
@@ -28,6 +30,7 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	mempoolService      pbmempoolsvc.MempoolServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -76,6 +79,13 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithMempoolService enables the mempool service on the CometBFT server.
+func WithMempoolService(eventBus *types.EventBus, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.mempoolService = mempoolservice.New(eventBus, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -115,6 +125,10 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.mempoolService != nil {
+		pbmempoolsvc.RegisterMempoolServiceServer(server, b.mempoolService)
+		b.logger.Debug("Registered mempool service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -0,0 +1,123 @@
+package mempoolservice
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	cmtpubsub "github.com/cometbft/cometbft/internal/pubsub"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/types"
+)
+
+// subscriptionCapacity is the number of events buffered for each subscriber.
+// Subscribers that fall further behind are disconnected.
+const subscriptionCapacity = 1000
+
+// removalReasons maps the reasons reported in mempool events to their
+// Protobuf representation.
+var removalReasons = map[string]mempoolsvc.TxRemovalReason{
+	mempl.TxRemovedManually.String():  mempoolsvc.TX_REMOVAL_REASON_MANUALLY,
+	mempl.TxRemovedCommitted.String(): mempoolsvc.TX_REMOVAL_REASON_COMMITTED,
+	mempl.TxRemovedInvalid.String():   mempoolsvc.TX_REMOVAL_REASON_INVALID,
+	mempl.TxRemovedEvicted.String():   mempoolsvc.TX_REMOVAL_REASON_EVICTED,
+	mempl.TxRemovedExpired.String():   mempoolsvc.TX_REMOVAL_REASON_EXPIRED,
+	mempl.TxRemovedFlushed.String():   mempoolsvc.TX_REMOVAL_REASON_FLUSHED,
+	mempl.TxRemovedReplaced.String():  mempoolsvc.TX_REMOVAL_REASON_REPLACED,
+}
+
+type mempoolServiceServer struct {
+	eventBus *types.EventBus
+	logger   log.Logger
+}
+
+// New creates a new CometBFT mempool service server.
+func New(eventBus *types.EventBus, logger log.Logger) mempoolsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		eventBus: eventBus,
+		logger:   logger.With("service", "MempoolService"),
+	}
+}
+
+// Subscribe implements v1.MempoolServiceServer Subscribe method.
+func (s *mempoolServiceServer) Subscribe(_ *mempoolsvc.SubscribeRequest, stream mempoolsvc.MempoolService_SubscribeServer) error {
+	logger := s.logger.With("endpoint", "Subscribe")
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	// The trace ID is reused as a unique subscriber ID
+	sub, err := s.eventBus.Subscribe(context.Background(), traceID, types.EventQueryMempoolTx, subscriptionCapacity)
+	if err != nil {
+		logger.Error("Cannot subscribe to mempool events", "err", err, "traceID", traceID)
+		return status.Errorf(codes.Internal, "Cannot subscribe to mempool events (see logs for trace ID: %s)", traceID)
+	}
+	defer func() {
+		if err := s.eventBus.UnsubscribeAll(context.Background(), traceID); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Failed to unsubscribe from mempool events", "err", err, "traceID", traceID)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			data, ok := msg.Data().(types.EventDataMempoolTx)
+			if !ok {
+				logger.Error("Unexpected event type", "type", msg.Data(), "traceID", traceID)
+				return status.Errorf(codes.Internal, "Internal server error (see logs for trace ID: %s)", traceID)
+			}
+			if err := stream.Send(responseFromEvent(data)); err != nil {
+				logger.Error("Failed to stream mempool event", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-sub.Canceled():
+			switch sub.Err() {
+			case cmtpubsub.ErrUnsubscribed:
+				return status.Error(codes.Canceled, "Subscription terminated")
+			case cmtpubsub.ErrOutOfCapacity:
+				return status.Error(codes.ResourceExhausted, "Subscription canceled because the client is too slow")
+			case nil:
+				return status.Error(codes.Canceled, "Subscription canceled without errors")
+			default:
+				logger.Info("Subscription canceled with errors", "err", sub.Err(), "traceID", traceID)
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+func responseFromEvent(data types.EventDataMempoolTx) *mempoolsvc.SubscribeResponse {
+	if data.Removed {
+		return &mempoolsvc.SubscribeResponse{
+			Event: &mempoolsvc.SubscribeResponse_Removed{
+				Removed: &mempoolsvc.TxRemoved{
+					Hash:   data.Hash,
+					Reason: removalReasons[data.Reason],
+				},
+			},
+		}
+	}
+	return &mempoolsvc.SubscribeResponse{
+		Event: &mempoolsvc.SubscribeResponse_Added{
+			Added: &mempoolsvc.TxAdded{
+				Tx:       data.Tx,
+				Hash:     data.Hash,
+				Height:   data.Height,
+				Priority: data.Priority,
+				Lane:     data.Lane,
+				Sender:   data.Sender,
+				Nonce:    data.Nonce,
+			},
+		},
+	}
+}
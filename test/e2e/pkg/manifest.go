@@ -110,6 +110,11 @@ type Manifest struct {
 	// 0 denotes it is set at InitChain.
 	VoteExtensionsUpdateHeight int64 `toml:"vote_extensions_update_height"`
 
+	// PBTSEnableHeight configures the first height during which the chain
+	// will use proposer-based timestamps. It is set at genesis.
+	// 0 denotes that proposer-based timestamps are disabled.
+	PBTSEnableHeight int64 `toml:"pbts_enable_height"`
+
 	// Upper bound of sleep duration then gossipping votes and block parts
 	PeerGossipIntraloopSleepDuration time.Duration `toml:"peer_gossip_intraloop_sleep_duration"`
 
@@ -99,6 +99,7 @@ type Testnet struct {
 	BlockMaxBytes                                        int64
 	VoteExtensionsEnableHeight                           int64
 	VoteExtensionsUpdateHeight                           int64
+	PBTSEnableHeight                                     int64
 	VoteExtensionSize                                    uint
 	PeerGossipIntraloopSleepDuration                     time.Duration
 	ExperimentalMaxGossipConnectionsToPersistentPeers    uint
@@ -194,6 +195,7 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		BlockMaxBytes:                    manifest.BlockMaxBytes,
 		VoteExtensionsEnableHeight:       manifest.VoteExtensionsEnableHeight,
 		VoteExtensionsUpdateHeight:       manifest.VoteExtensionsUpdateHeight,
+		PBTSEnableHeight:                 manifest.PBTSEnableHeight,
 		VoteExtensionSize:                manifest.VoteExtensionSize,
 		PeerGossipIntraloopSleepDuration: manifest.PeerGossipIntraloopSleepDuration,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    manifest.ExperimentalMaxGossipConnectionsToPersistentPeers,
@@ -419,6 +421,17 @@ func (t Testnet) Validate() error {
 			)
 		}
 	}
+	if t.PBTSEnableHeight < 0 {
+		return fmt.Errorf("value of PBTSEnableHeight must be positive, or 0 (disable); "+
+			"enable height %d", t.PBTSEnableHeight)
+	}
+	if t.PBTSEnableHeight > 0 && t.PBTSEnableHeight < t.InitialHeight {
+		return fmt.Errorf("a value of PBTSEnableHeight greater than 0 "+
+			"must not be less than InitialHeight; "+
+			"enable height %d, initial height %d",
+			t.PBTSEnableHeight, t.InitialHeight,
+		)
+	}
 	for _, node := range t.Nodes {
 		if err := node.Validate(t); err != nil {
 			return fmt.Errorf("invalid node %q: %w", node.Name, err)
@@ -142,8 +142,9 @@ func MakeGenesis(testnet *e2e.Testnet) (types.GenesisDoc, error) {
 		genesis.ConsensusParams.Block.MaxBytes = testnet.BlockMaxBytes
 	}
 	if testnet.VoteExtensionsUpdateHeight == -1 {
-		genesis.ConsensusParams.ABCI.VoteExtensionsEnableHeight = testnet.VoteExtensionsEnableHeight
+		genesis.ConsensusParams.Feature.VoteExtensionsEnableHeight = testnet.VoteExtensionsEnableHeight
 	}
+	genesis.ConsensusParams.Feature.PBTSEnableHeight = testnet.PBTSEnableHeight
 	for validator, power := range testnet.Validators {
 		genesis.Validators = append(genesis.Validators, types.GenesisValidator{
 			Name:    validator.Name,
@@ -180,6 +181,7 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 	cfg.GRPC.VersionService.Enabled = true
 	cfg.GRPC.BlockService.Enabled = true
 	cfg.GRPC.BlockResultsService.Enabled = true
+	cfg.GRPC.MempoolService.Enabled = true
 
 	cfg.P2P.ExternalAddress = fmt.Sprintf("tcp://%v", node.AddressP2P(false))
 	cfg.P2P.AddrBookStrict = false
@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -9,8 +10,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
 	"github.com/cometbft/cometbft/rpc/grpc/client/privileged"
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -94,6 +97,51 @@ func TestGRPC_Block_GetLatestHeight(t *testing.T) {
 	})
 }
 
+func TestGRPC_Mempool_Subscribe(t *testing.T) {
+	t.Helper()
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		gclient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gclient.Close()
+
+		eventCh, err := gclient.SubscribeMempool(ctx, grpcclient.SubscribeMempoolChannelSize(100))
+		require.NoError(t, err)
+
+		client, err := node.Client()
+		require.NoError(t, err)
+		tx := types.Tx(fmt.Sprintf("grpc-mempool-%v=%d", node.Name, time.Now().UnixNano()))
+		_, err = client.BroadcastTxSync(ctx, tx)
+		require.NoError(t, err)
+
+		// The transaction is reported when it is added to the mempool, and
+		// when it is removed from it after being committed.
+		added := false
+		for {
+			select {
+			case <-ctx.Done():
+				require.Fail(t, "did not expect context to be canceled")
+			case event := <-eventCh:
+				require.NoError(t, event.Error)
+				if !bytes.Equal(event.Hash, tx.Hash()) {
+					continue
+				}
+				if !event.Removed {
+					require.Equal(t, tx, event.Tx)
+					added = true
+					continue
+				}
+				require.True(t, added)
+				require.Equal(t, "committed", event.Reason)
+				return
+			}
+		}
+	})
+}
+
 func TestGRPC_GetBlockResults(t *testing.T) {
 	t.Helper()
 	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
@@ -13,6 +13,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
@@ -138,6 +139,26 @@ func (b *Block) Hash() cmtbytes.HexBytes {
 // This is the form in which the block is gossipped to peers.
 // CONTRACT: partSize is greater than zero.
 func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
+	bz, err := b.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return NewPartSetFromData(bz, partSize), nil
+}
+
+// MakeErasurePartSet returns an erasure-coded PartSet containing parts of a
+// serialized block, any half of which are enough to reconstruct the block.
+// See NewErasurePartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakeErasurePartSet(partSize uint32) (*PartSet, error) {
+	bz, err := b.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return NewErasurePartSetFromData(bz, partSize)
+}
+
+func (b *Block) marshal() ([]byte, error) {
 	if b == nil {
 		return nil, errors.New("nil block")
 	}
@@ -148,11 +169,7 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	bz, err := proto.Marshal(pbb)
-	if err != nil {
-		return nil, err
-	}
-	return NewPartSetFromData(bz, partSize), nil
+	return proto.Marshal(pbb)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -276,10 +293,22 @@ func BlockFromProto(bp *cmtproto.Block) (*Block, error) {
 //
 // XXX: Panics on negative result.
 func MaxDataBytes(maxBytes, evidenceBytes int64, valsCount int) int64 {
+	return maxDataBytes(maxBytes, evidenceBytes, MaxCommitBytes(valsCount))
+}
+
+// MaxDataBytesForValidators returns the maximum size of block's data, the
+// size of the last commit depending on the key types of the given validators.
+//
+// XXX: Panics on negative result.
+func MaxDataBytesForValidators(maxBytes, evidenceBytes int64, vals *ValidatorSet) int64 {
+	return maxDataBytes(maxBytes, evidenceBytes, MaxCommitBytesForValidators(vals))
+}
+
+func maxDataBytes(maxBytes, evidenceBytes, commitBytes int64) int64 {
 	maxDataBytes := maxBytes -
 		MaxOverheadForBlock -
 		MaxHeaderBytes -
-		MaxCommitBytes(valsCount) -
+		commitBytes -
 		evidenceBytes
 
 	if maxDataBytes < 0 {
@@ -298,10 +327,23 @@ func MaxDataBytes(maxBytes, evidenceBytes int64, valsCount int) int64 {
 //
 // XXX: Panics on negative result.
 func MaxDataBytesNoEvidence(maxBytes int64, valsCount int) int64 {
+	return maxDataBytesNoEvidence(maxBytes, MaxCommitBytes(valsCount))
+}
+
+// MaxDataBytesNoEvidenceForValidators returns the maximum size of block's
+// data when evidence count is unknown (will be assumed to be 0), the size of
+// the last commit depending on the key types of the given validators.
+//
+// XXX: Panics on negative result.
+func MaxDataBytesNoEvidenceForValidators(maxBytes int64, vals *ValidatorSet) int64 {
+	return maxDataBytesNoEvidence(maxBytes, MaxCommitBytesForValidators(vals))
+}
+
+func maxDataBytesNoEvidence(maxBytes, commitBytes int64) int64 {
 	maxDataBytes := maxBytes -
 		MaxOverheadForBlock -
 		MaxHeaderBytes -
-		MaxCommitBytes(valsCount)
+		commitBytes
 
 	if maxDataBytes < 0 {
 		panic(fmt.Sprintf(
@@ -589,6 +631,8 @@ const (
 	// Commit sig size is made up of 64 bytes for the signature, 20 bytes for the address,
 	// 1 byte for the flag and 14 bytes for the timestamp.
 	MaxCommitSigBytes int64 = 109
+	// Commit sig size of BLS12-381 validators, whose signatures are 96 bytes.
+	MaxCommitSigBytesBLS12381 int64 = 141
 )
 
 // CommitSig is a part of the Vote included in a Commit.
@@ -605,6 +649,23 @@ func MaxCommitBytes(valCount int) int64 {
 	return MaxCommitOverheadBytes + ((MaxCommitSigBytes + protoEncodingOverhead) * int64(valCount))
 }
 
+// MaxCommitBytesForValidators returns the maximum size of a commit signed by
+// the given validators, accounting for the larger signatures of BLS12-381
+// validators.
+func MaxCommitBytesForValidators(vals *ValidatorSet) int64 {
+	// From the repeated commit sig field, the length of a BLS12-381 commit sig
+	// taking 2 bytes
+	var protoEncodingOverhead, blsProtoEncodingOverhead int64 = 2, 3
+	maxBytes := MaxCommitBytes(vals.Size())
+	for _, val := range vals.Validators {
+		if val.PubKey.Type() == bls12381.KeyType {
+			maxBytes += (MaxCommitSigBytesBLS12381 + blsProtoEncodingOverhead) -
+				(MaxCommitSigBytes + protoEncodingOverhead)
+		}
+	}
+	return maxBytes
+}
+
 // NewCommitSigAbsent returns new CommitSig with BlockIDFlagAbsent. Other
 // fields are all empty.
 func NewCommitSigAbsent() CommitSig {
@@ -646,6 +707,13 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. If aggregated is true, the
+// signature must be empty, as it is part of the aggregated signature of the
+// commit.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -673,6 +741,12 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
+		if aggregated {
+			if len(cs.Signature) != 0 {
+				return errors.New("signature is present in aggregated commit")
+			}
+			break
+		}
 		if len(cs.Signature) == 0 {
 			return errors.New("signature is missing")
 		}
@@ -701,12 +775,15 @@ func (cs *CommitSig) ToProto() *cmtproto.CommitSig {
 // FromProto sets a protobuf CommitSig to the given pointer.
 // It returns an error if the CommitSig is invalid.
 func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
+	cs.fromProto(csp)
+	return cs.ValidateBasic()
+}
+
+func (cs *CommitSig) fromProto(csp cmtproto.CommitSig) {
 	cs.BlockIDFlag = BlockIDFlag(csp.BlockIdFlag)
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-
-	return cs.ValidateBasic()
 }
 
 //-------------------------------------
@@ -842,6 +919,10 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// If not empty, the aggregation of the BLS12-381 signatures of the
+	// signatures that are not absent, which have no signature of their own.
+	// See Aggregate.
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -851,7 +932,8 @@ type Commit struct {
 
 // GetVote converts the CommitSig for the given valIdx to a Vote. Commits do
 // not contain vote extensions, so the vote extension and vote extension
-// signature will not be present in the returned vote.
+// signature will not be present in the returned vote. The signature is not
+// present either if the commit is aggregated.
 // Returns nil if the precommit at valIdx is nil.
 // Panics if valIdx >= commit.Size().
 func (commit *Commit) GetVote(valIdx int32) *Vote {
@@ -908,15 +990,70 @@ func (commit *Commit) ValidateBasic() error {
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		aggregated := commit.IsAggregated()
+		if aggregated && len(commit.AggregatedSignature) != bls12381.SignatureSize {
+			return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+				bls12381.SignatureSize,
+				len(commit.AggregatedSignature),
+			)
+		}
 		for i, commitSig := range commit.Signatures {
-			if err := commitSig.ValidateBasic(); err != nil {
+			if err := commitSig.validateBasic(aggregated); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %w", i, err)
 			}
 		}
+	} else if commit.IsAggregated() {
+		return errors.New("aggregated signature in empty commit")
 	}
 	return nil
 }
 
+// IsAggregated returns true if the signatures of the commit are aggregated.
+func (commit *Commit) IsAggregated() bool {
+	return len(commit.AggregatedSignature) > 0
+}
+
+// Aggregate returns a copy of the commit in which the signatures that are not
+// absent are aggregated into AggregatedSignature. All of them must be
+// BLS12-381 signatures. The commit is returned as is if it is already
+// aggregated, or has no signatures to aggregate.
+//
+// An aggregated commit is smaller, and is verified with a single pairing
+// product by VerifyCommit and the light client verification functions, but it
+// can no longer be converted to votes. Note that its hash differs from the
+// hash of the original commit.
+func (commit *Commit) Aggregate() (*Commit, error) {
+	if commit.IsAggregated() {
+		return commit, nil
+	}
+	var (
+		sigs       = make([][]byte, 0, len(commit.Signatures))
+		commitSigs = make([]CommitSig, len(commit.Signatures))
+	)
+	for i, commitSig := range commit.Signatures {
+		commitSigs[i] = commitSig
+		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+		sigs = append(sigs, commitSig.Signature)
+		commitSigs[i].Signature = nil
+	}
+	if len(sigs) == 0 {
+		return commit, nil
+	}
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating commit signatures: %w", err)
+	}
+	return &Commit{
+		Height:              commit.Height,
+		Round:               commit.Round,
+		BlockID:             commit.BlockID,
+		Signatures:          commitSigs,
+		AggregatedSignature: aggSig,
+	}, nil
+}
+
 // Hash returns the hash of the commit.
 func (commit *Commit) Hash() cmtbytes.HexBytes {
 	if commit == nil {
@@ -933,6 +1070,9 @@ func (commit *Commit) Hash() cmtbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		if commit.IsAggregated() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -998,6 +1138,7 @@ func (commit *Commit) ToProto() *cmtproto.Commit {
 	c.Height = commit.Height
 	c.Round = commit.Round
 	c.BlockID = commit.BlockID.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
 
 	return c
 }
@@ -1016,17 +1157,18 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 		return nil, err
 	}
 
+	// The signatures are validated by ValidateBasic, depending on whether the
+	// commit is aggregated.
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
-		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
-			return nil, err
-		}
+		sigs[i].fromProto(cp.Signatures[i])
 	}
 	commit.Signatures = sigs
 
 	commit.Height = cp.Height
 	commit.Round = cp.Round
 	commit.BlockID = *bi
+	commit.AggregatedSignature = cp.AggregatedSignature
 
 	return commit, commit.ValidateBasic()
 }
@@ -1081,7 +1223,8 @@ func (ec *ExtendedCommit) addSigsToVoteSet(voteSet *VoteSet) {
 }
 
 // ToVoteSet constructs a VoteSet from the Commit and validator set.
-// Panics if signatures from the commit can't be added to the voteset.
+// Panics if signatures from the commit can't be added to the voteset, which is
+// the case if the commit is aggregated.
 // Inverse of VoteSet.MakeCommit().
 func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
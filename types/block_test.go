@@ -15,6 +15,8 @@ import (
 
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
@@ -277,13 +279,18 @@ func TestMaxCommitBytes(t *testing.T) {
 		BlockIDFlag:      BlockIDFlagNil,
 		ValidatorAddress: crypto.AddressHash([]byte("validator_address")),
 		Timestamp:        timestamp,
-		Signature:        crypto.CRandBytes(MaxSignatureSize),
+		Signature:        crypto.CRandBytes(ed25519.SignatureSize),
 	}
 
 	pbSig := cs.ToProto()
 	// test that a single commit sig doesn't exceed max commit sig bytes
 	assert.EqualValues(t, MaxCommitSigBytes, pbSig.Size())
 
+	blsCS := cs
+	blsCS.Signature = crypto.CRandBytes(bls12381.SignatureSize)
+	pbSig = blsCS.ToProto()
+	assert.EqualValues(t, MaxCommitSigBytesBLS12381, pbSig.Size())
+
 	// check size with a single commit
 	commit := &Commit{
 		Height: math.MaxInt64,
@@ -310,6 +317,17 @@ func TestMaxCommitBytes(t *testing.T) {
 	pb = commit.ToProto()
 
 	assert.EqualValues(t, MaxCommitBytes(MaxVotesCount), int64(pb.Size()))
+
+	// check the size of a commit of both ed25519 and BLS12-381 validators
+	vals := NewValidatorSet([]*Validator{
+		NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+		NewValidator(bls12381.GenPrivKey().PubKey(), 1),
+		NewValidator(bls12381.GenPrivKey().PubKey(), 1),
+	})
+	commit.Signatures = []CommitSig{cs, blsCS, blsCS}
+	pb = commit.ToProto()
+
+	assert.EqualValues(t, MaxCommitBytesForValidators(vals), int64(pb.Size()))
 }
 
 func TestHeaderHash(t *testing.T) {
@@ -484,6 +502,47 @@ func TestBlockMaxDataBytes(t *testing.T) {
 	}
 }
 
+func TestBlockMaxDataBytesForValidators(t *testing.T) {
+	ed25519Vals := NewValidatorSet([]*Validator{
+		NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+		NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+	})
+	blsVals := NewValidatorSet([]*Validator{
+		NewValidator(ed25519.GenPrivKey().PubKey(), 1),
+		NewValidator(bls12381.GenPrivKey().PubKey(), 1),
+	})
+
+	testCases := []struct {
+		maxBytes      int64
+		vals          *ValidatorSet
+		evidenceBytes int64
+		panics        bool
+		result        int64
+	}{
+		0: {952, ed25519Vals, 0, true, 0},
+		1: {953, ed25519Vals, 0, false, 0},
+		2: {954, ed25519Vals, 0, false, 1},
+		3: {1053, ed25519Vals, 100, false, 0},
+		4: {985, blsVals, 0, true, 0},
+		5: {986, blsVals, 0, false, 0},
+		6: {1086, blsVals, 100, false, 0},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		if tc.panics {
+			assert.Panics(t, func() {
+				MaxDataBytesForValidators(tc.maxBytes, tc.evidenceBytes, tc.vals)
+			}, "#%v", i)
+		} else {
+			assert.Equal(t,
+				tc.result,
+				MaxDataBytesForValidators(tc.maxBytes, tc.evidenceBytes, tc.vals),
+				"#%v", i)
+		}
+	}
+}
+
 func TestBlockMaxDataBytesNoEvidence(t *testing.T) {
 	testCases := []struct {
 		maxBytes  int64
@@ -189,6 +189,34 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventTxTrace publishes a tx trace event, with the predefined TxHashKey
+// set to the hash of the transaction.
+func (b *EventBus) PublishEventTxTrace(data EventDataTxTrace) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey: {EventTxTrace},
+		TxHashKey:    {fmt.Sprintf("%X", data.Hash)},
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// PublishEventMempoolTx publishes a mempool tx event, with the predefined
+// TxHashKey set to the hash of the transaction.
+func (b *EventBus) PublishEventMempoolTx(data EventDataMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey: {EventMempoolTx},
+		TxHashKey:    {fmt.Sprintf("%X", data.Hash)},
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -269,6 +297,14 @@ func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventTxTrace(EventDataTxTrace) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventMempoolTx(EventDataMempoolTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(EventDataRoundState) error {
 	return nil
 }
@@ -341,6 +341,40 @@ func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventMempoolTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	query := fmt.Sprintf("tm.event='MempoolTx' AND tx.hash='%X'", tx.Hash())
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-txsSub.Out()
+		edt := msg.Data().(EventDataMempoolTx)
+		assert.True(t, edt.Removed)
+		assert.Equal(t, "committed", edt.Reason)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventMempoolTx(EventDataMempoolTx{Hash: tx.Hash(), Removed: true, Reason: "committed"})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a mempool tx event after 1 sec.")
+	}
+}
+
 func TestEventBusPublish(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
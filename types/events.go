@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtpubsub "github.com/cometbft/cometbft/internal/pubsub"
 	cmtquery "github.com/cometbft/cometbft/internal/pubsub/query"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 )
 
@@ -21,8 +22,13 @@ const (
 	EventNewBlockEvents      = "NewBlockEvents"
 	EventNewEvidence         = "NewEvidence"
 	EventTx                  = "Tx"
+	EventTxTrace             = "TxTrace"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Mempool events, triggered when a transaction is added to or removed
+	// from the mempool.
+	EventMempoolTx = "MempoolTx"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -52,6 +58,8 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataTxTrace{}, "tendermint/event/TxTrace")
+	cmtjson.RegisterType(EventDataMempoolTx{}, "tendermint/event/MempoolTx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
@@ -89,6 +97,35 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// EventDataTxTrace is fired each time a traced transaction reaches a new stage
+// of its lifecycle. See TxTracer.
+type EventDataTxTrace struct {
+	Hash  cmtbytes.HexBytes `json:"hash"`
+	Stage string            `json:"stage"`
+	Trace TxTrace           `json:"trace"`
+}
+
+// EventDataMempoolTx is fired when a transaction is added to or removed from
+// the mempool.
+type EventDataMempoolTx struct {
+	Hash cmtbytes.HexBytes `json:"hash"`
+
+	// Set when the transaction is added to the mempool: the transaction, the
+	// height at which it was checked, and the values assigned to it by the
+	// application in CheckTx.
+	Tx       Tx     `json:"tx,omitempty"`
+	Height   int64  `json:"height,omitempty"`
+	Priority int64  `json:"priority,omitempty"`
+	Lane     string `json:"lane,omitempty"`
+	Sender   string `json:"sender,omitempty"`
+	Nonce    uint64 `json:"nonce,omitempty"`
+
+	// Set when the transaction is removed from the mempool, with the reason
+	// why it was removed (e.g. "committed", "evicted").
+	Removed bool   `json:"removed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
 // NOTE: This goes into the replay WAL.
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -148,6 +185,7 @@ const (
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
+	EventQueryMempoolTx           = QueryForEvent(EventMempoolTx)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewBlockEvents      = QueryForEvent(EventNewBlockEvents)
@@ -159,6 +197,7 @@ var (
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWait)
 	EventQueryTx                  = QueryForEvent(EventTx)
+	EventQueryTxTrace             = QueryForEvent(EventTxTrace)
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
 	EventQueryVote                = QueryForEvent(EventVote)
@@ -185,3 +224,13 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(tx EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the events of the mempool.
+type MempoolEventPublisher interface {
+	PublishEventMempoolTx(data EventDataMempoolTx) error
+}
+
+// TxTraceEventPublisher publishes the events of TxTracer.
+type TxTraceEventPublisher interface {
+	PublishEventTxTrace(trace EventDataTxTrace) error
+}
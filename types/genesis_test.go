@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -120,6 +121,40 @@ func TestGenesisGood(t *testing.T) {
 	}
 }
 
+func TestGenesisLegacyVoteExtensionsEnableHeight(t *testing.T) {
+	pubkey := ed25519.GenPrivKey().PubKey()
+	genDoc := &GenesisDoc{
+		ChainID:         "abc",
+		ConsensusParams: DefaultConsensusParams(),
+		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+	}
+	genDocBytes, err := cmtjson.Marshal(genDoc)
+	require.NoError(t, err)
+
+	// Replace consensus_params.feature with the deprecated
+	// consensus_params.abci.
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(genDocBytes, &raw))
+	params := raw["consensus_params"].(map[string]any)
+	delete(params, "feature")
+	params["abci"] = map[string]any{"vote_extensions_enable_height": "5"}
+	genDocBytes, err = json.Marshal(raw)
+	require.NoError(t, err)
+
+	genDoc, err = GenesisDocFromJSON(genDocBytes)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, genDoc.ConsensusParams.Features().VoteExtensionsEnableHeight)
+
+	// consensus_params.feature takes precedence.
+	params["feature"] = map[string]any{"vote_extensions_enable_height": "3", "pbts_enable_height": "0"}
+	genDocBytes, err = json.Marshal(raw)
+	require.NoError(t, err)
+
+	genDoc, err = GenesisDocFromJSON(genDocBytes)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, genDoc.ConsensusParams.Features().VoteExtensionsEnableHeight)
+}
+
 func TestGenesisSaveAs(t *testing.T) {
 	tmpfile, err := os.CreateTemp("", "genesis")
 	require.NoError(t, err)
@@ -3,9 +3,13 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
+
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -21,13 +25,20 @@ const (
 	// MaxBlockPartsCount is the maximum number of block parts.
 	MaxBlockPartsCount = (MaxBlockSizeBytes / BlockPartSizeBytes) + 1
 
+	// MaxErasureCodedBlockSizeBytes is the maximum permitted size of the
+	// blocks when erasure coding is enabled (8MB, minus the size of the length
+	// stored with the data).
+	MaxErasureCodedBlockSizeBytes = int64(maxErasureCodedParts/2*BlockPartSizeBytes) - erasureCodedLenSize
+
 	ABCIPubKeyTypeEd25519   = ed25519.KeyType
 	ABCIPubKeyTypeSecp256k1 = secp256k1.KeyType
+	ABCIPubKeyTypeBls12381  = bls12381.KeyType
 )
 
 var ABCIPubKeyTypesToNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
+	ABCIPubKeyTypeBls12381:  bls12381.PubKeyName,
 }
 
 // ConsensusParams contains consensus critical parameters that determine the
@@ -37,7 +48,12 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `json:"evidence"`
 	Validator ValidatorParams `json:"validator"`
 	Version   VersionParams   `json:"version"`
+	// Deprecated: use Feature.VoteExtensionsEnableHeight, which takes
+	// precedence if it is set. Will be removed in the next release.
 	ABCI      ABCIParams      `json:"abci"`
+	Synchrony SynchronyParams `json:"synchrony"`
+	Timeout   TimeoutParams   `json:"timeout"`
+	Feature   FeatureParams   `json:"feature"`
 }
 
 // BlockParams define limits on the block size and gas plus minimum time
@@ -65,7 +81,7 @@ type VersionParams struct {
 }
 
 // ABCIParams configure ABCI functionality specific to the Application Blockchain
-// Interface.
+// Interface. Superseded by FeatureParams.
 type ABCIParams struct {
 	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
 }
@@ -73,13 +89,101 @@ type ABCIParams struct {
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
 // and false otherwise.
 func (a ABCIParams) VoteExtensionsEnabled(h int64) bool {
+	return featureEnabled("vote extensions", a.VoteExtensionsEnableHeight, h)
+}
+
+// VoteExtensionsParams determine from which height vote extensions are
+// enabled. Implemented by FeatureParams, and by ABCIParams for backwards
+// compatibility.
+type VoteExtensionsParams interface {
+	VoteExtensionsEnabled(h int64) bool
+}
+
+// SynchronyParams determine the validity of block timestamps when
+// proposer-based timestamps (PBTS) are enabled.
+//
+// See spec/consensus/proposer-based-timestamp for details.
+type SynchronyParams struct {
+	Precision    time.Duration `json:"precision"`
+	MessageDelay time.Duration `json:"message_delay"`
+}
+
+// InRound returns the synchrony parameters to use in the given round. The
+// message delay is increased by 10% for each round, so that a network whose
+// MessageDelay is too small for its actual latency eventually accepts a
+// proposal as timely.
+func (s SynchronyParams) InRound(round int32) SynchronyParams {
+	delay := float64(s.MessageDelay)
+	for r := int32(0); r < round; r++ {
+		delay *= 1.1
+		if delay >= math.MaxInt64 {
+			s.MessageDelay = time.Duration(math.MaxInt64)
+			return s
+		}
+	}
+	s.MessageDelay = time.Duration(delay)
+	return s
+}
+
+// TimeoutParams configure the timeouts of the steps of the consensus
+// algorithm. A timeout that is zero is not set: nodes use the value from their
+// local configuration instead.
+type TimeoutParams struct {
+	Propose             time.Duration `json:"propose"`
+	ProposeDelta        time.Duration `json:"propose_delta"`
+	Vote                time.Duration `json:"vote"`
+	VoteDelta           time.Duration `json:"vote_delta"`
+	Commit              time.Duration `json:"commit"`
+	BypassCommitTimeout bool          `json:"bypass_commit_timeout"`
+}
+
+// FeatureParams configure the heights from which consensus features are
+// enabled. A feature whose enable height is 0 is disabled.
+type FeatureParams struct {
+	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
+	PBTSEnableHeight           int64 `json:"pbts_enable_height"`
+	ErasureCodingEnableHeight  int64 `json:"erasure_coding_enable_height"`
+}
+
+// VoteExtensionsEnabled returns true if vote extensions are enabled at height h
+// and false otherwise.
+func (f FeatureParams) VoteExtensionsEnabled(h int64) bool {
+	return featureEnabled("vote extensions", f.VoteExtensionsEnableHeight, h)
+}
+
+// PBTSEnabled returns true if proposer-based timestamps are enabled at height
+// h and false otherwise.
+func (f FeatureParams) PBTSEnabled(h int64) bool {
+	return featureEnabled("PBTS", f.PBTSEnableHeight, h)
+}
+
+// ErasureCodingEnabled returns true if the part sets of the blocks are
+// erasure coded at height h and false otherwise.
+func (f FeatureParams) ErasureCodingEnabled(h int64) bool {
+	return featureEnabled("erasure coding", f.ErasureCodingEnableHeight, h)
+}
+
+// Features returns the FeatureParams in effect: Feature, with the vote
+// extensions enable height of the deprecated ABCI params if Feature does not
+// set it.
+func (params ConsensusParams) Features() FeatureParams {
+	f := params.Feature
+	if f.VoteExtensionsEnableHeight == 0 {
+		f.VoteExtensionsEnableHeight = params.ABCI.VoteExtensionsEnableHeight
+	}
+	return f
+}
+
+// featureEnabled returns true if the feature enabled from enableHeight is
+// enabled at height h.
+func featureEnabled(feature string, enableHeight, h int64) bool {
 	if h < 1 {
-		panic(fmt.Errorf("cannot check if vote extensions enabled for height %d (< 1)", h))
+		panic(fmt.Errorf("cannot check if %s enabled for height %d (< 1)", feature, h))
 	}
-	if a.VoteExtensionsEnableHeight == 0 {
+	if enableHeight == 0 {
 		return false
 	}
-	return a.VoteExtensionsEnableHeight <= h
+	return enableHeight <= h
 }
 
 // DefaultConsensusParams returns a default ConsensusParams.
@@ -90,6 +194,9 @@ func DefaultConsensusParams() *ConsensusParams {
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
 		ABCI:      DefaultABCIParams(),
+		Synchrony: DefaultSynchronyParams(),
+		Timeout:   DefaultTimeoutParams(),
+		Feature:   DefaultFeatureParams(),
 	}
 }
 
@@ -131,6 +238,36 @@ func DefaultABCIParams() ABCIParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams.
+func DefaultSynchronyParams() SynchronyParams {
+	return SynchronyParams{
+		// 505ms was selected as the default to enable chains that have validators
+		// in mixed leap-second handling environments.
+		// For more information, see: https://github.com/tendermint/tendermint/issues/7724
+		Precision:    505 * time.Millisecond,
+		MessageDelay: 15 * time.Second,
+	}
+}
+
+// DefaultFeatureParams returns a default FeatureParams, where all features are
+// disabled.
+func DefaultFeatureParams() FeatureParams {
+	return FeatureParams{
+		// When set to 0, vote extensions are not required.
+		VoteExtensionsEnableHeight: 0,
+		// When set to 0, block times are BFT times.
+		PBTSEnableHeight: 0,
+		// When set to 0, block part sets are not erasure coded.
+		ErasureCodingEnableHeight: 0,
+	}
+}
+
+// DefaultTimeoutParams returns a default TimeoutParams, where no timeout is
+// set, so that nodes use their local configuration.
+func DefaultTimeoutParams() TimeoutParams {
+	return TimeoutParams{}
+}
+
 func IsValidPubkeyType(params ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -185,10 +322,43 @@ func (params ConsensusParams) ValidateBasic() error {
 			params.Evidence.MaxBytes)
 	}
 
+	if params.Feature.VoteExtensionsEnableHeight < 0 {
+		return fmt.Errorf("feature.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.Feature.VoteExtensionsEnableHeight)
+	}
 	if params.ABCI.VoteExtensionsEnableHeight < 0 {
 		return fmt.Errorf("ABCI.VoteExtensionsEnableHeight cannot be negative. Got: %d", params.ABCI.VoteExtensionsEnableHeight)
 	}
 
+	if params.Feature.PBTSEnableHeight < 0 {
+		return fmt.Errorf("feature.PBTSEnableHeight cannot be negative. Got: %d", params.Feature.PBTSEnableHeight)
+	}
+
+	if params.Feature.ErasureCodingEnableHeight < 0 {
+		return fmt.Errorf("feature.ErasureCodingEnableHeight cannot be negative. Got: %d", params.Feature.ErasureCodingEnableHeight)
+	}
+	// Erasure-coded part sets are limited in their number of parts.
+	if params.Feature.ErasureCodingEnableHeight > 0 &&
+		(params.Block.MaxBytes == -1 || params.Block.MaxBytes > MaxErasureCodedBlockSizeBytes) {
+		return fmt.Errorf("block.MaxBytes must be set and no greater than %d when erasure coding is enabled. Got %d",
+			MaxErasureCodedBlockSizeBytes, params.Block.MaxBytes)
+	}
+	if params.Synchrony.Precision < 0 || params.Synchrony.MessageDelay < 0 {
+		return fmt.Errorf("synchrony.Precision and synchrony.MessageDelay cannot be negative. Got: %v, %v",
+			params.Synchrony.Precision, params.Synchrony.MessageDelay)
+	}
+	// Synchrony parameters are only required once PBTS is enabled, so that
+	// existing genesis files remain valid.
+	if params.Feature.PBTSEnableHeight > 0 &&
+		(params.Synchrony.Precision == 0 || params.Synchrony.MessageDelay == 0) {
+		return fmt.Errorf("synchrony.Precision and synchrony.MessageDelay must be greater than 0 "+
+			"when PBTS is enabled. Got: %v, %v", params.Synchrony.Precision, params.Synchrony.MessageDelay)
+	}
+
+	if params.Timeout.Propose < 0 || params.Timeout.ProposeDelta < 0 ||
+		params.Timeout.Vote < 0 || params.Timeout.VoteDelta < 0 || params.Timeout.Commit < 0 {
+		return fmt.Errorf("timeout parameters cannot be negative. Got: %+v", params.Timeout)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -205,64 +375,101 @@ func (params ConsensusParams) ValidateBasic() error {
 	return nil
 }
 
-// ValidateUpdate validates the updated VoteExtensionsEnableHeight.
-// | r | params...EnableHeight | updated...EnableHeight | result (nil == pass)
+// ValidateUpdate validates the updates of the enable heights of the features
+// in FeatureParams at height h, following the table of
+// validateEnableHeightUpdate: a feature cannot be disabled once enabled, nor
+// enabled from a past height.
+func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
+	if updated == nil {
+		return nil
+	}
+	current := params.Features()
+	//nolint:staticcheck // Abci is validated for backwards compatibility.
+	if updated.Abci != nil {
+		if err := validateEnableHeightUpdate("vote extensions",
+			current.VoteExtensionsEnableHeight, updated.Abci.VoteExtensionsEnableHeight, h); err != nil {
+			return err
+		}
+	}
+	if updated.Feature == nil {
+		return nil
+	}
+	if updated.Feature.VoteExtensionsEnableHeight != nil {
+		if err := validateEnableHeightUpdate("vote extensions",
+			current.VoteExtensionsEnableHeight, updated.Feature.VoteExtensionsEnableHeight.Value, h); err != nil {
+			return err
+		}
+	}
+	if updated.Feature.PbtsEnableHeight != nil {
+		if err := validateEnableHeightUpdate("PBTS",
+			params.Feature.PBTSEnableHeight, updated.Feature.PbtsEnableHeight.Value, h); err != nil {
+			return err
+		}
+	}
+	if updated.Feature.ErasureCodingEnableHeight != nil {
+		if err := validateEnableHeightUpdate("erasure coding",
+			params.Feature.ErasureCodingEnableHeight, updated.Feature.ErasureCodingEnableHeight.Value, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEnableHeightUpdate validates the update of the height from which
+// feature is enabled, at height h.
+// | r | current EnableHeight | updated EnableHeight | result (nil == pass)
 // |  1 | *                    | (nil)                  | nil
-// |  2 | *                    | < 0                    | VoteExtensionsEnableHeight must be positive
+// |  2 | *                    | < 0                    | EnableHeight must be positive
 // |  3 | <=0                  | 0                      | nil
 // |  4 | X                    | X (>=0)                | nil
-// |  5 | > 0; <=height        | 0                      | vote extensions cannot be disabled once enabled
+// |  5 | > 0; <=height        | 0                      | feature cannot be disabled once enabled
 // |  6 | > 0; > height        | 0                      | nil (disable a previous proposal)
-// |  7 | *                    | <=height               | vote extensions cannot be updated to a past height
+// |  7 | *                    | <=height               | feature cannot be updated to a past height
 // |  8 | <=0                  | > height (*)           | nil
-// |  9 | (> 0) <=height       | > height (*)           | vote extensions cannot be modified once enabled
+// |  9 | (> 0) <=height       | > height (*)           | feature cannot be modified once enabled
 // | 10 | (> 0) > height       | > height (*)           | nil
-// The table above reflects all cases covered.
-func (params ConsensusParams) ValidateUpdate(updated *cmtproto.ConsensusParams, h int64) error {
-	// 1
-	if updated == nil || updated.Abci == nil {
-		return nil
-	}
+// The table above reflects all cases covered. Case 1 is handled by the caller.
+func validateEnableHeightUpdate(feature string, current, updated, h int64) error {
 	// 2
-	if updated.Abci.VoteExtensionsEnableHeight < 0 {
-		return errors.New("VoteExtensionsEnableHeight must be positive")
+	if updated < 0 {
+		return fmt.Errorf("%s enable height must be positive", feature)
 	}
 	// 3
-	if params.ABCI.VoteExtensionsEnableHeight <= 0 && updated.Abci.VoteExtensionsEnableHeight == 0 {
+	if current <= 0 && updated == 0 {
 		return nil
 	}
-	// 4 (implicit: updated.Abci.VoteExtensionsEnableHeight >= 0)
-	if params.ABCI.VoteExtensionsEnableHeight == updated.Abci.VoteExtensionsEnableHeight {
+	// 4 (implicit: updated >= 0)
+	if current == updated {
 		return nil
 	}
 	// 5 & 6
-	if params.ABCI.VoteExtensionsEnableHeight > 0 && updated.Abci.VoteExtensionsEnableHeight == 0 {
+	if current > 0 && updated == 0 {
 		// 5
-		if params.ABCI.VoteExtensionsEnableHeight <= h {
-			return fmt.Errorf("vote extensions cannot be disabled once enabled"+
+		if current <= h {
+			return fmt.Errorf("%s cannot be disabled once enabled, "+
 				"old enable height: %d, current height %d",
-				params.ABCI.VoteExtensionsEnableHeight, h)
+				feature, current, h)
 		}
 		// 6
 		return nil
 	}
-	// 7 (implicit: updated.Abci.VoteExtensionsEnableHeight > 0)
-	if updated.Abci.VoteExtensionsEnableHeight <= h {
-		return fmt.Errorf("vote extensions cannot be updated to a past or current height, "+
+	// 7 (implicit: updated > 0)
+	if updated <= h {
+		return fmt.Errorf("%s cannot be updated to a past or current height, "+
 			"enable height: %d, current height %d",
-			updated.Abci.VoteExtensionsEnableHeight, h)
+			feature, updated, h)
 	}
-	// 8 (implicit: updated.Abci.VoteExtensionsEnableHeight > h)
-	if params.ABCI.VoteExtensionsEnableHeight <= 0 {
+	// 8 (implicit: updated > h)
+	if current <= 0 {
 		return nil
 	}
-	// 9 (implicit: params.ABCI.VoteExtensionsEnableHeight > 0 && updated.Abci.VoteExtensionsEnableHeight > h)
-	if params.ABCI.VoteExtensionsEnableHeight <= h {
-		return fmt.Errorf("vote extensions cannot be modified once enabled"+
+	// 9 (implicit: current > 0 && updated > h)
+	if current <= h {
+		return fmt.Errorf("%s cannot be modified once enabled, "+
 			"enable height: %d, current height %d",
-			params.ABCI.VoteExtensionsEnableHeight, h)
+			feature, current, h)
 	}
-	// 10 (implicit: params.ABCI.VoteExtensionsEnableHeight > h && updated.Abci.VoteExtensionsEnableHeight > h)
+	// 10 (implicit: current > h && updated > h)
 	return nil
 }
 
@@ -317,13 +524,46 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 	if params2.Version != nil {
 		res.Version.App = params2.Version.App
 	}
+	//nolint:staticcheck // Abci is still accepted for backwards compatibility.
 	if params2.Abci != nil {
-		res.ABCI.VoteExtensionsEnableHeight = params2.Abci.GetVoteExtensionsEnableHeight()
+		res.setVoteExtensionsEnableHeight(params2.Abci.GetVoteExtensionsEnableHeight())
+	}
+	if params2.Synchrony != nil {
+		res.Synchrony.Precision = params2.Synchrony.Precision
+		res.Synchrony.MessageDelay = params2.Synchrony.MessageDelay
+	}
+	if params2.Timeout != nil {
+		res.Timeout.Propose = params2.Timeout.Propose
+		res.Timeout.ProposeDelta = params2.Timeout.ProposeDelta
+		res.Timeout.Vote = params2.Timeout.Vote
+		res.Timeout.VoteDelta = params2.Timeout.VoteDelta
+		res.Timeout.Commit = params2.Timeout.Commit
+		res.Timeout.BypassCommitTimeout = params2.Timeout.BypassCommitTimeout
+	}
+	if params2.Feature != nil {
+		// Only the enable heights that are set are updated.
+		if params2.Feature.VoteExtensionsEnableHeight != nil {
+			res.setVoteExtensionsEnableHeight(params2.Feature.VoteExtensionsEnableHeight.Value)
+		}
+		if params2.Feature.PbtsEnableHeight != nil {
+			res.Feature.PBTSEnableHeight = params2.Feature.PbtsEnableHeight.Value
+		}
+		if params2.Feature.ErasureCodingEnableHeight != nil {
+			res.Feature.ErasureCodingEnableHeight = params2.Feature.ErasureCodingEnableHeight.Value
+		}
 	}
 	return res
 }
 
+// setVoteExtensionsEnableHeight sets the vote extensions enable height in both
+// Feature and the deprecated ABCI params, so that they do not disagree.
+func (params *ConsensusParams) setVoteExtensionsEnableHeight(h int64) {
+	params.Feature.VoteExtensionsEnableHeight = h
+	params.ABCI.VoteExtensionsEnableHeight = h
+}
+
 func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
+	feature := params.Features()
 	return cmtproto.ConsensusParams{
 		Block: &cmtproto.BlockParams{
 			MaxBytes: params.Block.MaxBytes,
@@ -340,8 +580,26 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		Version: &cmtproto.VersionParams{
 			App: params.Version.App,
 		},
+		// Still set for the clients that do not know FeatureParams yet.
 		Abci: &cmtproto.ABCIParams{
-			VoteExtensionsEnableHeight: params.ABCI.VoteExtensionsEnableHeight,
+			VoteExtensionsEnableHeight: feature.VoteExtensionsEnableHeight,
+		},
+		Synchrony: &cmtproto.SynchronyParams{
+			Precision:    params.Synchrony.Precision,
+			MessageDelay: params.Synchrony.MessageDelay,
+		},
+		Timeout: &cmtproto.TimeoutParams{
+			Propose:             params.Timeout.Propose,
+			ProposeDelta:        params.Timeout.ProposeDelta,
+			Vote:                params.Timeout.Vote,
+			VoteDelta:           params.Timeout.VoteDelta,
+			Commit:              params.Timeout.Commit,
+			BypassCommitTimeout: params.Timeout.BypassCommitTimeout,
+		},
+		Feature: &cmtproto.FeatureParams{
+			VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: feature.VoteExtensionsEnableHeight},
+			PbtsEnableHeight:           &gogotypes.Int64Value{Value: feature.PBTSEnableHeight},
+			ErasureCodingEnableHeight:  &gogotypes.Int64Value{Value: feature.ErasureCodingEnableHeight},
 		},
 	}
 }
@@ -364,8 +622,34 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			App: pbParams.Version.App,
 		},
 	}
+	// Params stored before FeatureParams existed have the vote extensions
+	// enable height in Abci.
+	//nolint:staticcheck // Abci is read for backwards compatibility.
 	if pbParams.Abci != nil {
-		c.ABCI.VoteExtensionsEnableHeight = pbParams.Abci.GetVoteExtensionsEnableHeight()
+		c.setVoteExtensionsEnableHeight(pbParams.Abci.GetVoteExtensionsEnableHeight())
+	}
+	if pbParams.Synchrony != nil {
+		c.Synchrony.Precision = pbParams.Synchrony.Precision
+		c.Synchrony.MessageDelay = pbParams.Synchrony.MessageDelay
+	}
+	if pbParams.Timeout != nil {
+		c.Timeout.Propose = pbParams.Timeout.Propose
+		c.Timeout.ProposeDelta = pbParams.Timeout.ProposeDelta
+		c.Timeout.Vote = pbParams.Timeout.Vote
+		c.Timeout.VoteDelta = pbParams.Timeout.VoteDelta
+		c.Timeout.Commit = pbParams.Timeout.Commit
+		c.Timeout.BypassCommitTimeout = pbParams.Timeout.BypassCommitTimeout
+	}
+	if pbParams.Feature != nil {
+		if pbParams.Feature.VoteExtensionsEnableHeight != nil {
+			c.setVoteExtensionsEnableHeight(pbParams.Feature.VoteExtensionsEnableHeight.Value)
+		}
+		if pbParams.Feature.PbtsEnableHeight != nil {
+			c.Feature.PBTSEnableHeight = pbParams.Feature.PbtsEnableHeight.Value
+		}
+		if pbParams.Feature.ErasureCodingEnableHeight != nil {
+			c.Feature.ErasureCodingEnableHeight = pbParams.Feature.ErasureCodingEnableHeight.Value
+		}
 	}
 	return c
 }
@@ -2,10 +2,12 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"sort"
 	"testing"
 	"time"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -74,6 +76,9 @@ func makeParams(
 		ABCI: ABCIParams{
 			VoteExtensionsEnableHeight: abciExtensionHeight,
 		},
+		Feature: FeatureParams{
+			VoteExtensionsEnableHeight: abciExtensionHeight,
+		},
 	}
 }
 
@@ -212,13 +217,57 @@ func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 		t.Run(tc.name, func(*testing.T) {
 			initialParams := makeParams(1, 0, 2, 0, valEd25519, tc.from)
 			update := &cmtproto.ConsensusParams{}
-			if tc.to == nilTest {
-				update.Abci = nil
-			} else {
-				update.Abci = &cmtproto.ABCIParams{
+			if tc.to != nilTest {
+				update.Feature = &cmtproto.FeatureParams{
+					VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: tc.to},
+				}
+			}
+			// The deprecated ABCIParams are validated the same way.
+			legacyUpdate := &cmtproto.ConsensusParams{}
+			if tc.to != nilTest {
+				legacyUpdate.Abci = &cmtproto.ABCIParams{ //nolint:staticcheck
 					VoteExtensionsEnableHeight: tc.to,
 				}
 			}
+			if tc.expectedErr {
+				require.Error(t, initialParams.ValidateUpdate(update, tc.current))
+				require.Error(t, initialParams.ValidateUpdate(legacyUpdate, tc.current))
+			} else {
+				require.NoError(t, initialParams.ValidateUpdate(update, tc.current))
+				require.NoError(t, initialParams.ValidateUpdate(legacyUpdate, tc.current))
+			}
+		})
+	}
+}
+
+func TestConsensusParamsUpdate_PBTSEnableHeight(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     int64
+		from        int64
+		to          int64
+		expectedErr bool
+	}{
+		{"current: 3, 0 -> 0", 3, 0, 0, false},
+		{"current: 3, 0 -> 5", 3, 0, 5, false},
+		{"current: 5, 0 -> 5", 5, 0, 5, true},
+		{"current: 4, 5 -> 0", 4, 5, 0, false},
+		{"current: 5, 5 -> 0", 5, 5, 0, true},
+		{"current: 9, 10 -> 15", 9, 10, 15, false},
+		{"current: 10, 10 -> 15", 10, 10, 15, true},
+		{"current: 3, 0 -> -5", 3, 0, -5, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(*testing.T) {
+			initialParams := makeParams(1, 0, 2, 0, valEd25519, 0)
+			initialParams.Synchrony = DefaultSynchronyParams()
+			initialParams.Feature.PBTSEnableHeight = tc.from
+			update := &cmtproto.ConsensusParams{
+				Feature: &cmtproto.FeatureParams{
+					PbtsEnableHeight: &gogotypes.Int64Value{Value: tc.to},
+				},
+			}
 			if tc.expectedErr {
 				require.Error(t, initialParams.ValidateUpdate(update, tc.current))
 			} else {
@@ -228,6 +277,156 @@ func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
 	}
 }
 
+func TestSynchronyParams(t *testing.T) {
+	params := makeParams(1, 0, 2, 0, valEd25519, 0)
+	require.NoError(t, params.ValidateBasic())
+	assert.False(t, params.Feature.PBTSEnabled(1))
+
+	// Synchrony parameters are required once PBTS is enabled.
+	params.Feature.PBTSEnableHeight = 10
+	require.Error(t, params.ValidateBasic())
+	params.Synchrony.Precision = time.Second
+	params.Synchrony.MessageDelay = time.Second
+	require.NoError(t, params.ValidateBasic())
+	assert.False(t, params.Feature.PBTSEnabled(9))
+	assert.True(t, params.Feature.PBTSEnabled(10))
+
+	params.Synchrony.Precision = -time.Second
+	require.Error(t, params.ValidateBasic())
+
+	// The message delay is increased by 10% per round, without overflowing.
+	sp := SynchronyParams{MessageDelay: time.Second}
+	assert.Equal(t, time.Second, sp.InRound(0).MessageDelay)
+	assert.Equal(t, 1100*time.Millisecond, sp.InRound(1).MessageDelay.Round(time.Millisecond))
+	assert.Equal(t, time.Duration(math.MaxInt64), sp.InRound(math.MaxInt32).MessageDelay)
+
+	// Synchrony parameters are updated.
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Synchrony: &cmtproto.SynchronyParams{
+			Precision:    time.Millisecond,
+			MessageDelay: time.Minute,
+		},
+	})
+	assert.Equal(t, SynchronyParams{
+		Precision:    time.Millisecond,
+		MessageDelay: time.Minute,
+	}, updated.Synchrony)
+	assert.EqualValues(t, 10, updated.Feature.PBTSEnableHeight)
+}
+
+func TestFeatureParams(t *testing.T) {
+	params := makeParams(1, 0, 2, 0, valEd25519, 0)
+	require.Equal(t, DefaultFeatureParams(), params.Feature)
+	assert.False(t, params.Feature.VoteExtensionsEnabled(1))
+	assert.False(t, params.Feature.PBTSEnabled(1))
+	assert.Panics(t, func() { params.Feature.VoteExtensionsEnabled(0) })
+
+	params.Feature.VoteExtensionsEnableHeight = -1
+	require.Error(t, params.ValidateBasic())
+
+	// Only the enable heights that are set are updated.
+	params.Feature.VoteExtensionsEnableHeight = 5
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{
+			PbtsEnableHeight: &gogotypes.Int64Value{Value: 10},
+		},
+	})
+	assert.Equal(t, FeatureParams{VoteExtensionsEnableHeight: 5, PBTSEnableHeight: 10}, updated.Feature)
+	assert.False(t, updated.Feature.VoteExtensionsEnabled(4))
+	assert.True(t, updated.Feature.VoteExtensionsEnabled(5))
+
+	// The deprecated ABCIParams update the vote extensions enable height.
+	updated = updated.Update(&cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 7}, //nolint:staticcheck
+	})
+	assert.Equal(t, FeatureParams{VoteExtensionsEnableHeight: 7, PBTSEnableHeight: 10}, updated.Feature)
+	assert.Equal(t, ABCIParams{VoteExtensionsEnableHeight: 7}, updated.ABCI)
+
+	// The deprecated ABCIParams are still set for the clients that read them.
+	pbParams := params.ToProto()
+	assert.EqualValues(t, 5, pbParams.Abci.GetVoteExtensionsEnableHeight()) //nolint:staticcheck
+
+	// Params stored with the deprecated ABCIParams are still read.
+	pbParams.Feature = nil
+	expected := params
+	expected.ABCI.VoteExtensionsEnableHeight = 5
+	assert.Equal(t, expected, ConsensusParamsFromProto(pbParams))
+
+	// The deprecated ABCIParams are used if FeatureParams do not set the vote
+	// extensions enable height.
+	params = makeParams(1, 0, 2, 0, valEd25519, 0)
+	params.ABCI.VoteExtensionsEnableHeight = 3
+	assert.True(t, params.Features().VoteExtensionsEnabled(3))
+	assert.EqualValues(t, 3, params.ToProto().Feature.VoteExtensionsEnableHeight.Value)
+	params.Feature.VoteExtensionsEnableHeight = 4
+	assert.False(t, params.Features().VoteExtensionsEnabled(3))
+}
+
+func TestErasureCodingParams(t *testing.T) {
+	params := makeParams(4194304, 0, 2, 0, valEd25519, 0)
+	assert.False(t, params.Feature.ErasureCodingEnabled(1))
+
+	params.Feature.ErasureCodingEnableHeight = -1
+	require.Error(t, params.ValidateBasic())
+
+	params.Feature.ErasureCodingEnableHeight = 2
+	require.NoError(t, params.ValidateBasic())
+	assert.False(t, params.Feature.ErasureCodingEnabled(1))
+	assert.True(t, params.Feature.ErasureCodingEnabled(2))
+
+	// The size of erasure-coded blocks is bounded.
+	params.Block.MaxBytes = -1
+	require.Error(t, params.ValidateBasic())
+	params.Block.MaxBytes = MaxErasureCodedBlockSizeBytes + 1
+	require.Error(t, params.ValidateBasic())
+	params.Block.MaxBytes = MaxErasureCodedBlockSizeBytes
+	require.NoError(t, params.ValidateBasic())
+
+	// Erasure coding cannot be disabled once enabled.
+	require.Error(t, params.ValidateUpdate(&cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{ErasureCodingEnableHeight: &gogotypes.Int64Value{Value: 0}},
+	}, 3))
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{ErasureCodingEnableHeight: &gogotypes.Int64Value{Value: 5}},
+	})
+	assert.EqualValues(t, 5, updated.Feature.ErasureCodingEnableHeight)
+	assert.Equal(t, updated, ConsensusParamsFromProto(updated.ToProto()))
+}
+
+func TestTimeoutParams(t *testing.T) {
+	params := makeParams(1, 0, 2, 0, valEd25519, 0)
+	require.Equal(t, DefaultTimeoutParams(), params.Timeout)
+	require.NoError(t, params.ValidateBasic())
+
+	params.Timeout.VoteDelta = -time.Second
+	require.Error(t, params.ValidateBasic())
+
+	updated := params.Update(&cmtproto.ConsensusParams{
+		Timeout: &cmtproto.TimeoutParams{
+			Propose:             2 * time.Second,
+			ProposeDelta:        500 * time.Millisecond,
+			Vote:                time.Second,
+			VoteDelta:           100 * time.Millisecond,
+			Commit:              3 * time.Second,
+			BypassCommitTimeout: true,
+		},
+	})
+	expected := TimeoutParams{
+		Propose:             2 * time.Second,
+		ProposeDelta:        500 * time.Millisecond,
+		Vote:                time.Second,
+		VoteDelta:           100 * time.Millisecond,
+		Commit:              3 * time.Second,
+		BypassCommitTimeout: true,
+	}
+	assert.Equal(t, expected, updated.Timeout)
+	require.NoError(t, updated.ValidateBasic())
+
+	pbParams := updated.ToProto()
+	assert.Equal(t, expected, ConsensusParamsFromProto(pbParams).Timeout)
+}
+
 func TestProto(t *testing.T) {
 	params := []ConsensusParams{
 		makeParams(4, 2, 3, 1, valEd25519, 1),
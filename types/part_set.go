@@ -2,10 +2,13 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 
+	"github.com/klauspost/reedsolomon"
+
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/internal/bits"
@@ -20,6 +23,17 @@ var (
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
 	ErrPartTooBig             = errors.New("error part size too big")
 	ErrPartInvalidSize        = errors.New("error inner part with invalid size")
+	ErrPartSetInvalidCoding   = errors.New("error part set with invalid erasure coding")
+)
+
+const (
+	// maxErasureCodedParts is the maximum number of parts of an
+	// erasure-coded part set, half of which are data parts.
+	maxErasureCodedParts = 256
+
+	// erasureCodedLenSize is the size of the length of the data, stored at the
+	// end of the data parts of an erasure-coded part set.
+	erasureCodedLenSize = 4
 )
 
 type Part struct {
@@ -162,6 +176,9 @@ func ProtoPartSetHeaderIsZero(ppsh *cmtproto.PartSetHeader) bool {
 type PartSet struct {
 	total uint32
 	hash  []byte
+	// Number of data parts of an erasure-coded part set, from which the other
+	// parts are reconstructed. Zero if the part set is not erasure coded.
+	dataTotal uint32
 
 	mtx           cmtsync.Mutex
 	parts         []*Part
@@ -205,6 +222,82 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	}
 }
 
+// NewErasurePartSetFromData returns an immutable, full PartSet from the data
+// bytes, erasure coded so that any half of its parts are enough to
+// reconstruct the data.
+//
+// The data bytes, followed by padding and by their length (4 bytes, big
+// endian), are split into "partSize" data parts, to which as many
+// Reed-Solomon parity parts are added. Data that fits in a single part is
+// not erasure coded, as there would be nothing to gain.
+// CONTRACT: partSize is greater than zero.
+func NewErasurePartSetFromData(data []byte, partSize uint32) (*PartSet, error) {
+	if len(data) <= int(partSize) {
+		return NewPartSetFromData(data, partSize), nil
+	}
+	if int64(len(data)) > MaxErasureCodedBlockSizeBytes {
+		return nil, fmt.Errorf("data too big to be erasure coded (%d > %d)", len(data), MaxErasureCodedBlockSizeBytes)
+	}
+
+	dataTotal := (uint32(len(data)) + erasureCodedLenSize + partSize - 1) / partSize
+	total := 2 * dataTotal
+	if total > maxErasureCodedParts {
+		return nil, fmt.Errorf("too many parts to be erasure coded (%d > %d)", total, maxErasureCodedParts)
+	}
+	buf := make([]byte, dataTotal*partSize)
+	copy(buf, data)
+	binary.BigEndian.PutUint32(buf[len(buf)-erasureCodedLenSize:], uint32(len(data)))
+
+	partsBytes := make([][]byte, total)
+	for i := uint32(0); i < dataTotal; i++ {
+		partsBytes[i] = buf[i*partSize : (i+1)*partSize]
+	}
+	for i := dataTotal; i < total; i++ {
+		partsBytes[i] = make([]byte, partSize)
+	}
+	enc, err := reedsolomon.New(int(dataTotal), int(total-dataTotal))
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(partsBytes); err != nil {
+		return nil, err
+	}
+
+	parts := make([]*Part, total)
+	partsBitArray := bits.NewBitArray(int(total))
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	for i := uint32(0); i < total; i++ {
+		parts[i] = &Part{
+			Index: i,
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+		partsBitArray.SetIndex(int(i), true)
+	}
+	return &PartSet{
+		total:         total,
+		hash:          root,
+		dataTotal:     dataTotal,
+		parts:         parts,
+		partsBitArray: partsBitArray,
+		count:         total,
+		byteSize:      int64(len(data)),
+	}, nil
+}
+
+// ErasureCodedData returns the data held by data, the concatenated bytes of
+// the data parts of an erasure-coded PartSet, without its padding and length.
+func ErasureCodedData(data []byte) ([]byte, error) {
+	if len(data) < erasureCodedLenSize {
+		return nil, ErrPartSetInvalidCoding
+	}
+	dataLen := binary.BigEndian.Uint32(data[len(data)-erasureCodedLenSize:])
+	if int64(dataLen) > int64(len(data)-erasureCodedLenSize) {
+		return nil, ErrPartSetInvalidCoding
+	}
+	return data[:dataLen], nil
+}
+
 // NewPartSetFromHeader returns an empty PartSet ready to be populated.
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
@@ -217,6 +310,18 @@ func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	}
 }
 
+// NewErasurePartSetFromHeader returns an empty erasure-coded PartSet ready to
+// be populated. It is complete, and all its parts are reconstructed, as soon
+// as half of its parts are added. A part set with a single part is not
+// erasure coded.
+func NewErasurePartSetFromHeader(header PartSetHeader) *PartSet {
+	ps := NewPartSetFromHeader(header)
+	if header.Total > 1 {
+		ps.dataTotal = (header.Total + 1) / 2
+	}
+	return ps
+}
+
 func (ps *PartSet) Header() PartSetHeader {
 	if ps == nil {
 		return PartSetHeader{}
@@ -275,6 +380,27 @@ func (ps *PartSet) Total() uint32 {
 	return ps.total
 }
 
+// IsErasureCoded returns true if the part set is erasure coded.
+func (ps *PartSet) IsErasureCoded() bool {
+	if ps == nil {
+		return false
+	}
+	return ps.dataTotal > 0
+}
+
+// DataTotal returns the number of parts needed to complete the part set: the
+// number of data parts of an erasure-coded part set, and the total number of
+// parts otherwise.
+func (ps *PartSet) DataTotal() uint32 {
+	if ps == nil {
+		return 0
+	}
+	if ps.dataTotal > 0 {
+		return ps.dataTotal
+	}
+	return ps.total
+}
+
 func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	// TODO: remove this? would be preferable if this only returned (false, nil)
 	// when its a duplicate block part
@@ -309,10 +435,70 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.parts[part.Index] = part
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
-	ps.byteSize += int64(len(part.Bytes))
+	if ps.dataTotal == 0 {
+		ps.byteSize += int64(len(part.Bytes))
+	} else if ps.count == ps.dataTotal {
+		if err := ps.reconstruct(); err != nil {
+			return true, err
+		}
+	}
 	return true, nil
 }
 
+// reconstruct reconstructs the missing parts of an erasure-coded part set
+// from its data parts, and checks that they match the hash of the part set.
+// Must be called with the lock held, once dataTotal parts were added.
+func (ps *PartSet) reconstruct() error {
+	partSize := 0
+	partsBytes := make([][]byte, ps.total)
+	for i, part := range ps.parts {
+		if part == nil {
+			continue
+		}
+		if partSize == 0 {
+			partSize = len(part.Bytes)
+		}
+		if len(part.Bytes) != partSize {
+			return ErrPartSetInvalidCoding
+		}
+		partsBytes[i] = part.Bytes
+	}
+	enc, err := reedsolomon.New(int(ps.dataTotal), int(ps.total-ps.dataTotal))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+	if err := enc.Reconstruct(partsBytes); err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+
+	// All the parts must be committed to by the hash of the part set, so
+	// that any subset of parts leads to the same data.
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	if !bytes.Equal(root, ps.hash) {
+		return ErrPartSetInvalidCoding
+	}
+	dataSize := ps.dataTotal * uint32(partSize)
+	lastData := partsBytes[ps.dataTotal-1]
+	dataLen := binary.BigEndian.Uint32(lastData[len(lastData)-erasureCodedLenSize:])
+	if dataLen > dataSize-erasureCodedLenSize {
+		return ErrPartSetInvalidCoding
+	}
+
+	for i := range ps.parts {
+		if ps.parts[i] == nil {
+			ps.parts[i] = &Part{
+				Index: uint32(i),
+				Bytes: partsBytes[i],
+				Proof: *proofs[i],
+			}
+			ps.partsBitArray.SetIndex(i, true)
+		}
+	}
+	ps.count = ps.total
+	ps.byteSize = int64(dataLen)
+	return nil
+}
+
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -327,6 +513,10 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.dataTotal > 0 {
+		// The data is followed by padding and its length in the data parts.
+		return io.LimitReader(NewPartSetReader(ps.parts[:ps.dataTotal]), ps.byteSize)
+	}
 	return NewPartSetReader(ps.parts)
 }
 
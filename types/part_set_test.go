@@ -104,6 +104,83 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestErasurePartSet(t *testing.T) {
+	// Construct random data of size partSize * 3.5
+	data := cmtrand.Bytes(testPartSize*3 + testPartSize/2)
+	partSet, err := NewErasurePartSetFromData(data, testPartSize)
+	require.NoError(t, err)
+
+	assert.True(t, partSet.IsErasureCoded())
+	assert.EqualValues(t, 8, partSet.Total())
+	assert.EqualValues(t, 4, partSet.DataTotal())
+	assert.True(t, partSet.IsComplete())
+	assert.EqualValues(t, len(data), partSet.ByteSize())
+	for i := 0; i < int(partSet.Total()); i++ {
+		require.NoError(t, partSet.GetPart(i).ValidateBasic())
+	}
+
+	// Any half of the parts are enough to reconstruct the others.
+	for _, indexes := range [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}, {1, 3, 6, 7}} {
+		partSet2 := NewErasurePartSetFromHeader(partSet.Header())
+		assert.True(t, partSet2.IsErasureCoded())
+		for i, index := range indexes {
+			assert.False(t, partSet2.IsComplete())
+			added, err := partSet2.AddPart(partSet.GetPart(index))
+			require.NoError(t, err)
+			assert.True(t, added)
+			if i < len(indexes)-1 {
+				assert.Zero(t, partSet2.ByteSize())
+			}
+		}
+		assert.True(t, partSet2.IsComplete())
+		assert.True(t, partSet2.BitArray().IsFull())
+		assert.EqualValues(t, len(data), partSet2.ByteSize())
+		for i := 0; i < int(partSet.Total()); i++ {
+			assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+		}
+
+		data2, err := io.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+
+		// Reconstructed parts are not added again.
+		added, err := partSet2.AddPart(partSet.GetPart(5))
+		require.NoError(t, err)
+		assert.False(t, added)
+	}
+
+	// Data that fits in a single part is not erasure coded.
+	partSet, err = NewErasurePartSetFromData(data[:testPartSize], testPartSize)
+	require.NoError(t, err)
+	assert.False(t, partSet.IsErasureCoded())
+	assert.EqualValues(t, 1, partSet.Total())
+	assert.False(t, NewErasurePartSetFromHeader(partSet.Header()).IsErasureCoded())
+
+	// Data that would need too many parts cannot be erasure coded.
+	_, err = NewErasurePartSetFromData(make([]byte, MaxErasureCodedBlockSizeBytes+1), testPartSize)
+	require.Error(t, err)
+}
+
+func TestErasurePartSetInvalidCoding(t *testing.T) {
+	// Parity parts that do not match the data parts are detected once the
+	// part set is reconstructed, even if they match the hash of the part set.
+	partsBytes := make([][]byte, 4)
+	for i := range partsBytes {
+		partsBytes[i] = cmtrand.Bytes(testPartSize)
+	}
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	partSet := NewErasurePartSetFromHeader(PartSetHeader{Total: 4, Hash: root})
+	for i := 0; i < 2; i++ {
+		_, err := partSet.AddPart(&Part{Index: uint32(i), Bytes: partsBytes[i], Proof: *proofs[i]})
+		if i == 1 {
+			require.ErrorIs(t, err, ErrPartSetInvalidCoding)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	assert.False(t, partSet.IsComplete())
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
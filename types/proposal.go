@@ -79,6 +79,20 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely validates that the proposal timestamp is 'timely' according to the
+// proposer-based timestamp algorithm. To evaluate if a proposal is timely, its
+// timestamp is compared to the local time at which the proposal was received,
+// recvTime. The proposal is timely if
+//
+//	recvTime - Precision - MessageDelay <= Timestamp <= recvTime + Precision
+//
+// See spec/consensus/proposer-based-timestamp for details.
+func (p *Proposal) IsTimely(recvTime time.Time, sp SynchronyParams) bool {
+	lhs := recvTime.Add(-sp.Precision).Add(-sp.MessageDelay)
+	rhs := recvTime.Add(sp.Precision)
+	return !p.Timestamp.Before(lhs) && !p.Timestamp.After(rhs)
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
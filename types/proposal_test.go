@@ -191,3 +191,34 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestProposalIsTimely(t *testing.T) {
+	recvTime := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	sp := SynchronyParams{
+		Precision:    500 * time.Millisecond,
+		MessageDelay: 2 * time.Second,
+	}
+
+	testCases := []struct {
+		name      string
+		timestamp time.Time
+		timely    bool
+	}{
+		{"same time", recvTime, true},
+		{"upper bound", recvTime.Add(sp.Precision), true},
+		{"after upper bound", recvTime.Add(sp.Precision + time.Nanosecond), false},
+		{"lower bound", recvTime.Add(-sp.Precision - sp.MessageDelay), true},
+		{"before lower bound", recvTime.Add(-sp.Precision - sp.MessageDelay - time.Nanosecond), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Proposal{Timestamp: tc.timestamp}
+			assert.Equal(t, tc.timely, p.IsTimely(recvTime, sp))
+		})
+	}
+
+	// The message delay grows with the round.
+	p := Proposal{Timestamp: recvTime.Add(-sp.Precision - sp.MessageDelay - time.Second)}
+	assert.False(t, p.IsTimely(recvTime, sp.InRound(0)))
+	assert.True(t, p.IsTimely(recvTime, sp.InRound(5)))
+}
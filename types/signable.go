@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 )
@@ -8,7 +9,7 @@ import (
 // MaxSignatureSize is a maximum allowed signature size for the Proposal
 // and Vote.
 // XXX: secp256k1 does not have Size nor MaxSize defined.
-var MaxSignatureSize = cmtmath.MaxInt(ed25519.SignatureSize, 64)
+var MaxSignatureSize = cmtmath.MaxInt(cmtmath.MaxInt(ed25519.SignatureSize, 64), bls12381.SignatureSize)
 
 // Signable is an interface for all signable things.
 // It typically removes signatures before serializing.
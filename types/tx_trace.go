@@ -0,0 +1,279 @@
+package types
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+)
+
+// Stages of the lifecycle of a transaction, in the order they are normally
+// reached.
+const (
+	TxStageReceived  = "received"
+	TxStageChecked   = "checked"
+	TxStageGossiped  = "gossiped"
+	TxStageProposed  = "proposed"
+	TxStageCommitted = "committed"
+	TxStageIndexed   = "indexed"
+
+	// Number of stages above.
+	txTraceStages = 6
+)
+
+// Sources of the transactions traced by TxTracer.
+const (
+	TxSourceRPC  = "rpc"
+	TxSourcePeer = "peer"
+)
+
+// TxTrace records when a transaction reached each stage of its lifecycle on
+// this node. The time of a stage that has not been reached is zero.
+type TxTrace struct {
+	// Where the transaction was received from: TxSourceRPC or TxSourcePeer.
+	Source string `json:"source"`
+
+	// When the transaction was received.
+	Received time.Time `json:"received"`
+	// When the application responded to CheckTx.
+	Checked time.Time `json:"checked"`
+	// When the transaction was first sent (or announced) to a peer.
+	Gossiped time.Time `json:"gossiped"`
+	// When a complete proposal block including the transaction was first
+	// received, or created.
+	Proposed time.Time `json:"proposed"`
+	// When a block including the transaction was committed.
+	Committed time.Time `json:"committed"`
+	// When the transaction was indexed.
+	Indexed time.Time `json:"indexed"`
+
+	// Height of the block including the transaction, once committed.
+	Height int64 `json:"height"`
+}
+
+// txTraceEntry is an element of TxTracer.order.
+type txTraceEntry struct {
+	key   TxKey
+	trace TxTrace
+}
+
+// TxTracer records the lifecycle of the transactions received by this node,
+// via RPC or from peers, across the mempool, consensus and block execution.
+// Each time a transaction reaches a new stage, a TxTrace event is published.
+// Events are published in order from a separate goroutine, so that recording
+// a stage, e.g. while committing a block, never waits for the event bus.
+// At most maxTraces traces, and as many events waiting to be published as
+// there are stages in maxTraces traces, are kept: the oldest ones are dropped
+// first.
+//
+// A nil *TxTracer records nothing, so that components can be given a nil
+// tracer when tracing is disabled. Callers should check for a nil tracer
+// before computing the keys of the transactions to trace, which is not free.
+// All methods are safe for concurrent use.
+type TxTracer struct {
+	mtx       cmtsync.Mutex
+	maxTraces int
+	traces    map[TxKey]*list.Element // values are *txTraceEntry
+	order     *list.List              // from oldest to newest
+
+	publisher TxTraceEventPublisher
+	// Events waiting to be published by publishRoutine, which runs as long as
+	// there are any, from oldest to newest. Values are EventDataTxTrace.
+	pending    *list.List
+	maxPending int
+	publishing bool
+	// Counts the events dropped because pending was full.
+	droppedEvents metrics.Counter
+}
+
+// NewTxTracer returns a TxTracer keeping up to maxTraces traces.
+func NewTxTracer(maxTraces int) *TxTracer {
+	return &TxTracer{
+		maxTraces:  maxTraces,
+		traces:     make(map[TxKey]*list.Element, maxTraces),
+		order:      list.New(),
+		publisher:  NopEventBus{},
+		pending:    list.New(),
+		maxPending: txTraceStages * maxTraces,
+
+		droppedEvents: discard.NewCounter(),
+	}
+}
+
+// SetEventBus sets the event bus TxTrace events are published to.
+func (t *TxTracer) SetEventBus(publisher TxTraceEventPublisher) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.publisher = publisher
+}
+
+// SetDroppedEventsCounter sets the counter of the TxTrace events dropped
+// because the event bus could not keep up with them.
+func (t *TxTracer) SetDroppedEventsCounter(counter metrics.Counter) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.droppedEvents = counter
+}
+
+// Received starts tracing the transaction with the given key, received from
+// source. It does nothing if the transaction is already traced.
+func (t *TxTracer) Received(key TxKey, source string) {
+	if t == nil || t.maxTraces <= 0 {
+		return
+	}
+	t.mtx.Lock()
+	if _, ok := t.traces[key]; ok {
+		t.mtx.Unlock()
+		return
+	}
+	if t.order.Len() >= t.maxTraces {
+		oldest := t.order.Remove(t.order.Front()).(*txTraceEntry)
+		delete(t.traces, oldest.key)
+	}
+	entry := &txTraceEntry{key: key, trace: TxTrace{Source: source, Received: time.Now()}}
+	t.traces[key] = t.order.PushBack(entry)
+	t.publish(EventDataTxTrace{Hash: append([]byte(nil), key[:]...), Stage: TxStageReceived, Trace: entry.trace})
+	t.mtx.Unlock()
+}
+
+// Checked records that the application responded to CheckTx for the
+// transaction with the given key.
+func (t *TxTracer) Checked(key TxKey) {
+	t.record([]TxKey{key}, TxStageChecked, 0)
+}
+
+// Gossiped records that the transaction with the given key was sent to a peer.
+func (t *TxTracer) Gossiped(key TxKey) {
+	t.record([]TxKey{key}, TxStageGossiped, 0)
+}
+
+// Proposed records that txs are included in a complete proposal block.
+func (t *TxTracer) Proposed(txs Txs) {
+	if t == nil {
+		return
+	}
+	t.record(txKeys(txs), TxStageProposed, 0)
+}
+
+// Committed records that txs are included in the block committed at height.
+func (t *TxTracer) Committed(txs Txs, height int64) {
+	if t == nil {
+		return
+	}
+	t.record(txKeys(txs), TxStageCommitted, height)
+}
+
+// Indexed records that the transactions with the given keys were indexed.
+func (t *TxTracer) Indexed(keys []TxKey) {
+	t.record(keys, TxStageIndexed, 0)
+}
+
+// Trace returns the trace of the transaction with the given key, if any.
+func (t *TxTracer) Trace(key TxKey) (TxTrace, bool) {
+	if t == nil {
+		return TxTrace{}, false
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	elem, ok := t.traces[key]
+	if !ok {
+		return TxTrace{}, false
+	}
+	return elem.Value.(*txTraceEntry).trace, true
+}
+
+// record sets the time of stage to now in the traces of the given
+// transactions, unless they are not traced or have already reached stage.
+// A TxTrace event is published for each updated trace.
+func (t *TxTracer) record(keys []TxKey, stage string, height int64) {
+	if t == nil || len(keys) == 0 {
+		return
+	}
+	now := time.Now()
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for _, key := range keys {
+		elem, ok := t.traces[key]
+		if !ok {
+			continue
+		}
+		trace := &elem.Value.(*txTraceEntry).trace
+		var at *time.Time
+		switch stage {
+		case TxStageChecked:
+			at = &trace.Checked
+		case TxStageGossiped:
+			at = &trace.Gossiped
+		case TxStageProposed:
+			at = &trace.Proposed
+		case TxStageCommitted:
+			at = &trace.Committed
+		case TxStageIndexed:
+			at = &trace.Indexed
+		}
+		if at == nil || !at.IsZero() {
+			continue
+		}
+		*at = now
+		if stage == TxStageCommitted {
+			trace.Height = height
+		}
+		t.publish(EventDataTxTrace{Hash: append([]byte(nil), key[:]...), Stage: stage, Trace: *trace})
+	}
+}
+
+// publish queues an event to be published by publishRoutine, starting it if
+// it is not running. If maxPending events are already queued, the oldest one
+// is dropped. It must be called with t.mtx held.
+func (t *TxTracer) publish(data EventDataTxTrace) {
+	if t.pending.Len() >= t.maxPending {
+		t.pending.Remove(t.pending.Front())
+		t.droppedEvents.Add(1)
+	}
+	t.pending.PushBack(data)
+	if !t.publishing {
+		t.publishing = true
+		go t.publishRoutine()
+	}
+}
+
+// publishRoutine publishes the pending events in order, and returns once there
+// are none left.
+func (t *TxTracer) publishRoutine() {
+	for {
+		t.mtx.Lock()
+		events, publisher := t.pending, t.publisher
+		t.pending = list.New()
+		if events.Len() == 0 {
+			t.publishing = false
+			t.mtx.Unlock()
+			return
+		}
+		t.mtx.Unlock()
+
+		for e := events.Front(); e != nil; e = e.Next() {
+			_ = publisher.PublishEventTxTrace(e.Value.(EventDataTxTrace))
+		}
+	}
+}
+
+func txKeys(txs Txs) []TxKey {
+	keys := make([]TxKey, len(txs))
+	for i, tx := range txs {
+		keys[i] = tx.Key()
+	}
+	return keys
+}
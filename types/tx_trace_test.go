@@ -0,0 +1,196 @@
+package types
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type txTraceEvents struct {
+	mtx    sync.Mutex
+	events []EventDataTxTrace
+}
+
+func (e *txTraceEvents) PublishEventTxTrace(data EventDataTxTrace) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.events = append(e.events, data)
+	return nil
+}
+
+// stages returns the stages of the events published for the transaction with
+// the given key.
+func (e *txTraceEvents) stages(key TxKey) []string {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	var stages []string
+	for _, data := range e.events {
+		if key == TxKey(data.Hash) {
+			stages = append(stages, data.Stage)
+		}
+	}
+	return stages
+}
+
+func TestTxTracer(t *testing.T) {
+	events := &txTraceEvents{}
+	tracer := NewTxTracer(2)
+	tracer.SetEventBus(events)
+
+	txs := Txs{Tx("a"), Tx("b"), Tx("c")}
+	tracer.Received(txs[0].Key(), TxSourceRPC)
+	tracer.Received(txs[1].Key(), TxSourcePeer)
+
+	// Transactions that are not traced are ignored.
+	tracer.Checked(txs[2].Key())
+	_, ok := tracer.Trace(txs[2].Key())
+	require.False(t, ok)
+
+	tracer.Checked(txs[0].Key())
+	tracer.Gossiped(txs[0].Key())
+	tracer.Proposed(txs[:1])
+	tracer.Committed(txs, 5)
+	tracer.Indexed([]TxKey{txs[0].Key()})
+
+	// Only the first time a stage is reached is recorded.
+	trace, ok := tracer.Trace(txs[0].Key())
+	require.True(t, ok)
+	tracer.Received(txs[0].Key(), TxSourcePeer)
+	tracer.Committed(txs[:1], 6)
+	trace2, _ := tracer.Trace(txs[0].Key())
+	require.Equal(t, trace, trace2)
+
+	assert.Equal(t, TxSourceRPC, trace.Source)
+	assert.EqualValues(t, 5, trace.Height)
+	for _, at := range []struct {
+		stage string
+		prev  int64
+		next  int64
+	}{
+		{TxStageChecked, trace.Received.UnixNano(), trace.Checked.UnixNano()},
+		{TxStageGossiped, trace.Checked.UnixNano(), trace.Gossiped.UnixNano()},
+		{TxStageProposed, trace.Gossiped.UnixNano(), trace.Proposed.UnixNano()},
+		{TxStageCommitted, trace.Proposed.UnixNano(), trace.Committed.UnixNano()},
+		{TxStageIndexed, trace.Committed.UnixNano(), trace.Indexed.UnixNano()},
+	} {
+		assert.LessOrEqual(t, at.prev, at.next, at.stage)
+	}
+
+	trace, ok = tracer.Trace(txs[1].Key())
+	require.True(t, ok)
+	assert.Equal(t, TxSourcePeer, trace.Source)
+	assert.True(t, trace.Checked.IsZero())
+	assert.False(t, trace.Committed.IsZero())
+
+	// An event is published, in order, each time a transaction reaches a new
+	// stage.
+	expStages := []string{
+		TxStageReceived, TxStageChecked, TxStageGossiped,
+		TxStageProposed, TxStageCommitted, TxStageIndexed,
+	}
+	require.Eventually(t, func() bool {
+		return len(events.stages(txs[0].Key())) == len(expStages)
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, expStages, events.stages(txs[0].Key()))
+
+	// The oldest trace is dropped first.
+	tracer.Received(txs[2].Key(), TxSourceRPC)
+	_, ok = tracer.Trace(txs[0].Key())
+	assert.False(t, ok)
+	_, ok = tracer.Trace(txs[2].Key())
+	assert.True(t, ok)
+}
+
+func TestNilTxTracer(t *testing.T) {
+	var tracer *TxTracer
+	tx := Tx("a")
+	tracer.Received(tx.Key(), TxSourceRPC)
+	tracer.Checked(tx.Key())
+	tracer.Committed(Txs{tx}, 1)
+	_, ok := tracer.Trace(tx.Key())
+	require.False(t, ok)
+}
+
+type blockingTxTraceEvents struct {
+	txTraceEvents
+	unblock chan struct{}
+}
+
+func (e *blockingTxTraceEvents) PublishEventTxTrace(data EventDataTxTrace) error {
+	<-e.unblock
+	return e.txTraceEvents.PublishEventTxTrace(data)
+}
+
+// Recording a stage does not wait for the events to be published.
+func TestTxTracerDoesNotBlockOnPublisher(t *testing.T) {
+	events := &blockingTxTraceEvents{unblock: make(chan struct{})}
+	tracer := NewTxTracer(10)
+	tracer.SetEventBus(events)
+
+	txs := Txs{Tx("a"), Tx("b")}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, tx := range txs {
+			tracer.Received(tx.Key(), TxSourcePeer)
+		}
+		tracer.Proposed(txs)
+		tracer.Committed(txs, 1)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("recording stages blocked on the publisher")
+	}
+
+	close(events.unblock)
+	for _, tx := range txs {
+		require.Eventually(t, func() bool {
+			return len(events.stages(tx.Key())) == 3
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{TxStageReceived, TxStageProposed, TxStageCommitted}, events.stages(tx.Key()))
+	}
+}
+
+// At most as many events as there are stages in maxTraces traces wait to be
+// published, and the oldest ones are dropped first.
+func TestTxTracerDropsOldestEvents(t *testing.T) {
+	events := &blockingTxTraceEvents{unblock: make(chan struct{})}
+	dropped := generic.NewCounter("dropped")
+	tracer := NewTxTracer(1)
+	tracer.SetEventBus(events)
+	tracer.SetDroppedEventsCounter(dropped)
+
+	a, b, c := Tx("a"), Tx("b"), Tx("c")
+	tracer.Received(a.Key(), TxSourcePeer)
+	// Wait for the first event to be taken by the publisher, which blocks.
+	require.Eventually(t, func() bool {
+		tracer.mtx.Lock()
+		defer tracer.mtx.Unlock()
+		return tracer.pending.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	tracer.Received(b.Key(), TxSourcePeer)
+	tracer.Checked(b.Key())
+	tracer.Gossiped(b.Key())
+	tracer.Proposed(Txs{b})
+	tracer.Committed(Txs{b}, 1)
+	tracer.Indexed([]TxKey{b.Key()})
+	assert.Zero(t, dropped.Value())
+	tracer.Received(c.Key(), TxSourcePeer)
+	assert.Equal(t, float64(1), dropped.Value())
+
+	close(events.unblock)
+	require.Eventually(t, func() bool {
+		return len(events.stages(c.Key())) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{TxStageReceived}, events.stages(a.Key()))
+	assert.Equal(t, []string{
+		TxStageChecked, TxStageGossiped, TxStageProposed, TxStageCommitted, TxStageIndexed,
+	}, events.stages(b.Key()))
+	assert.Equal(t, []string{TxStageReceived}, events.stages(c.Key()))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cometbft/cometbft/crypto/batch"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -23,6 +24,8 @@ func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
 // application that depends on the LastCommitInfo sent in FinalizeBlock, which
 // includes which validators signed. For instance, Gaia incentivizes proposers
 // with a bonus for including more than +2/3 of the signatures.
+//
+// If the commit is aggregated, its aggregated signature is verified instead.
 func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	height int64, commit *Commit,
 ) error {
@@ -41,6 +44,11 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// only count the signatures that are for the block
 	count := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagCommit }
 
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, true)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -57,7 +65,8 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 // VerifyCommitLight verifies +2/3 of the set had signed the given commit.
 //
 // This method is primarily used by the light client and does NOT check all the
-// signatures.
+// signatures, unless the commit is aggregated, in which case its aggregated
+// signature is verified.
 func VerifyCommitLight(
 	chainID string,
 	vals *ValidatorSet,
@@ -103,6 +112,11 @@ func verifyCommitLightInternal(
 	// count all the remaining signatures
 	count := func(c CommitSig) bool { return true }
 
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, true)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -118,7 +132,8 @@ func verifyCommitLightInternal(
 // this commit.
 //
 // NOTE the given validators do not necessarily correspond to the validator set
-// for this commit, but there may be some intersection.
+// for this commit, but there may be some intersection. An aggregated commit
+// can only be verified if all its signers are in the given validator set.
 //
 // This method is primarily used by the light client and does NOT check all the
 // signatures.
@@ -178,6 +193,11 @@ func verifyCommitLightTrustingInternal(
 	// count all the remaining signatures
 	count := func(c CommitSig) bool { return true }
 
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, false)
+	}
+
 	// attempt to batch verify commit. As the validator set doesn't necessarily
 	// correspond with the validator set that signed the block we need to look
 	// up by address rather than index.
@@ -401,6 +421,85 @@ func verifyCommitSingle(
 	return nil
 }
 
+// Aggregated Verification
+
+// verifyCommitAggregated verifies the aggregated signature of an aggregated
+// commit, which covers all the signatures that are not absent, whether they
+// are ignored when tallying the voting power or not. Every signer must thus be
+// a validator of the set, with a BLS12-381 public key.
+func verifyCommitAggregated(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	lookUpByIndex bool,
+) error {
+	var (
+		val                *Validator
+		valIdx             int32
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		pubKeys            = make([]bls12381.PubKey, 0, len(commit.Signatures))
+		msgs               = make([][]byte, 0, len(commit.Signatures))
+		talliedVotingPower int64
+	)
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+
+		// If the vals and commit have a 1-to-1 correspondence we can retrieve
+		// them by index else we need to retrieve them by address
+		if lookUpByIndex {
+			val = vals.Validators[idx]
+		} else {
+			valIdx, val = vals.GetByAddress(commitSig.ValidatorAddress)
+
+			// the aggregated signature cannot be verified without the public
+			// keys of all the signers, so none of the voting power of the
+			// validator set can be trusted to have signed the commit. The
+			// light client falls back to verifying intermediate headers.
+			if val == nil {
+				return ErrNotEnoughVotingPowerSigned{Got: 0, Needed: votingPowerNeeded}
+			}
+
+			// because we are getting validators by address we need to make sure
+			// that the same validator doesn't commit twice
+			if firstIndex, ok := seenVals[valIdx]; ok {
+				secondIndex := idx
+				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+			}
+			seenVals[valIdx] = idx
+		}
+
+		pubKey, ok := val.PubKey.(bls12381.PubKey)
+		if !ok {
+			return fmt.Errorf("cannot verify aggregated commit: validator %v has a %T public key at index %d",
+				val, val.PubKey, idx)
+		}
+		pubKeys = append(pubKeys, pubKey)
+		msgs = append(msgs, commit.VoteSignBytes(chainID, int32(idx)))
+
+		// If this signature counts then add the voting power of the validator
+		// to the tally
+		if !ignoreSig(commitSig) && countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+	}
+
+	// ensure that the signers have enough voting power else there is no need
+	// to even verify
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	if !bls12381.VerifyAggregateSignature(pubKeys, msgs, commit.AggregatedSignature) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return nil
+}
+
 func verifyBasicValsAndCommit(vals *ValidatorSet, commit *Commit, height int64, blockID BlockID) error {
 	if vals == nil {
 		return errors.New("nil validator set")
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 )
 
@@ -307,3 +309,113 @@ func TestValidatorSet_VerifyCommitLightTrustingErrorsOnOverflow(t *testing.T) {
 		assert.Contains(t, err.Error(), "int64 overflow")
 	}
 }
+
+func TestValidatorSet_VerifyCommit_Aggregated(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	voteSet, valSet, vals := randBls12381VoteSet(h, 0, 4, 10)
+	// The last validator is absent.
+	extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals[:3], time.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+	require.False(t, commit.IsAggregated())
+
+	aggCommit, err := commit.Aggregate()
+	require.NoError(t, err)
+	require.True(t, aggCommit.IsAggregated())
+	require.NoError(t, aggCommit.ValidateBasic())
+	for i, commitSig := range aggCommit.Signatures {
+		assert.Empty(t, commitSig.Signature, i)
+		assert.Equal(t, commit.Signatures[i].ValidatorAddress, commitSig.ValidatorAddress, i)
+	}
+	assert.Less(t, aggCommit.ToProto().Size(), commit.ToProto().Size())
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+
+	fromProto, err := CommitFromProto(aggCommit.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.Hash(), fromProto.Hash())
+
+	require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, aggCommit))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, h, aggCommit))
+	require.NoError(t, valSet.VerifyCommitLightTrusting(chainID, aggCommit, cmtmath.Fraction{Numerator: 1, Denominator: 3}))
+
+	// wrong chain ID
+	require.Error(t, valSet.VerifyCommit("CentaurusA", blockID, h, aggCommit))
+	require.Error(t, valSet.VerifyCommitLight("CentaurusA", blockID, h, aggCommit))
+
+	// aggregated signature not covering all the signatures
+	partial := *commit
+	partial.Signatures = append([]CommitSig{}, commit.Signatures...)
+	partial.Signatures[2] = NewCommitSigAbsent()
+	partialAgg, err := partial.Aggregate()
+	require.NoError(t, err)
+	tampered := *aggCommit
+	tampered.AggregatedSignature = partialAgg.AggregatedSignature
+	err = valSet.VerifyCommit(chainID, blockID, h, &tampered)
+	if assert.Error(t, err) { //nolint:testifylint // require.Error doesn't work with the conditional here
+		assert.Contains(t, err.Error(), "wrong aggregated signature")
+	}
+
+	// not enough voting power
+	err = valSet.VerifyCommit(chainID, blockID, h, partialAgg)
+	require.ErrorAs(t, err, &ErrNotEnoughVotingPowerSigned{})
+
+	// the public keys of all the signers are needed
+	otherValSet, _ := randBls12381ValidatorSet(2, 10)
+	trustedVals := NewValidatorSet(append(otherValSet.Validators, valSet.Validators[1:]...))
+	err = trustedVals.VerifyCommitLightTrusting(chainID, aggCommit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+	require.ErrorAs(t, err, &ErrNotEnoughVotingPowerSigned{})
+}
+
+func TestCommitAggregate_NonBls12381Signatures(t *testing.T) {
+	h := int64(3)
+	voteSet, _, vals := randVoteSet(h, 0, PrecommitType, 4, 10, false)
+	extCommit, err := MakeExtCommit(makeBlockIDRandom(), h, 0, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+
+	_, err = extCommit.ToCommit().Aggregate()
+	require.Error(t, err)
+}
+
+func TestCommitValidateBasic_Aggregated(t *testing.T) {
+	h := int64(3)
+	voteSet, _, vals := randBls12381VoteSet(h, 0, 4, 10)
+	extCommit, err := MakeExtCommit(makeBlockIDRandom(), h, 0, voteSet, vals, time.Now(), false)
+	require.NoError(t, err)
+	commit := extCommit.ToCommit()
+	aggCommit, err := commit.Aggregate()
+	require.NoError(t, err)
+	require.NoError(t, aggCommit.ValidateBasic())
+
+	withSig := *aggCommit
+	withSig.Signatures = append([]CommitSig{}, aggCommit.Signatures...)
+	withSig.Signatures[0] = commit.Signatures[0]
+	require.Error(t, withSig.ValidateBasic())
+
+	wrongSize := *aggCommit
+	wrongSize.AggregatedSignature = aggCommit.AggregatedSignature[1:]
+	require.Error(t, wrongSize.ValidateBasic())
+}
+
+func randBls12381ValidatorSet(numValidators int, votingPower int64) (*ValidatorSet, []PrivValidator) {
+	var (
+		valz           = make([]*Validator, numValidators)
+		privValidators = make([]PrivValidator, numValidators)
+	)
+	for i := 0; i < numValidators; i++ {
+		privVal := NewMockPVWithParams(bls12381.GenPrivKey(), false, false)
+		valz[i] = privVal.ExtractIntoValidator(votingPower)
+		privValidators[i] = privVal
+	}
+	sort.Sort(PrivValidatorsByAddress(privValidators))
+	return NewValidatorSet(valz), privValidators
+}
+
+func randBls12381VoteSet(height int64, round int32, numValidators int, votingPower int64) (*VoteSet, *ValidatorSet, []PrivValidator) {
+	valSet, privValidators := randBls12381ValidatorSet(numValidators, votingPower)
+	return NewVoteSet("test_chain_id", height, round, PrecommitType, valSet), valSet, privValidators
+}
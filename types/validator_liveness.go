@@ -0,0 +1,213 @@
+package types
+
+import (
+	"bytes"
+	"slices"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/internal/sync"
+)
+
+// ValidatorLiveness summarizes how a validator signed the commits of a window
+// of heights, among those of the heights at which it was a validator.
+type ValidatorLiveness struct {
+	Address Address `json:"address"`
+
+	// Number of commits of the window the validator was expected to sign.
+	Commits int64 `json:"commits"`
+	// Number of them without its signature.
+	Absent int64 `json:"absent"`
+	// Number of them in which its precommit was for nil.
+	Nil int64 `json:"nil"`
+	// Average delay of its precommits for the committed blocks after +2/3 of
+	// the voting power precommitted them, going by the timestamps of the
+	// precommits. The precommits making the +2/3 are not late.
+	AvgLateness time.Duration `json:"avg_lateness"`
+
+	// Last height of the window at which it precommitted the committed block,
+	// or 0.
+	LastSignedHeight int64 `json:"last_signed_height"`
+}
+
+// commitSigLiveness is how a validator signed a commit of the window.
+type commitSigLiveness struct {
+	address  string
+	flag     BlockIDFlag
+	lateness time.Duration
+}
+
+// validatorLivenessTotals is the sum of the commitSigLiveness of a validator
+// over the window.
+type validatorLivenessTotals struct {
+	address          Address
+	commits          int64
+	absent           int64
+	nilVotes         int64
+	signed           int64
+	lateness         time.Duration
+	lastSignedHeight int64
+}
+
+// LivenessTracker keeps the liveness of the validators over a rolling window
+// of the commits of the last heights. Commits are added in increasing height
+// order; once the window is full, adding a commit drops the oldest one.
+//
+// A nil *LivenessTracker records nothing, so that components can be given a
+// nil tracker when liveness tracking is disabled. All methods are safe for
+// concurrent use.
+type LivenessTracker struct {
+	mtx     cmtsync.Mutex
+	window  int
+	heights []int64               // from oldest to newest
+	commits [][]commitSigLiveness // same order as heights
+	totals  map[string]*validatorLivenessTotals
+}
+
+// NewLivenessTracker returns a LivenessTracker over the commits of the last
+// window heights.
+func NewLivenessTracker(window int) *LivenessTracker {
+	return &LivenessTracker{
+		window: window,
+		totals: make(map[string]*validatorLivenessTotals),
+	}
+}
+
+// LastHeight returns the height of the last commit added, or 0.
+func (lt *LivenessTracker) LastHeight() int64 {
+	if lt == nil {
+		return 0
+	}
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+	if len(lt.heights) == 0 {
+		return 0
+	}
+	return lt.heights[len(lt.heights)-1]
+}
+
+// AddCommit adds commit, signed by vals, to the window. It is ignored if its
+// height is not above the one of the last commit added.
+func (lt *LivenessTracker) AddCommit(commit *Commit, vals *ValidatorSet) {
+	if lt == nil || commit == nil || len(commit.Signatures) != vals.Size() {
+		return
+	}
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+	if n := len(lt.heights); n > 0 && commit.Height <= lt.heights[n-1] {
+		return
+	}
+
+	quorumTime := commitQuorumTime(commit, vals)
+	sigs := make([]commitSigLiveness, len(commit.Signatures))
+	for i, commitSig := range commit.Signatures {
+		_, val := vals.GetByIndex(int32(i))
+		sig := commitSigLiveness{address: string(val.Address), flag: commitSig.BlockIDFlag}
+		if commitSig.BlockIDFlag == BlockIDFlagCommit {
+			sig.lateness = max(commitSig.Timestamp.Sub(quorumTime), 0)
+		}
+		sigs[i] = sig
+
+		totals, ok := lt.totals[sig.address]
+		if !ok {
+			totals = &validatorLivenessTotals{address: val.Address}
+			lt.totals[sig.address] = totals
+		}
+		totals.add(sig, 1)
+		if sig.flag == BlockIDFlagCommit {
+			totals.lastSignedHeight = commit.Height
+		}
+	}
+	lt.heights = append(lt.heights, commit.Height)
+	lt.commits = append(lt.commits, sigs)
+
+	for len(lt.heights) > lt.window {
+		for _, sig := range lt.commits[0] {
+			totals := lt.totals[sig.address]
+			totals.add(sig, -1)
+			if totals.lastSignedHeight == lt.heights[0] {
+				totals.lastSignedHeight = 0
+			}
+			if totals.commits == 0 {
+				delete(lt.totals, sig.address)
+			}
+		}
+		lt.heights = lt.heights[1:]
+		lt.commits = lt.commits[1:]
+	}
+}
+
+// Liveness returns the range of heights of the window, and the liveness of
+// the validators over it, ordered by address.
+func (lt *LivenessTracker) Liveness() (fromHeight, toHeight int64, liveness []ValidatorLiveness) {
+	if lt == nil {
+		return 0, 0, nil
+	}
+	lt.mtx.Lock()
+	defer lt.mtx.Unlock()
+	if len(lt.heights) == 0 {
+		return 0, 0, nil
+	}
+
+	liveness = make([]ValidatorLiveness, 0, len(lt.totals))
+	for _, totals := range lt.totals {
+		l := ValidatorLiveness{
+			Address:          totals.address,
+			Commits:          totals.commits,
+			Absent:           totals.absent,
+			Nil:              totals.nilVotes,
+			LastSignedHeight: totals.lastSignedHeight,
+		}
+		if totals.signed > 0 {
+			l.AvgLateness = totals.lateness / time.Duration(totals.signed)
+		}
+		liveness = append(liveness, l)
+	}
+	slices.SortFunc(liveness, func(a, b ValidatorLiveness) int {
+		return bytes.Compare(a.Address, b.Address)
+	})
+	return lt.heights[0], lt.heights[len(lt.heights)-1], liveness
+}
+
+// add adds sig to the totals if n is 1, or removes it if n is -1.
+func (totals *validatorLivenessTotals) add(sig commitSigLiveness, n int64) {
+	totals.commits += n
+	switch sig.flag {
+	case BlockIDFlagAbsent:
+		totals.absent += n
+	case BlockIDFlagNil:
+		totals.nilVotes += n
+	case BlockIDFlagCommit:
+		totals.signed += n
+		totals.lateness += time.Duration(n) * sig.lateness
+	}
+}
+
+// commitQuorumTime returns the timestamp of the precommit for the committed
+// block with which +2/3 of the voting power precommitted it, going by the
+// timestamps of the precommits.
+func commitQuorumTime(commit *Commit, vals *ValidatorSet) time.Time {
+	type signed struct {
+		timestamp time.Time
+		power     int64
+	}
+	sigs := make([]signed, 0, len(commit.Signatures))
+	for i, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag == BlockIDFlagCommit {
+			_, val := vals.GetByIndex(int32(i))
+			sigs = append(sigs, signed{commitSig.Timestamp, val.VotingPower})
+		}
+	}
+	slices.SortFunc(sigs, func(a, b signed) int { return a.timestamp.Compare(b.timestamp) })
+
+	var power int64
+	for _, sig := range sigs {
+		power += sig.power
+		if power > vals.TotalVotingPower()*2/3 {
+			return sig.timestamp
+		}
+	}
+	if len(sigs) == 0 {
+		return time.Time{}
+	}
+	return sigs[len(sigs)-1].timestamp
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivenessTracker(t *testing.T) {
+	vals, _ := RandValidatorSet(4, 10)
+	now := time.Now()
+
+	// makeCommit returns a commit at height with a signature of each validator
+	// at the given delay after now, or with the given flag if it is not a
+	// precommit for the block.
+	type sig struct {
+		flag  BlockIDFlag
+		delay time.Duration
+	}
+	makeCommit := func(height int64, sigs ...sig) *Commit {
+		commit := &Commit{Height: height}
+		for i, s := range sigs {
+			commitSig := CommitSig{
+				BlockIDFlag:      s.flag,
+				ValidatorAddress: vals.Validators[i].Address,
+				Timestamp:        now.Add(s.delay),
+			}
+			if s.flag == BlockIDFlagAbsent {
+				commitSig = NewCommitSigAbsent()
+			}
+			commit.Signatures = append(commit.Signatures, commitSig)
+		}
+		return commit
+	}
+	signed := func(delay time.Duration) sig { return sig{BlockIDFlagCommit, delay} }
+	nilVote := sig{BlockIDFlagNil, 0}
+	absent := sig{BlockIDFlagAbsent, 0}
+
+	livenessOf := func(tracker *LivenessTracker, i int) ValidatorLiveness {
+		_, _, liveness := tracker.Liveness()
+		for _, l := range liveness {
+			if bytes.Equal(l.Address, vals.Validators[i].Address) {
+				return l
+			}
+		}
+		t.Fatalf("no liveness for validator %d", i)
+		return ValidatorLiveness{}
+	}
+
+	tracker := NewLivenessTracker(2)
+
+	// +2/3 of the voting power precommitted after 1s, so the last validator
+	// is late by 2s.
+	tracker.AddCommit(makeCommit(1, signed(0), signed(0), signed(time.Second), signed(3*time.Second)), vals)
+	tracker.AddCommit(makeCommit(2, signed(0), signed(0), signed(0), nilVote), vals)
+	// Commits must be added in increasing height order.
+	tracker.AddCommit(makeCommit(2, absent, absent, signed(0), signed(0)), vals)
+	assert.EqualValues(t, 2, tracker.LastHeight())
+
+	from, to, liveness := tracker.Liveness()
+	assert.EqualValues(t, 1, from)
+	assert.EqualValues(t, 2, to)
+	require.Len(t, liveness, 4)
+	for i := 1; i < len(liveness); i++ {
+		assert.Equal(t, -1, bytes.Compare(liveness[i-1].Address, liveness[i].Address))
+	}
+	assert.Equal(t, ValidatorLiveness{
+		Address:          vals.Validators[0].Address,
+		Commits:          2,
+		LastSignedHeight: 2,
+	}, livenessOf(tracker, 0))
+	assert.Equal(t, ValidatorLiveness{
+		Address:          vals.Validators[3].Address,
+		Commits:          2,
+		Nil:              1,
+		AvgLateness:      2 * time.Second,
+		LastSignedHeight: 1,
+	}, livenessOf(tracker, 3))
+
+	// The commit of height 1 is out of the window.
+	tracker.AddCommit(makeCommit(3, signed(0), signed(0), signed(0), absent), vals)
+	from, to, _ = tracker.Liveness()
+	assert.EqualValues(t, 2, from)
+	assert.EqualValues(t, 3, to)
+	assert.Equal(t, ValidatorLiveness{
+		Address: vals.Validators[3].Address,
+		Commits: 2,
+		Absent:  1,
+		Nil:     1,
+	}, livenessOf(tracker, 3))
+
+	// A nil tracker records nothing.
+	var nilTracker *LivenessTracker
+	nilTracker.AddCommit(makeCommit(1, signed(0), signed(0), signed(0), signed(0)), vals)
+	from, to, liveness = nilTracker.Liveness()
+	assert.Zero(t, from)
+	assert.Zero(t, to)
+	assert.Empty(t, liveness)
+	assert.Zero(t, nilTracker.LastHeight())
+}